@@ -0,0 +1,64 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Returns the inventory of all the drives of the PowerScale cluster
+data "powerscale_drive" "all" {
+}
+
+output "powerscale_drive_all" {
+  value = data.powerscale_drive.all
+}
+
+# Returns the drives matching the filters provided in the filter block
+data "powerscale_drive" "filtered" {
+  filter {
+    # Logical Node Numbers (LNN) of the nodes the drives are in
+    lnns = [1]
+    # Names of the node pools the drives belong to
+    node_pools = ["s210_6.9tb_800gb-ssd_64gb"]
+    # States of the drives, ex. HEALTHY, SMARTFAIL, EMPTY, REPLACE
+    states = ["HEALTHY"]
+    # Purposes of the drives, ex. STORAGE, JOURNAL
+    purposes = ["STORAGE"]
+    # Health of the drives
+    # Options: ok, smartfail, empty, unhealthy
+    health = ["ok"]
+  }
+}
+
+output "powerscale_drive_filtered" {
+  value = data.powerscale_drive.filtered
+}
+
+# Drives in SMARTFAIL, which can be used to guard changes with a precondition
+data "powerscale_drive" "smartfail" {
+  filter {
+    health = ["smartfail"]
+  }
+}
+
+resource "terraform_data" "drive_check" {
+  lifecycle {
+    precondition {
+      condition     = length(data.powerscale_drive.smartfail.drives) == 0
+      error_message = "No drive of the cluster may be in SMARTFAIL."
+    }
+  }
+}
+
+# After the successful execution of above said block, We can see the output value by executing 'terraform output' command.
+# Also, we can use the fetched information by the variable data.powerscale_drive.all
@@ -21,6 +21,8 @@ limitations under the License.
 data "powerscale_filesystem" "system" {
   # Required parameter, path of the directory filesystem datasource, defaults to "/ifs" if not set
   directory_path = "/ifs/tfacc_file_system_test"
+  # Optional parameter, also returns the snapshots of the parent directories, which cover the directory too. Defaults to false.
+  include_parent_snapshots = true
 }
 
 output "powerscale_filesystem_1" {
@@ -0,0 +1,75 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Returns the large files below /ifs/data which were not modified since the beginning of 2025
+data "powerscale_namespace_query" "large_files" {
+  # Path of the directory to query below
+  path = "/ifs/data"
+  # Attributes to return for each match, the name and the container_path are always returned
+  result = ["size", "owner", "last_modified"]
+  # How the conditions are combined, and or or
+  logic = "and"
+  conditions = [
+    {
+      attribute = "type"
+      operator  = "eq"
+      value     = "object"
+    },
+    {
+      attribute = "size"
+      operator  = "gt"
+      value     = "1073741824"
+    },
+    {
+      attribute = "last_modified"
+      operator  = "lt"
+      value     = "2025-01-01T00:00:00"
+    },
+  ]
+  # Maximum depth of the directories to query below the path
+  max_depth = 4
+  # Maximum number of matches to return
+  limit = 100
+}
+
+output "powerscale_namespace_query_large_files" {
+  value = data.powerscale_namespace_query.large_files
+}
+
+# Returns the directories tagged with a user-defined metadata attribute, with all their user-defined metadata attributes
+data "powerscale_namespace_query" "tagged" {
+  path = "/ifs/data"
+  conditions = [
+    {
+      # Name of the user-defined metadata attribute
+      attribute = "team"
+      operator  = "eq"
+      value     = "storage"
+      namespace = "user"
+    },
+  ]
+  include_user_metadata = true
+  # Optional whether to return the access control list of each match
+  include_acl = true
+}
+
+output "powerscale_namespace_query_tagged" {
+  value = [for match in data.powerscale_namespace_query.tagged.matches : match.path]
+}
+
+# After the successful execution of above said block, We can see the output value by executing 'terraform output' command.
+# Also, we can use the fetched information by the variable data.powerscale_namespace_query.tagged
@@ -0,0 +1,38 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Returns all of the NDMP backup and restore contexts
+data "powerscale_ndmp_contexts" "all" {
+}
+
+output "powerscale_ndmp_contexts_all" {
+  value = data.powerscale_ndmp_contexts.all
+}
+
+# Returns the NDMP contexts of the type provided in the filter block
+data "powerscale_ndmp_contexts" "backup" {
+  filter {
+    type = "backup"
+  }
+}
+
+output "powerscale_ndmp_contexts_backup" {
+  value = data.powerscale_ndmp_contexts.backup
+}
+
+# After the successful execution of above said block, We can see the output value by executing 'terraform output' command.
+# Also, we can use the fetched information by the variable data.powerscale_ndmp_contexts.all
@@ -0,0 +1,38 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Returns all of the NDMP sessions running on the PowerScale cluster
+data "powerscale_ndmp_sessions" "all" {
+}
+
+output "powerscale_ndmp_sessions_all" {
+  value = data.powerscale_ndmp_sessions.all
+}
+
+# Returns the NDMP sessions matching the filters provided in the filter block
+data "powerscale_ndmp_sessions" "filtered" {
+  filter {
+    lnn = "1"
+  }
+}
+
+output "powerscale_ndmp_sessions_filtered" {
+  value = data.powerscale_ndmp_sessions.filtered
+}
+
+# After the successful execution of above said block, We can see the output value by executing 'terraform output' command.
+# Also, we can use the fetched information by the variable data.powerscale_ndmp_sessions.all
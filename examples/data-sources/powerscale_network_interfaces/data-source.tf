@@ -0,0 +1,55 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+# Returns all the network interfaces of all the nodes of the PowerScale cluster
+data "powerscale_network_interfaces" "all" {
+}
+
+output "powerscale_network_interfaces_all" {
+  value = data.powerscale_network_interfaces.all
+}
+
+# Returns the network interfaces matching the filters provided in the filter block
+data "powerscale_network_interfaces" "filtered" {
+  filter {
+    # Logical Node Numbers (LNN) of the nodes of the interfaces
+    lnns = [1, 2]
+    # Types of the interfaces
+    types = ["40gige"]
+    # Link states of the interfaces, ex. up, down, no_carrier
+    statuses = ["up"]
+  }
+}
+
+output "powerscale_network_interfaces_filtered" {
+  value = data.powerscale_network_interfaces.filtered
+}
+
+# The discovered interfaces which are up and not aggregated can be used for the ifaces of a network pool
+resource "powerscale_networkpool" "example" {
+  name     = "tfacc_pool"
+  groupnet = "groupnet0"
+  subnet   = "subnet0"
+  ifaces = [
+    for iface in data.powerscale_network_interfaces.filtered.network_interfaces : {
+      iface = iface.name
+      lnn   = iface.lnn
+    } if iface.aggregate == null
+  ]
+}
+
+# After the successful execution of above said block, We can see the output value by executing 'terraform output' command.
+# Also, we can use the fetched information by the variable data.powerscale_network_interfaces.all
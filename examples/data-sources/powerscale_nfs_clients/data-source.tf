@@ -0,0 +1,43 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Returns all of the NFS clients active on the nodes of the PowerScale cluster
+data "powerscale_nfs_clients" "all" {
+}
+
+output "powerscale_nfs_clients_all" {
+  value = data.powerscale_nfs_clients.all
+}
+
+# Returns the NFS clients matching the filters provided in the filter block
+data "powerscale_nfs_clients" "filtered" {
+  filter {
+    # logical node numbers
+    nodes = [1, 2]
+    # matched with the IP address ranges of the network pools of the zone
+    zone           = "System"
+    client_address = "10.10.0.0/24"
+    # user = "root"
+  }
+}
+
+output "powerscale_nfs_clients_filtered" {
+  value = data.powerscale_nfs_clients.filtered
+}
+
+# After the successful execution of above said block, We can see the output value by executing 'terraform output' command.
+# Also, we can use the fetched information by the variable data.powerscale_nfs_clients.all
@@ -0,0 +1,60 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Returns the inventory of all the nodes of the PowerScale cluster
+data "powerscale_node" "all" {
+}
+
+output "powerscale_node_all" {
+  value = data.powerscale_node.all
+}
+
+# Returns the nodes matching the filters provided in the filter block
+data "powerscale_node" "filtered" {
+  filter {
+    # Logical Node Numbers (LNN) of the nodes
+    lnns = [1, 2]
+    # Names of the node pools the nodes belong to
+    node_pools = ["s210_6.9tb_800gb-ssd_64gb"]
+    # Health of the nodes
+    # Options: ok, smartfailed, readonly, down
+    health = ["ok"]
+  }
+}
+
+output "powerscale_node_filtered" {
+  value = data.powerscale_node.filtered
+}
+
+# Nodes that are not healthy, which can be used to guard changes with a precondition
+data "powerscale_node" "unhealthy" {
+  filter {
+    health = ["smartfailed", "readonly", "down"]
+  }
+}
+
+resource "terraform_data" "node_check" {
+  lifecycle {
+    precondition {
+      condition     = length(data.powerscale_node.unhealthy.nodes) == 0
+      error_message = "All the nodes of the cluster must be healthy."
+    }
+  }
+}
+
+# After the successful execution of above said block, We can see the output value by executing 'terraform output' command.
+# Also, we can use the fetched information by the variable data.powerscale_node.all
@@ -0,0 +1,42 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Returns all of the files open over SMB on the PowerScale cluster
+data "powerscale_smb_openfiles" "all" {
+}
+
+output "powerscale_smb_openfiles_all" {
+  value = data.powerscale_smb_openfiles.all
+}
+
+# Returns the open files matching the filters provided in the filter block
+data "powerscale_smb_openfiles" "filtered" {
+  filter {
+    # the files within the base path of the access zone are returned
+    zone = "System"
+    # the files within the directory are returned
+    path = "/ifs/smb_share_example"
+    user = "user1"
+  }
+}
+
+output "powerscale_smb_openfiles_filtered" {
+  value = data.powerscale_smb_openfiles.filtered
+}
+
+# After the successful execution of above said block, We can see the output value by executing 'terraform output' command.
+# Also, we can use the fetched information by the variable data.powerscale_smb_openfiles.all
@@ -0,0 +1,53 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Returns all of the SMB sessions open on the PowerScale cluster
+data "powerscale_smb_sessions" "all" {
+}
+
+output "powerscale_smb_sessions_all" {
+  value = data.powerscale_smb_sessions.all
+}
+
+# Returns the SMB sessions matching the filters provided in the filter block
+data "powerscale_smb_sessions" "filtered" {
+  filter {
+    # logical node numbers of the nodes the clients are connected to
+    nodes = [1, 2]
+    zone  = "System"
+    user  = "user1"
+    # host name, IP address or network in CIDR notation
+    computer = "10.10.0.0/24"
+  }
+}
+
+output "powerscale_smb_sessions_filtered" {
+  value = data.powerscale_smb_sessions.filtered
+}
+
+# The sessions can be used to assert that no client is still connected, ex. before a share is retired
+resource "terraform_data" "share_retirement" {
+  lifecycle {
+    precondition {
+      condition     = length(data.powerscale_smb_sessions.filtered.smb_sessions) == 0
+      error_message = "SMB clients are still connected."
+    }
+  }
+}
+
+# After the successful execution of above said block, We can see the output value by executing 'terraform output' command.
+# Also, we can use the fetched information by the variable data.powerscale_smb_sessions.all
@@ -0,0 +1,49 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Returns all of the entries of the given PowerScale snapshot changelist and their details
+# A snapshot changelist records the files and directories that changed between two snapshots of the same path.
+data "powerscale_snapshot_changelist" "all" {
+  changelist_id = "10_12"
+}
+
+output "powerscale_snapshot_changelist_all" {
+  value = data.powerscale_snapshot_changelist.all
+}
+
+# Returns a subset of the changelist entries based on the filters provided in the filter block
+data "powerscale_snapshot_changelist" "filtered" {
+  changelist_id = "10_12"
+  filter {
+    # Only entries at or below this path
+    path_prefix = "/ifs/data/projects"
+    # Only entries with at least one of these change types
+    # Options: added, removed, path_changed, modified, ads, has_ads, symlink, hardlinks
+    change_types = ["added", "modified"]
+    # Maximum number of entries to return
+    limit = 500
+    # Number of entries requested from the array per page
+    page_size = 1000
+  }
+}
+
+output "powerscale_snapshot_changelist_filtered" {
+  value = data.powerscale_snapshot_changelist.filtered
+}
+
+# After the successful execution of above said block, We can see the output value by executing 'terraform output' command.
+# Also, we can use the fetched information by the variable data.powerscale_snapshot_changelist.all
@@ -0,0 +1,59 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Returns the current values of the statistics keys
+data "powerscale_statistics" "current" {
+  # Statistics keys to query, the valid keys can be discovered with the powerscale_statistics_keys datasource
+  keys = ["ifs.bytes.used", "ifs.bytes.total", "cluster.cpu.user.avg"]
+}
+
+output "powerscale_statistics_current" {
+  value = data.powerscale_statistics.current
+}
+
+# Returns the historical values of the statistics keys
+data "powerscale_statistics" "history" {
+  keys = ["node.cpu.user.avg", "node.protostats.nfs.total"]
+  # Node device IDs to query, 0 being the cluster
+  nodes = [1, 2]
+  # Number of seconds of history to query
+  history_window = 3600
+  # Minimum interval in seconds between the historical values
+  resolution = 300
+}
+
+output "powerscale_statistics_history" {
+  value = data.powerscale_statistics.history
+}
+
+# Changes can be gated on the current cluster load with a precondition
+locals {
+  cluster_cpu = one([for stat in data.powerscale_statistics.current.statistics : stat.value_number if stat.key == "cluster.cpu.user.avg"])
+}
+
+resource "terraform_data" "load_check" {
+  lifecycle {
+    precondition {
+      # node.cpu.user.avg and cluster.cpu.user.avg are reported in tenths of a percent
+      condition     = local.cluster_cpu < 700
+      error_message = "The cluster CPU usage must be below 70%."
+    }
+  }
+}
+
+# After the successful execution of above said block, We can see the output value by executing 'terraform output' command.
+# Also, we can use the fetched information by the variable data.powerscale_statistics.current
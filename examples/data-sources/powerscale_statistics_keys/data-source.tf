@@ -0,0 +1,43 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Returns all of the statistics keys supported by the cluster
+data "powerscale_statistics_keys" "all" {
+}
+
+output "powerscale_statistics_keys_all" {
+  value = data.powerscale_statistics_keys.all
+}
+
+# Returns the statistics keys matching the filters provided in the filter block
+data "powerscale_statistics_keys" "filtered" {
+  filter {
+    # Names of the keys
+    keys = ["ifs.bytes.used", "node.cpu.user.avg"]
+    # Substring of the names of the keys
+    substr = "cpu"
+    # Only list the keys that can be queried
+    queryable = true
+  }
+}
+
+output "powerscale_statistics_keys_filtered" {
+  value = data.powerscale_statistics_keys.filtered
+}
+
+# After the successful execution of above said block, We can see the output value by executing 'terraform output' command.
+# Also, we can use the fetched information by the variable data.powerscale_statistics_keys.all
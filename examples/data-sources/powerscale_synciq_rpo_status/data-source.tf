@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+# Returns the RPO status of all the SyncIQ policies of the PowerScale cluster
+data "powerscale_synciq_rpo_status" "all" {
+}
+
+output "powerscale_synciq_rpo_status_all" {
+  value = data.powerscale_synciq_rpo_status.all
+}
+
+# Returns the RPO status of the SyncIQ policies matching the filters provided in the filter block
+data "powerscale_synciq_rpo_status" "breached" {
+  filter {
+    # Names of the policies
+    names = ["Policy1", "Policy2"]
+    # Whether the policies missed their RPO
+    breached = true
+  }
+}
+
+output "powerscale_synciq_rpo_status_breached" {
+  value = data.powerscale_synciq_rpo_status.breached
+}
+
+# Policies which missed their RPO can be reported from a check block
+check "synciq_rpo" {
+  assert {
+    condition     = length(data.powerscale_synciq_rpo_status.breached.synciq_rpo_status) == 0
+    error_message = "SyncIQ policies missed their RPO: ${join(", ", data.powerscale_synciq_rpo_status.breached.synciq_rpo_status[*].policy_name)}"
+  }
+}
+
+# After the successful execution of above said block, We can see the output value by executing 'terraform output' command.
+# Also, we can use the fetched information by the variable data.powerscale_synciq_rpo_status.all
@@ -0,0 +1,44 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Returns all of the SyncIQ policies that replicate to this PowerScale cluster, as seen from the target cluster
+data "powerscale_synciq_target_policy" "all" {
+}
+
+output "powerscale_synciq_target_policy_all" {
+  value = data.powerscale_synciq_target_policy.all
+}
+
+# Returns the SyncIQ target policies matching the filters provided in the filter block
+data "powerscale_synciq_target_policy" "filtered" {
+  filter {
+    # Name or ID of the target policies
+    names = ["TerraformPolicy"]
+    # Target directory of the target policies
+    target_path = "/ifs/terraformAT/target"
+    # Failover or failback state of the target directory
+    # Options: writes_disabled, enabling_writes, writes_enabled, disabling_writes, creating_resync_policy, resync_policy_created
+    failover_failback_state = "writes_disabled"
+  }
+}
+
+output "powerscale_synciq_target_policy_filtered" {
+  value = data.powerscale_synciq_target_policy.filtered
+}
+
+# After the successful execution of above said block, We can see the output value by executing 'terraform output' command.
+# Also, we can use the fetched information by the variable data.powerscale_synciq_target_policy.all
@@ -0,0 +1,42 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Returns all of the reports of the SyncIQ jobs run on this PowerScale cluster as a target
+data "powerscale_synciq_target_report" "all" {
+}
+
+output "powerscale_synciq_target_report_all" {
+  value = data.powerscale_synciq_target_report.all
+}
+
+# Returns the SyncIQ target reports matching the filters provided in the filter block
+data "powerscale_synciq_target_report" "filtered" {
+  filter {
+    policy_name        = "TerraformPolicy"
+    reports_per_policy = 1
+    state              = "finished"
+    sort               = "end_time"
+    dir                = "DESC"
+  }
+}
+
+output "powerscale_synciq_target_report_filtered" {
+  value = data.powerscale_synciq_target_report.filtered
+}
+
+# After the successful execution of above said block, We can see the output value by executing 'terraform output' command.
+# Also, we can use the fetched information by the variable data.powerscale_synciq_target_report.all
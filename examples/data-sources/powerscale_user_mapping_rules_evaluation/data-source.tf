@@ -0,0 +1,104 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# PowerScale User Mapping Rules combines user identities from different directory services into a single access token and then modifies it according to configured rules.
+
+# Evaluates a candidate list of User Mapping Rules against sample identities at plan time, without applying the rules to the PowerScale Array.
+data "powerscale_user_mapping_rules_evaluation" "candidate" {
+  # Required. The candidate rules, with the same attributes as the rules of the powerscale_user_mapping_rules resource.
+  rules = [
+    {
+      # Joins the users of the AD domain with the UNIX users of the same name
+      operator = "union"
+      target_user = {
+        domain = "AD"
+        user   = "*"
+      }
+      source_user = {
+        user = "*"
+      }
+    },
+    {
+      # Maps the AD guest to the nobody user
+      operator = "replace"
+      options = {
+        break = true
+        default_user = {
+          user = "nobody"
+        }
+      }
+      target_user = {
+        domain = "AD"
+        user   = "guest"
+      }
+      source_user = {
+        user = "nobody"
+      }
+    },
+  ]
+
+  # Required. The identities stand for the directory services of the zone.
+  identities = [
+    {
+      domain = "AD"
+      name   = "alice"
+      sid    = "SID:S-1-5-21-100-200-300-1001"
+      primary_group = {
+        name = "AD\\domain users"
+        sid  = "SID:S-1-5-21-100-200-300-513"
+      }
+    },
+    {
+      name = "alice"
+      uid  = "UID:2001"
+      sid  = "SID:S-1-22-1-2001"
+      primary_group = {
+        name = "alice"
+        gid  = "GID:2001"
+        sid  = "SID:S-1-22-2-2001"
+      }
+      groups = [
+        {
+          name = "eng"
+          gid  = "GID:3000"
+        }
+      ]
+    },
+    {
+      domain = "AD"
+      name   = "guest"
+      sid    = "SID:S-1-5-21-100-200-300-501"
+    },
+    {
+      name = "nobody"
+      uid  = "UID:65534"
+      sid  = "SID:S-1-22-1-65534"
+    },
+  ]
+
+  # Required. The users to evaluate the rules against, written as [domain\]name.
+  users = ["AD\\alice", "AD\\guest"]
+}
+
+# Output value of above block by executing 'terraform output' command
+# The user can use the access tokens the users map to by the variable data.powerscale_user_mapping_rules_evaluation.candidate.results
+output "powerscale_user_mapping_rules_evaluation" {
+  value = data.powerscale_user_mapping_rules_evaluation.candidate.results
+}
+
+# The rules of an existing zone can be evaluated as well, ex. to check the effect of an additional rule before applying it.
+# The same evaluation is available as the provider::powerscale::evaluate_user_mapping_rules function.
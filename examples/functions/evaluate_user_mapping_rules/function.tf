@@ -0,0 +1,59 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Evaluates a candidate list of user mapping rules against sample identities, without applying the rules to the PowerScale Array.
+# The function requires Terraform 1.8 or later.
+data "powerscale_user_mapping_rules" "current" {
+  filter {
+    zone = "System"
+  }
+}
+
+locals {
+  identities = [
+    {
+      domain = "AD"
+      name   = "alice"
+      sid    = "SID:S-1-5-21-100-200-300-1001"
+    },
+    {
+      name = "alice"
+      uid  = "UID:2001"
+      sid  = "SID:S-1-22-1-2001"
+    },
+  ]
+}
+
+# Evaluates the current rules of the zone followed by a new rule
+output "evaluated_mapping" {
+  value = provider::powerscale::evaluate_user_mapping_rules(
+    concat(data.powerscale_user_mapping_rules.current.user_mapping_rules, [
+      {
+        operator = "union"
+        target_user = {
+          domain = "AD"
+          user   = "*"
+        }
+        source_user = {
+          user = "*"
+        }
+      },
+    ]),
+    local.identities,
+    ["AD\\alice"],
+  )
+}
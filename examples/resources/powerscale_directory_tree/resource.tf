@@ -0,0 +1,66 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete.
+# After `terraform apply` of this example file it will create the directories of the tree below the root path.
+# The directories are created parents first and deleted children first, and the directories created are deleted again if a later step fails.
+# The changes made to the directories outside of Terraform are reported as warnings and reverted on the next apply.
+
+# PowerScale directory tree allows you to manage the directory skeleton of a project with a single resource
+resource "powerscale_directory_tree" "project" {
+  # Required: path of the existing directory the directories are created in. Updating it recreates the tree.
+  root_path = "/ifs/projects/alpha"
+
+  # Optional: how the directories are deleted, fail_if_not_empty, recursive or tree_delete_job. The Default value is fail_if_not_empty.
+  delete_mode = "fail_if_not_empty"
+
+  # Required: directories of the tree, the parent of each directory must be the root path or another directory of the tree.
+  directories = [
+    {
+      # Required: path of the directory relative to the root path
+      path = "data"
+      # Optional: names of the user and the group owning the directory
+      owner = "alpha-admin"
+      group = "alpha"
+      # Optional: POSIX permissions or predefined access control, ex. private_read, private, public_read, public_read_write, public
+      access_control = "0770"
+    },
+    {
+      path           = "data/archive"
+      owner          = "alpha-admin"
+      group          = "alpha"
+      access_control = "0750"
+      # Optional: directory quota of the directory, with its thresholds in bytes
+      quota = {
+        hard       = 10995116277760
+        soft       = 8796093022208
+        soft_grace = 604800
+        advisory   = 7696581394432
+      }
+    },
+    {
+      path  = "scratch"
+      group = "alpha"
+      quota = {
+        hard = 1099511627776
+      }
+    },
+  ]
+}
+
+# After the execution of above resource block, directory tree would have been created on the PowerScale array.
+# For more information, Please check the terraform state file.
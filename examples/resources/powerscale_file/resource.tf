@@ -0,0 +1,70 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update (content, source, owner, group, access_control), Delete and Import existing file from PowerScale array.
+# After `terraform apply` of this example file it will write a file with the name set in `name` attribute in the directory provided in `directory_path` on the PowerScale array.
+# The content of the file is tracked by its SHA-256 hash, so changes made to the file on the cluster are overwritten on the next apply.
+
+# PowerScale File Resource allows you to manage a file in the namespace of the PowerScale array
+resource "powerscale_file" "readme" {
+  # Required attributes
+  # Directory of the file, which must exist
+  directory_path = "/ifs/projects/alpha"
+  name           = "README"
+  group = {
+    name = "wheel"
+  }
+  owner = {
+    name = "root"
+  }
+
+  # Content of the file, conflicts with source
+  content = "Project alpha data. Contact storage-admins before deleting.\n"
+
+  # Optional: The ACL value for the file. Users can either provide access rights input such as 'private_read' , 'private' ,
+  # 'public_read', 'public_read_write', 'public' or permissions in POSIX format as '0600', '0640', '0644' or '0755'.
+  # Modification of ACL is only supported from POSIX to POSIX mode.
+  access_control = "0644"
+
+  # Optional : query_zone, this will default to the default access zone if unset.
+  # query_zone = "System"
+
+  # Optional: Replaces an existing file with the same name on creation, when set to true.
+  # overwrite = false
+
+  # Optional: user-defined metadata attributes of the file, other user-defined attributes are left untouched.
+  user_metadata = {
+    team = "alpha"
+  }
+}
+
+# The content can also be read from a local file
+resource "powerscale_file" "authorized_keys" {
+  directory_path = "/ifs/home/alice/.ssh"
+  name           = "authorized_keys"
+  # Path of the local file whose content is written, conflicts with content
+  source         = "${path.module}/authorized_keys"
+  access_control = "0600"
+  owner = {
+    name = "alice"
+  }
+  group = {
+    name = "Isilon Users"
+  }
+}
+
+# After the execution of above resource block, the files would have been created at PowerScale array. You can also verify the changes made in terraform state file.
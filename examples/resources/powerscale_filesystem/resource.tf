@@ -15,7 +15,8 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-# Available actions: Create, Update (owner, group, access_control), Delete and Import existing FileSystem(Namespace directory) from Powerscale array.
+# Available actions: Create, Update (name, directory_path, owner, group, access_control), Delete and Import existing FileSystem(Namespace directory) from Powerscale array.
+# Changing name or directory_path renames or moves the directory in place, keeping its content, ACL and quotas.
 # After `terraform apply` of this example file it will create a new FileSystem(Namespace directory) with the name set in `name` attribute in the directory path provided in `directory_path`on the PowerScale array
 
 # PowerScale FileSystem Resource allows you to manage the Namespace Directory on the Powerscale array
@@ -45,6 +46,17 @@ resource "powerscale_filesystem" "file_system_test" {
   recursive = true
   # Deletes and replaces the existing user attributes and ACLs of the directory with user-specified attributes and ACLS, when set to true.
   overwrite = false
+  # How the directory is deleted on destroy. The deletion is refused while NFS exports, SMB shares, S3 buckets or quotas point at the directory.
+  # Options: fail_if_not_empty, recursive (namespace API), tree_delete_job (TreeDelete job, faster for large trees)
+  delete_mode = "fail_if_not_empty"
+
+  # Optional: user-defined metadata attributes of the directory.
+  # Only the attributes configured here are managed, other user-defined attributes are left untouched.
+  # user_metadata = {
+  #   team                = "storage"
+  #   cost_center         = "cc-42"
+  #   data_classification = "internal"
+  # }
 
 
   /* Optional : The ACL value for the directory. Users can either provide access rights input such as 'private_read' , 'private' ,
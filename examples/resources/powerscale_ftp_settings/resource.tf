@@ -0,0 +1,40 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete and Import.
+# If resource arguments are omitted, `terraform apply` will load FTP settings from PowerScale, and save to terraform state file.
+# If any resource arguments are specified, `terraform apply` will try to load FTP settings (if not loaded) and update the settings.
+# `terraform destroy` will delete the resource from terraform state file rather than deleting FTP settings from PowerScale.
+# For more information, Please check the terraform state file.
+
+# PowerScale FTP Settings allow you to configure the FTP service on PowerScale, such as anonymous access, chroot options, server-to-server transfers, denied users and session timeout.
+resource "powerscale_ftp_settings" "example" {
+  # Optional fields both for creating and updating
+  #  allow_anon_access = false
+  #  allow_anon_upload = false
+  #  anon_root_path = "/ifs/home/ftp"
+  #  chroot_local_mode = "all-with-exceptions"
+  #  chroot_exception_list = ["admin"]
+  #  server_to_server = false
+  #  denied_user_list = ["guest"]
+  #  session_timeout = 300
+  #  service = true
+}
+
+# After the execution of above resource block, FTP settings would have been cached in terraform state file, or
+# FTP settings would have been updated on PowerScale.
+# For more information, Please check the terraform state file.
\ No newline at end of file
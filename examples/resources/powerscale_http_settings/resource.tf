@@ -0,0 +1,41 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete and Import.
+# If resource arguments are omitted, `terraform apply` will load HTTP settings from PowerScale, and save to terraform state file.
+# If any resource arguments are specified, `terraform apply` will try to load HTTP settings (if not loaded) and update the settings.
+# `terraform destroy` will delete the resource from terraform state file rather than deleting HTTP settings from PowerScale.
+# For more information, Please check the terraform state file.
+
+# PowerScale HTTP Settings allow you to configure the HTTP service on PowerScale, such as access control, authentication, WebDAV, HTTP/HTTPS and TLS options.
+resource "powerscale_http_settings" "example" {
+  # Optional fields both for creating and updating
+  #  access_control = false
+  #  basic_authentication = true
+  #  integrated_authentication = false
+  #  dav = false
+  #  enable_access_log = true
+  #  https = true
+  #  service = "redirect"
+  #  server_root = "/ifs"
+  #  service_timeout = 60
+  #  tls_min_version = "1.2"
+}
+
+# After the execution of above resource block, HTTP settings would have been cached in terraform state file, or
+# HTTP settings would have been updated on PowerScale.
+# For more information, Please check the terraform state file.
\ No newline at end of file
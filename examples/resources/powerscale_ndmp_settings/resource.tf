@@ -0,0 +1,40 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete and Import.
+# If resource arguments are omitted, `terraform apply` will load NDMP settings from PowerScale, and save to terraform state file.
+# If any resource arguments are specified, `terraform apply` will try to load NDMP settings (if not loaded) and update the settings.
+# `terraform destroy` will delete the resource from terraform state file rather than deleting NDMP settings from PowerScale.
+# For more information, Please check the terraform state file.
+
+# PowerScale NDMP Settings allow you to configure the NDMP service on PowerScale, such as the data management application, the port and the restartable backups.
+resource "powerscale_ndmp_settings" "example" {
+  # Optional fields both for creating and updating
+  #  dma = "generic"
+  #  port = 10000
+  #  service = true
+  #  bre_max_num_contexts = 64
+  #  msb_context_retention_duration = 300
+  #  msr_context_retention_duration = 600
+  #  enable_redirector = false
+  #  enable_throttler = false
+  #  throttler_cpu_threshold = 50
+}
+
+# After the execution of above resource block, NDMP settings would have been cached in terraform state file, or
+# NDMP settings would have been updated on PowerScale.
+# For more information, Please check the terraform state file.
\ No newline at end of file
@@ -0,0 +1,21 @@
+# Copyright (c) 2023-2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+# Licensed under the Mozilla Public License Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+
+#     http://mozilla.org/MPL/2.0/
+
+
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# The command is
+# terraform import powerscale_ndmp_user.example <name>
+# Example:
+terraform import powerscale_ndmp_user.example ndmp_backup
+# after running this command, populate the password field in the config file to start managing this resource.
+# Note: the password is write-only, so it is not imported. Running "terraform show" after importing shows the current config/state of the resource.
@@ -0,0 +1,35 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete and Import.
+# After `terraform apply` of this example file it will create the NDMP user on PowerScale.
+# `terraform destroy` will delete the NDMP user from PowerScale.
+
+# The password is write-only, which requires Terraform 1.11 or later: it is sent to PowerScale
+# but never stored in the plan or the state file. Since Terraform can not detect a password change,
+# bump password_version whenever the password is rotated to push it to PowerScale again.
+resource "powerscale_ndmp_user" "example" {
+  # Required fields
+  name     = "ndmp_backup"
+  password = "testPassword"
+
+  # Optional fields
+  password_version = 1
+}
+
+# After the execution of above resource block, the NDMP user would have been created on PowerScale.
+# For more information, Please check the terraform state file.
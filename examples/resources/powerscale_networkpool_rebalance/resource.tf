@@ -0,0 +1,37 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+# Available actions: Create, Update and Delete
+# After `terraform apply` of this example file it will trigger a SmartConnect rebalance of the IP addresses of the network pool and wait until the IP assignments of the pool stabilize
+
+# Note: Changing the triggers rebalances the pool again.
+# Destroying this resource only removes it from the state, a rebalance cannot be undone.
+resource "powerscale_networkpool_rebalance" "example" {
+  # Required groupnet, subnet and name of the pool to rebalance. These cannot be changed after create
+  groupnet = "groupnet0"
+  subnet   = "subnet0"
+  pool     = "pool0"
+
+  # Optional arbitrary values which rebalance the pool again whenever they change
+  triggers = {
+    run = "1"
+  }
+
+  # Optional time in seconds to wait for the IP assignments of the pool to stabilize. Defaults to 300
+  # wait_timeout = 600
+}
+
+# After the execution of above resource block, the IP assignments of the pool after the rebalance are recorded in the state.
@@ -0,0 +1,40 @@
+/*
+Copyright (c) 2023-2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+terraform {
+  required_providers {
+    powerscale = {
+      source = "registry.terraform.io/dell/powerscale"
+    }
+  }
+}
+
+provider "powerscale" {
+  username = var.username
+  password = var.password
+  endpoint = var.endpoint
+  insecure = var.insecure
+
+  ## Provider can also be set using environment variables
+  ## If environment variables are set it will override this configuration
+  ## Example environment variables
+  # POWERSCALE_USERNAME="username"
+  # POWERSCALE_PASSWORD="password"
+  # POWERSCALE_ENDPOINT="https://yourhost.host.com:8080"
+  # POWERSCALE_INSECURE="false"
+  # POWERSCALE_TIMEOUT="2000"
+  # POWERSCALE_AUTH_TYPE="0"
+}
\ No newline at end of file
@@ -0,0 +1,47 @@
+/*
+Copyright (c) 2023-2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete and Import
+# After `terraform apply` of this example file it will add the clients to the existing NFS export on the PowerScale Array.
+# For more information, Please check the terraform state file.
+
+# The clients are merged with the other clients of the list.
+# The client lists of powerscale_nfs_export are authoritative: when the export is managed by Terraform as well,
+# add the client list to its ignore_changes and do not manage the same client in both places.
+resource "powerscale_nfs_export_client" "subnet_read_only" {
+  # Required, the NFS export is given either by export_id or by path
+  path   = "/ifs/nfs_export_example"
+  client = "10.10.0.0/24"
+
+  # Optional, defaults to the System access zone
+  # zone = "System"
+
+  # Optional, one of clients, root_clients, read_only_clients and read_write_clients, defaults to clients
+  list_type = "read_only_clients"
+}
+
+resource "powerscale_nfs_export_client" "backup_host_root" {
+  export_id = 3
+  client    = "backup.example.com"
+  list_type = "root_clients"
+
+  # Optional, does not fail when the host name cannot be resolved
+  ignore_unresolvable_hosts = true
+}
+
+# After the execution of above resource blocks, the clients would have been added to the NFS export on the PowerScale Array.
+# For more information, Please check the terraform state file.
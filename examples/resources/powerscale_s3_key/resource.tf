@@ -27,6 +27,22 @@ resource "powerscale_s3_key" "skm" {
   existing_key_expiry_time = 10
 }
 
+# The key can be rotated automatically on routine applies.
+# The key is regenerated once it is older than rotation_days, or rotate_before_expiry days earlier,
+# and the old key stays valid for existing_key_expiry_time minutes.
+resource "powerscale_s3_key" "rotated" {
+  user                     = "tf_user2"
+  zone                     = "System"
+  existing_key_expiry_time = 1440
+  rotation_days            = 90
+  rotate_before_expiry     = 7
+
+  # Optional, changing any value regenerates the key
+  keepers = {
+    version = "1"
+  }
+}
+
 output "key" {
   value = powerscale_s3_key.skm
 }
\ No newline at end of file
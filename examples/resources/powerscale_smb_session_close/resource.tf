@@ -0,0 +1,43 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create and Delete
+# After `terraform apply` of this example file it will close the matching SMB open files and sessions on the PowerScale cluster
+
+# Note: Closing the open files and sessions interrupts the clients, which may lose unsaved data.
+# Destroying this resource only removes it from the state. Use replace_triggered_by to close them again.
+resource "powerscale_smb_session_close" "example" {
+  # Optional directory of the open files to close, ex. the path of a share
+  path = "/ifs/smb_share_example"
+
+  # Optional user of the open files and sessions to close
+  # user = "user1"
+
+  # Optional client computer of the sessions to close
+  # computer = "10.10.0.1"
+
+  # Optional access zone of the sessions to close, defaults to all the zones
+  # zone = "System"
+
+  # Optional whether to close the open files, defaults to true. A user or path is required to close open files
+  # close_openfiles = true
+
+  # Optional whether to close the sessions, defaults to false. A user or computer is required to close sessions
+  # close_sessions = true
+}
+
+# After the execution of above resource block, the closed open files and sessions are recorded in the state.
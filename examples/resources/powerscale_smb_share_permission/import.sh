@@ -0,0 +1,23 @@
+# Copyright (c) 2023-2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+# Licensed under the Mozilla Public License Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+
+#     http://mozilla.org/MPL/2.0/
+
+
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# The command is
+# terraform import powerscale_smb_share_permission.everyone_read [<zoneID>:]<share_name>:<permission_type>:<trustee_id>
+# Example 1: <zoneID> is Optional, defaults to System:
+terraform import powerscale_smb_share_permission.everyone_read smb_share_example:allow:SID:S-1-1-0
+# Example 2:
+terraform import powerscale_smb_share_permission.everyone_read zone_id:smb_share_example:allow:SID:S-1-1-0
+# after running this command, populate the share_name, permission_type and trustee fields in the config file to start managing this resource.
+# Note: running "terraform show" after importing shows the current config/state of the resource. You can copy/paste that config to make it easier to manage the resource.
@@ -0,0 +1,50 @@
+/*
+Copyright (c) 2023-2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete and Import
+# After `terraform apply` of this example file it will add the permission entries to the existing SMB share on the PowerScale Array.
+# For more information, Please check the terraform state file.
+
+# The permission entries are merged with the other entries of the share.
+# The permissions attribute of powerscale_smb_share is authoritative: when the share is managed by Terraform as well,
+# add permissions to its ignore_changes and do not manage the same entry in both places.
+resource "powerscale_smb_share_permission" "everyone_read" {
+  # Required
+  share_name      = "smb_share_example"
+  permission      = "read"
+  permission_type = "allow"
+  trustee = {
+    id = "SID:S-1-1-0"
+  }
+
+  # Optional, defaults to the System access zone
+  # zone = "System"
+}
+
+resource "powerscale_smb_share_permission" "admin_full" {
+  share_name      = "smb_share_example"
+  permission      = "full"
+  permission_type = "allow"
+  # The trustee can be given by name and type instead of id
+  trustee = {
+    name = "admin"
+    type = "user"
+  }
+}
+
+# After the execution of above resource blocks, the permission entries would have been added to the SMB share on the PowerScale Array.
+# For more information, Please check the terraform state file.
@@ -0,0 +1,37 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Delete and Import
+# After `terraform apply` of this example file it will launch a ChangelistCreate job for the snapshot pair and wait for the changelist to be created on the PowerScale
+
+# A snapshot changelist records the files and directories that changed between two snapshots of the same path.
+resource "powerscale_snapshot_changelist" "example" {
+
+  # Required ID of the older snapshot of the pair. This cannot be changed after create
+  older_snapshot_id = 10
+
+  # Required ID of the newer snapshot of the pair. Must be greater than older_snapshot_id. This cannot be changed after create
+  newer_snapshot_id = 12
+
+  # Optional whether to retain the repstate of the snapshots after the changelist is created
+  # retain_repstate = false
+
+  # Optional whether or not to queue the job if one of the same type is already running or queued
+  # allow_dup = false
+}
+
+# After the execution of above resource block, snapshot changelist would have been created on the PowerScale array. For more information, Please check the terraform state file.
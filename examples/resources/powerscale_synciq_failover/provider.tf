@@ -0,0 +1,41 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+terraform {
+  required_providers {
+    powerscale = {
+      source = "registry.terraform.io/dell/powerscale"
+    }
+  }
+}
+
+# Provider of the source cluster of the SyncIQ policy
+provider "powerscale" {
+  alias    = "source"
+  username = var.source_username
+  password = var.source_password
+  endpoint = var.source_endpoint
+  insecure = var.insecure
+}
+
+# Provider of the target cluster of the SyncIQ policy
+provider "powerscale" {
+  alias    = "target"
+  username = var.target_username
+  password = var.target_password
+  endpoint = var.target_endpoint
+  insecure = var.insecure
+}
@@ -0,0 +1,62 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update (direction) and Delete
+# After `terraform apply` of this example file it will fail over the SyncIQ policy to the target cluster
+
+# Note: A Terraform resource is bound to a single provider configuration. This resource is managed through the provider of the source cluster,
+# and reaches the target cluster with the `target_cluster` connection details, which are the same values the target provider alias is configured with.
+# Note: Destroying this resource only removes it from the state, it does not revert the failover.
+resource "powerscale_synciq_failover" "example" {
+  provider = powerscale.source
+
+  # Required name of the SyncIQ policy on the source cluster. This cannot be changed after create
+  policy_name = "TerraformPolicy"
+
+  # Required workflow to drive. Changing the direction runs the corresponding workflow
+  # failover - allow writes on the target cluster
+  # failback - resync_prep on the source cluster, run the mirror policy on the target cluster,
+  #            allow writes on the source cluster and resync_prep the mirror policy on the target cluster
+  direction = "failover"
+
+  # Optional name of the mirror policy created by resync_prep on the target cluster. Defaults to <policy_name>_mirror
+  # mirror_policy_name = "TerraformPolicy_mirror"
+
+  # Optional whether to run the policy once more before allowing writes on the target cluster (planned failover). Defaults to false
+  # sync_before_failover = true
+
+  # Optional time in seconds to wait for each job of the workflow. Defaults to 3600
+  # job_timeout = 3600
+
+  # Required connection details of the target cluster
+  target_cluster = {
+    endpoint = var.target_endpoint
+    username = var.target_username
+    password = var.target_password
+    insecure = var.insecure
+  }
+}
+
+# The data on the target cluster can be managed with the target provider alias once the failover is complete
+data "powerscale_synciq_policy" "target_policies" {
+  provider   = powerscale.target
+  depends_on = [powerscale_synciq_failover.example]
+}
+
+# After the execution of above resource block, the completed steps of the workflow are recorded in the state.
+# If a step fails, the next `terraform apply` resumes the workflow from the last completed step.
+# Steps whose effect is already visible in the policy states are skipped, so a resource replaced after a failed create does not repeat them.
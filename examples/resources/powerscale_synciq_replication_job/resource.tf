@@ -25,5 +25,22 @@ resource "powerscale_synciq_replication_job" "job1" {
   is_paused = false             # change job state to running or paused.
 }
 
+# Assessment mode runs a test job of the policy, waits for it to end and records its report in assessment_report.
+# The apply fails when the report exceeds any of the configured thresholds. Changing the assessment runs it again.
+resource "powerscale_synciq_replication_job" "assessment" {
+  action = "test"            # assessment is only supported with the test action
+  id     = "TerraformPolicy" # id/name of the synciq policy to assess.
+  assessment = {
+    timeout            = 3600        # time in seconds to wait for the assessment job to end. Defaults to 3600
+    max_files_selected = 1000000     # maximum number of files the assessment may select
+    max_bytes_selected = 10995116277 # maximum number of bytes the assessment may select
+    max_errors         = 0           # maximum number of errors the assessment may report
+  }
+}
+
+output "synciq_assessment_report" {
+  value = powerscale_synciq_replication_job.assessment.assessment_report
+}
+
 # There are other attributes values as well. Please refer the documentation.
 # After the execution of above resource block, job would have been extecuted/updated on the PowerScale array. For more information, Please check the terraform state file.
\ No newline at end of file
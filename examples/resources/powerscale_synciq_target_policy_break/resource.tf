@@ -0,0 +1,32 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create and Delete
+# After `terraform apply` of this example file it will break the association between the target directory of the SyncIQ policy and its source cluster
+
+# Note: This resource must be managed through the provider of the target cluster of the policy.
+# Note: Breaking the association makes the target directory writable and forces the next job of the policy to run a full replication.
+# Destroying this resource only removes it from the state, a broken association cannot be restored.
+resource "powerscale_synciq_target_policy_break" "example" {
+  # Required name or ID of the target policy. This cannot be changed after create
+  policy_name = "TerraformPolicy"
+
+  # Optional whether to break the association without contacting the source cluster, use it when the source cluster is unreachable. Defaults to false
+  # force = true
+}
+
+# After the execution of above resource block, the target path and source host of the broken association are recorded in the state.
@@ -0,0 +1,73 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete and Import.
+# After `terraform apply` of this example file it will insert the user mapping rules into the rule list of the zone, keeping the other rules.
+# `terraform destroy` removes only the rules managed by these resources.
+# Do not use this resource together with powerscale_user_mapping_rules on the same zone, as the latter manages the whole rule list.
+# For more information, Please check the terraform state file.
+
+# PowerScale User Mapping Rules combines user identities from different directory services into a single access token and then modifies it according to configured rules.
+resource "powerscale_user_mapping_rule" "first" {
+  # Required. The operator, target_user and source_user identify the rule, changing them replaces it.
+  operator = "append"
+  target_user = {
+    user = "Guest"
+    # domain = "domain"
+  }
+
+  # Optional, not used by the trim operator
+  source_user = {
+    user = "admin"
+  }
+
+  # The zone to which the user mapping applies. Defaults to System
+  # zone = "System"
+
+  # Optional, can be updated
+  options = {
+    break  = true
+    group  = true
+    groups = true
+    user   = true
+    # default_user = {
+    #   domain = "domain"
+    #   user   = "Guest"
+    # }
+  }
+
+  # Optional placement, at most one of position, before and after, can be updated.
+  # The rule is appended when no placement is given.
+  position = 0
+}
+
+resource "powerscale_user_mapping_rule" "second" {
+  operator = "union"
+  target_user = {
+    user = "Guest"
+  }
+  source_user = {
+    user = "admin"
+  }
+
+  # Inserts the rule right after the rule given by its id
+  after = powerscale_user_mapping_rule.first.id
+  # before = powerscale_user_mapping_rule.first.id
+}
+
+# After the execution of above resource blocks, the user mapping rules would have been inserted into the rule list of the zone on the PowerScale Array.
+# For more information, Please check the terraform state file.
@@ -511,4 +511,175 @@ const (
 
 	// DeleteStoragepoolTierErrorMsg specifies error details occurred while deleting Storage pool Tier.
 	DeleteStoragepoolTierErrorMsg = "Could not delete storagepool tier "
+
+	// CreateSnapshotChangelistErrorMsg specifies error details occurred while creating snapshot changelist.
+	CreateSnapshotChangelistErrorMsg = "Could not create snapshot changelist "
+
+	// ReadSnapshotChangelistErrorMsg specifies error details occurred while reading snapshot changelist.
+	ReadSnapshotChangelistErrorMsg = "Could not read snapshot changelist "
+
+	// DeleteSnapshotChangelistErrorMsg specifies error details occurred while deleting snapshot changelist.
+	DeleteSnapshotChangelistErrorMsg = "Could not delete snapshot changelist "
+
+	// SyncIQFailoverTargetClientErrorMsg specifies error details occurred while connecting to the SyncIQ target cluster.
+	SyncIQFailoverTargetClientErrorMsg = "Could not connect to the SyncIQ target cluster "
+
+	// SyncIQFailoverStepErrorMsg specifies error details occurred while running a step of the SyncIQ failover workflow.
+	SyncIQFailoverStepErrorMsg = "Could not complete the SyncIQ failover step "
+
+	// ReadSyncIQTargetPoliciesErrorMsg specifies error details occurred while reading SyncIQ target policies.
+	ReadSyncIQTargetPoliciesErrorMsg = "Could not read SyncIQ target policies "
+
+	// BreakSyncIQTargetPolicyErrorMsg specifies error details occurred while breaking a SyncIQ target policy association.
+	BreakSyncIQTargetPolicyErrorMsg = "Could not break SyncIQ target policy association "
+
+	// ReadSyncIQTargetReportsErrorMsg specifies error details occurred while reading SyncIQ target reports.
+	ReadSyncIQTargetReportsErrorMsg = "Could not read SyncIQ target reports "
+
+	// ReadNodeInventoryErrorMsg specifies error details occurred while reading the node inventory.
+	ReadNodeInventoryErrorMsg = "Could not read node inventory "
+
+	// ReadNodePoolsErrorMsg specifies error details occurred while reading the node pools.
+	ReadNodePoolsErrorMsg = "Could not read node pools "
+
+	// ReadStatisticsErrorMsg specifies error details occurred while reading statistics.
+	ReadStatisticsErrorMsg = "Could not read statistics "
+
+	// ReadStatisticsKeysErrorMsg specifies error details occurred while reading statistics keys.
+	ReadStatisticsKeysErrorMsg = "Could not read statistics keys "
+
+	// ReadFileSystemReferencesErrorMsg specifies error details occurred while reading the exports, shares, buckets and quotas of a File System.
+	ReadFileSystemReferencesErrorMsg = "Could not read the references of file system "
+
+	// TreeDeleteJobErrorMsg specifies error details occurred while running a TreeDelete job.
+	TreeDeleteJobErrorMsg = "Could not run TreeDelete job "
+
+	// CreateFileErrorMsg specifies error details occurred while creating a file.
+	CreateFileErrorMsg = "Could not create file "
+
+	// ReadFileErrorMsg specifies error details occurred while reading a file.
+	ReadFileErrorMsg = "Could not read file "
+
+	// UpdateFileErrorMsg specifies error details occurred while updating a file.
+	UpdateFileErrorMsg = "Could not update file "
+
+	// DeleteFileErrorMsg specifies error details occurred while deleting a file.
+	DeleteFileErrorMsg = "Could not delete file "
+
+	// MoveFileSystemErrorMsg specifies error details occurred while moving a File System.
+	MoveFileSystemErrorMsg = "Could not move file system "
+
+	// SetUserMetadataErrorMsg specifies error details occurred while setting the user metadata of a directory or a file.
+	SetUserMetadataErrorMsg = "Could not set user metadata "
+
+	// ReadNamespaceQueryErrorMsg specifies error details occurred while querying the namespace.
+	ReadNamespaceQueryErrorMsg = "Could not query the namespace "
+
+	// CreateDirectoryTreeErrorMsg specifies error details occurred while creating a directory tree.
+	CreateDirectoryTreeErrorMsg = "Could not create directory tree "
+
+	// ReadDirectoryTreeErrorMsg specifies error details occurred while reading a directory tree.
+	ReadDirectoryTreeErrorMsg = "Could not read directory tree "
+
+	// UpdateDirectoryTreeErrorMsg specifies error details occurred while updating a directory tree.
+	UpdateDirectoryTreeErrorMsg = "Could not update directory tree "
+
+	// DeleteDirectoryTreeErrorMsg specifies error details occurred while deleting a directory tree.
+	DeleteDirectoryTreeErrorMsg = "Could not delete directory tree "
+
+	// CreateSmbSharePermissionErrorMsg specifies error details occurred while adding an smb share permission.
+	CreateSmbSharePermissionErrorMsg = "Could not add smb share permission "
+
+	// GetSmbSharePermissionErrorMsg specifies error details occurred while reading an smb share permission.
+	GetSmbSharePermissionErrorMsg = "Could not read smb share permission "
+
+	// UpdateSmbSharePermissionErrorMsg specifies error details occurred while updating an smb share permission.
+	UpdateSmbSharePermissionErrorMsg = "Could not update smb share permission "
+
+	// DeleteSmbSharePermissionErrorMsg specifies error details occurred while removing an smb share permission.
+	DeleteSmbSharePermissionErrorMsg = "Could not remove smb share permission "
+
+	// CreateUserMappingRuleErrorMsg specifies error details occurred while creating a user mapping rule.
+	CreateUserMappingRuleErrorMsg = "Could not create user mapping rule "
+
+	// ReadUserMappingRuleErrorMsg specifies error details occurred while reading a user mapping rule.
+	ReadUserMappingRuleErrorMsg = "Could not read user mapping rule "
+
+	// UpdateUserMappingRuleErrorMsg specifies error details occurred while updating a user mapping rule.
+	UpdateUserMappingRuleErrorMsg = "Could not update user mapping rule "
+
+	// DeleteUserMappingRuleErrorMsg specifies error details occurred while deleting a user mapping rule.
+	DeleteUserMappingRuleErrorMsg = "Could not delete user mapping rule "
+
+	// CreateNfsExportClientErrorMsg specifies error details occurred while adding a client to an nfs export.
+	CreateNfsExportClientErrorMsg = "Could not add nfs export client "
+
+	// GetNfsExportClientErrorMsg specifies error details occurred while getting a client of an nfs export.
+	GetNfsExportClientErrorMsg = "Could not get nfs export client "
+
+	// DeleteNfsExportClientErrorMsg specifies error details occurred while removing a client from an nfs export.
+	DeleteNfsExportClientErrorMsg = "Could not remove nfs export client "
+
+	// ReadSmbSessionsErrorMsg specifies error details occurred while reading smb sessions.
+	ReadSmbSessionsErrorMsg = "Could not read smb sessions "
+
+	// ReadSmbOpenfilesErrorMsg specifies error details occurred while reading smb open files.
+	ReadSmbOpenfilesErrorMsg = "Could not read smb open files "
+
+	// ReadNfsClientsErrorMsg specifies error details occurred while reading nfs clients.
+	ReadNfsClientsErrorMsg = "Could not read nfs clients "
+
+	// CloseSmbSessionErrorMsg specifies error details occurred while closing an smb session.
+	CloseSmbSessionErrorMsg = "Could not close smb session "
+
+	// CloseSmbOpenfileErrorMsg specifies error details occurred while closing an smb open file.
+	CloseSmbOpenfileErrorMsg = "Could not close smb open file "
+
+	// ReadFtpSettingsErrorMsg specifies error details occurred while reading ftp settings.
+	ReadFtpSettingsErrorMsg = "Could not read ftp settings "
+
+	// UpdateFtpSettingsErrorMsg specifies error details occurred while updating ftp settings.
+	UpdateFtpSettingsErrorMsg = "Could not update ftp settings "
+
+	// ReadHTTPSettingsErrorMsg specifies error details occurred while reading http settings.
+	ReadHTTPSettingsErrorMsg = "Could not read http settings "
+
+	// UpdateHTTPSettingsErrorMsg specifies error details occurred while updating http settings.
+	UpdateHTTPSettingsErrorMsg = "Could not update http settings "
+
+	// ReadNdmpSettingsErrorMsg specifies error details occurred while reading ndmp settings.
+	ReadNdmpSettingsErrorMsg = "Could not read ndmp settings "
+
+	// UpdateNdmpSettingsErrorMsg specifies error details occurred while updating ndmp settings.
+	UpdateNdmpSettingsErrorMsg = "Could not update ndmp settings "
+
+	// CreateNdmpUserErrorMsg specifies error details occurred while creating an ndmp user.
+	CreateNdmpUserErrorMsg = "Could not create ndmp user "
+
+	// ReadNdmpUserErrorMsg specifies error details occurred while reading an ndmp user.
+	ReadNdmpUserErrorMsg = "Could not read ndmp user "
+
+	// UpdateNdmpUserErrorMsg specifies error details occurred while updating an ndmp user.
+	UpdateNdmpUserErrorMsg = "Could not update ndmp user "
+
+	// DeleteNdmpUserErrorMsg specifies error details occurred while deleting an ndmp user.
+	DeleteNdmpUserErrorMsg = "Could not delete ndmp user "
+
+	// ReadNdmpContextsErrorMsg specifies error details occurred while reading ndmp contexts.
+	ReadNdmpContextsErrorMsg = "Could not read ndmp contexts "
+
+	// ReadNdmpSessionsErrorMsg specifies error details occurred while reading ndmp sessions.
+	ReadNdmpSessionsErrorMsg = "Could not read ndmp sessions "
+
+	// ReadNetworkInterfacesErrorMsg specifies error details occurred while reading network interfaces.
+	ReadNetworkInterfacesErrorMsg = "Could not read network interfaces "
+
+	// RebalanceNetworkPoolErrorMsg specifies error details occurred while rebalancing a network pool.
+	RebalanceNetworkPoolErrorMsg = "Could not rebalance network pool "
+
+	// ReadSyncIQRpoStatusErrorMsg specifies error details occurred while reading the SyncIQ RPO status.
+	ReadSyncIQRpoStatusErrorMsg = "Could not read SyncIQ RPO status "
+
+	// SyncIQAssessmentErrorMsg specifies error details occurred while running a SyncIQ assessment.
+	SyncIQAssessmentErrorMsg = "Could not complete the SyncIQ assessment "
 )
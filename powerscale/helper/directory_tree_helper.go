@@ -0,0 +1,413 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"cmp"
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ValidateDirectoryTreeEntries checks the paths of the directories of a directory tree.
+// The paths must be unique and relative to the root path, and the parent of each directory
+// must be the root path or another directory of the tree.
+func ValidateDirectoryTreeEntries(entries []models.DirectoryTreeEntryModel) error {
+	paths := map[string]bool{}
+	for _, entry := range entries {
+		if entry.Path.IsUnknown() || entry.Path.IsNull() {
+			continue
+		}
+		entryPath := entry.Path.ValueString()
+		if entryPath == "" || strings.HasPrefix(entryPath, "/") || path.Clean(entryPath) != entryPath ||
+			entryPath == ".." || strings.HasPrefix(entryPath, "../") {
+			return fmt.Errorf("invalid directory path '%s', it must be a path relative to the root path, ex. 'data/archive'", entryPath)
+		}
+		if paths[entryPath] {
+			return fmt.Errorf("the directory path '%s' is set more than once", entryPath)
+		}
+		paths[entryPath] = true
+	}
+	for entryPath := range paths {
+		if parent := path.Dir(entryPath); parent != "." && !paths[parent] {
+			return fmt.Errorf("the parent directory '%s' of '%s' must be a directory of the tree", parent, entryPath)
+		}
+	}
+	return nil
+}
+
+// GetDirectoryTreeEntryPath returns the namespace path of a directory of a directory tree.
+func GetDirectoryTreeEntryPath(rootPath string, entryPath string) string {
+	return GetDirectoryPath(rootPath, entryPath)
+}
+
+// sortDirectoryTreeEntries returns the directories of a directory tree, parents first.
+func sortDirectoryTreeEntries(entries []models.DirectoryTreeEntryModel) []models.DirectoryTreeEntryModel {
+	sorted := slices.Clone(entries)
+	slices.SortStableFunc(sorted, func(a, b models.DirectoryTreeEntryModel) int {
+		return cmp.Compare(strings.Count(a.Path.ValueString(), "/"), strings.Count(b.Path.ValueString(), "/"))
+	})
+	return sorted
+}
+
+// ApplyDirectoryTree creates, updates and deletes the directories of a directory tree, parents first
+// for the creations and children first for the deletions. The directories created are deleted again
+// if a later step fails. It returns the directories of the plan with their computed attributes.
+func ApplyDirectoryTree(ctx context.Context, client *client.Client, rootPath string, deleteMode string,
+	plan []models.DirectoryTreeEntryModel, state []models.DirectoryTreeEntryModel) ([]models.DirectoryTreeEntryModel, error) {
+
+	current := map[string]models.DirectoryTreeEntryModel{}
+	for _, entry := range state {
+		current[entry.Path.ValueString()] = entry
+	}
+	indexes := map[string]int{}
+	for i, entry := range plan {
+		indexes[entry.Path.ValueString()] = i
+	}
+
+	var created []models.DirectoryTreeEntryModel
+	rollback := func() {
+		for _, entry := range slices.Backward(created) {
+			if err := deleteDirectoryTreeEntry(ctx, client, rootPath, entry, FileSystemDeleteModeFailIfNotEmpty); err != nil {
+				tflog.Error(ctx, fmt.Sprintf("Error deleting directory when reverting the directory tree - %s", err.Error()))
+			}
+		}
+	}
+
+	result := slices.Clone(plan)
+	for _, entry := range sortDirectoryTreeEntries(plan) {
+		stateEntry, ok := current[entry.Path.ValueString()]
+		if !ok {
+			if err := createDirectoryTreeEntry(ctx, client, rootPath, &entry); err != nil {
+				rollback()
+				return nil, err
+			}
+			created = append(created, entry)
+		} else if err := updateDirectoryTreeEntry(ctx, client, rootPath, &entry, stateEntry); err != nil {
+			rollback()
+			return nil, err
+		}
+		result[indexes[entry.Path.ValueString()]] = entry
+	}
+
+	var removed []models.DirectoryTreeEntryModel
+	for _, entry := range state {
+		if _, ok := indexes[entry.Path.ValueString()]; !ok {
+			removed = append(removed, entry)
+		}
+	}
+	if err := deleteDirectoryTreeEntries(ctx, client, rootPath, deleteMode, removed); err != nil {
+		rollback()
+		return nil, err
+	}
+	return result, nil
+}
+
+// DeleteDirectoryTree deletes the directories of a directory tree, children first.
+func DeleteDirectoryTree(ctx context.Context, client *client.Client, rootPath string, deleteMode string, state []models.DirectoryTreeEntryModel) error {
+	return deleteDirectoryTreeEntries(ctx, client, rootPath, deleteMode, state)
+}
+
+// deleteDirectoryTreeEntries deletes directories of a directory tree, children first, after checking
+// once that no NFS export, SMB share, S3 bucket or quota other than their own points at them.
+func deleteDirectoryTreeEntries(ctx context.Context, client *client.Client, rootPath string, deleteMode string, entries []models.DirectoryTreeEntryModel) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	var dirPaths, quotaIDs []string
+	for _, entry := range entries {
+		dirPaths = append(dirPaths, GetDirectoryTreeEntryPath(rootPath, entry.Path.ValueString()))
+		if entry.Quota != nil && entry.Quota.ID.ValueString() != "" {
+			quotaIDs = append(quotaIDs, entry.Quota.ID.ValueString())
+		}
+	}
+	if err := CheckFileSystemReferences(ctx, client, dirPaths, quotaIDs); err != nil {
+		return err
+	}
+
+	for _, entry := range slices.Backward(sortDirectoryTreeEntries(entries)) {
+		if err := deleteDirectoryTreeEntry(ctx, client, rootPath, entry, deleteMode); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadDirectoryTree reads the directories of a directory tree and reports their drift as warnings.
+// The directories that do not exist anymore are removed from the returned directories, so that they are created again.
+func ReadDirectoryTree(ctx context.Context, client *client.Client, rootPath string, state []models.DirectoryTreeEntryModel) ([]models.DirectoryTreeEntryModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var result []models.DirectoryTreeEntryModel
+	for _, entry := range state {
+		dirPath := GetDirectoryTreeEntryPath(rootPath, entry.Path.ValueString())
+		acl, httpResp, err := client.PscaleOpenAPIClient.NamespaceApi.GetAcl(ctx, dirPath).Acl(true).Nsaccess(true).Execute()
+		if err != nil {
+			if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+				diags.AddWarning(fmt.Sprintf("Directory /%s drifted", dirPath), "The directory does not exist anymore, it will be created again.")
+				continue
+			}
+			errStr := constants.ReadDirectoryTreeErrorMsg + "with error: "
+			diags.AddError(fmt.Sprintf("Error reading directory /%s", dirPath), GetErrorString(err, errStr))
+			return nil, diags
+		}
+
+		var drift []string
+		if owner := acl.GetOwner(); !entry.Owner.IsNull() && owner.GetName() != entry.Owner.ValueString() {
+			drift = append(drift, fmt.Sprintf("owner is '%s' instead of '%s'", owner.GetName(), entry.Owner.ValueString()))
+			entry.Owner = types.StringValue(owner.GetName())
+		}
+		if group := acl.GetGroup(); !entry.Group.IsNull() && group.GetName() != entry.Group.ValueString() {
+			drift = append(drift, fmt.Sprintf("group is '%s' instead of '%s'", group.GetName(), entry.Group.ValueString()))
+			entry.Group = types.StringValue(group.GetName())
+		}
+		// only the POSIX modes can be compared, the predefined access controls are applied as ACL
+		if _, authoritative := getNewAccessControlParams(entry.AccessControl.ValueString()); !entry.AccessControl.IsNull() && authoritative == mode &&
+			acl.GetMode() != entry.AccessControl.ValueString() {
+			drift = append(drift, fmt.Sprintf("access control is '%s' instead of '%s'", acl.GetMode(), entry.AccessControl.ValueString()))
+			entry.AccessControl = types.StringValue(acl.GetMode())
+		}
+
+		if entry.Quota != nil {
+			quota, err := getDirectoryTreeQuota(ctx, client, dirPath)
+			if err != nil {
+				errStr := constants.ReadDirectoryTreeErrorMsg + "with error: "
+				diags.AddError(fmt.Sprintf("Error reading the quota of directory /%s", dirPath), GetErrorString(err, errStr))
+				return nil, diags
+			}
+			if quota == nil {
+				drift = append(drift, "the directory quota does not exist anymore")
+				entry.Quota = nil
+			} else {
+				actual := &models.DirectoryTreeQuotaModel{
+					ID:        types.StringValue(quota.Id),
+					Hard:      directoryTreeThreshold(quota.Thresholds.GetHard()),
+					Soft:      directoryTreeThreshold(quota.Thresholds.GetSoft()),
+					SoftGrace: directoryTreeThreshold(quota.Thresholds.GetSoftGrace()),
+					Advisory:  directoryTreeThreshold(quota.Thresholds.GetAdvisory()),
+				}
+				if !actual.Hard.Equal(entry.Quota.Hard) || !actual.Soft.Equal(entry.Quota.Soft) ||
+					!actual.SoftGrace.Equal(entry.Quota.SoftGrace) || !actual.Advisory.Equal(entry.Quota.Advisory) {
+					drift = append(drift, "the directory quota thresholds were modified")
+				}
+				entry.Quota = actual
+			}
+		}
+
+		if len(drift) > 0 {
+			diags.AddWarning(fmt.Sprintf("Directory /%s drifted", dirPath), strings.Join(drift, ", ")+".")
+		}
+		entry.FullPath = types.StringValue("/" + dirPath)
+		result = append(result, entry)
+	}
+	if result == nil {
+		result = []models.DirectoryTreeEntryModel{}
+	}
+	return result, diags
+}
+
+// createDirectoryTreeEntry creates a directory of a directory tree with its ownership and quota.
+// The directory is deleted again if its ownership or quota cannot be set.
+func createDirectoryTreeEntry(ctx context.Context, client *client.Client, rootPath string, entry *models.DirectoryTreeEntryModel) error {
+	dirPath := GetDirectoryTreeEntryPath(rootPath, entry.Path.ValueString())
+	createReq := client.PscaleOpenAPIClient.NamespaceApi.CreateDirectory(ctx, dirPath).
+		XIsiIfsTargetType("container").Overwrite(false).Recursive(false)
+	if !entry.AccessControl.IsNull() && entry.AccessControl.ValueString() != "" {
+		createReq = createReq.XIsiIfsAccessControl(entry.AccessControl.ValueString())
+	}
+	if _, _, err := ExecuteCreate(createReq); err != nil {
+		errStr := constants.CreateDirectoryTreeErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return fmt.Errorf("error creating directory /%s : %s", dirPath, message)
+	}
+
+	err := setDirectoryTreeOwnership(ctx, client, dirPath, entry.Owner, entry.Group)
+	if err == nil && entry.Quota != nil {
+		var quotaID string
+		if quotaID, err = createDirectoryTreeQuota(ctx, client, dirPath, entry.Quota); err == nil {
+			entry.Quota.ID = types.StringValue(quotaID)
+		}
+	}
+	if err != nil {
+		// if err, revert create
+		if errDelete := DeleteFileSystem(ctx, client, dirPath); errDelete != nil {
+			tflog.Error(ctx, fmt.Sprintf("Error deleting directory when reverting creation - %s", errDelete.Error()))
+		}
+		return err
+	}
+	entry.FullPath = types.StringValue("/" + dirPath)
+	return nil
+}
+
+// updateDirectoryTreeEntry updates the ownership, the access control and the quota of a directory of a directory tree.
+func updateDirectoryTreeEntry(ctx context.Context, client *client.Client, rootPath string, plan *models.DirectoryTreeEntryModel, state models.DirectoryTreeEntryModel) error {
+	dirPath := GetDirectoryTreeEntryPath(rootPath, plan.Path.ValueString())
+	if !plan.Owner.Equal(state.Owner) || !plan.Group.Equal(state.Group) {
+		if err := setDirectoryTreeOwnership(ctx, client, dirPath, plan.Owner, plan.Group); err != nil {
+			return err
+		}
+	}
+	if err := UpdateFileSystemAccessControl(ctx, client, dirPath,
+		&models.FileSystemResource{AccessControl: plan.AccessControl}, &models.FileSystemResource{AccessControl: state.AccessControl}); err != nil {
+		return fmt.Errorf("error updating access control of directory /%s : %s", dirPath, err.Error())
+	}
+
+	switch {
+	case plan.Quota == nil && state.Quota != nil:
+		if err := DeleteQuota(ctx, client, state.Quota.ID.ValueString()); err != nil {
+			errStr := constants.DeleteQuotaErrorMsg + "with error: "
+			message := GetErrorString(err, errStr)
+			return fmt.Errorf("error deleting quota of directory /%s : %s", dirPath, message)
+		}
+	case plan.Quota != nil && state.Quota == nil:
+		quotaID, err := createDirectoryTreeQuota(ctx, client, dirPath, plan.Quota)
+		if err != nil {
+			return err
+		}
+		plan.Quota.ID = types.StringValue(quotaID)
+	case plan.Quota != nil:
+		plan.Quota.ID = state.Quota.ID
+		if !plan.Quota.Hard.Equal(state.Quota.Hard) || !plan.Quota.Soft.Equal(state.Quota.Soft) ||
+			!plan.Quota.SoftGrace.Equal(state.Quota.SoftGrace) || !plan.Quota.Advisory.Equal(state.Quota.Advisory) {
+			updatedQuota := powerscale.V12QuotaQuotaExtendedExtended{Thresholds: directoryTreeQuotaThresholds(plan.Quota)}
+			if err := UpdateQuota(ctx, client, state.Quota.ID.ValueString(), updatedQuota, false); err != nil {
+				errStr := constants.UpdateQuotaErrorMsg + "with error: "
+				message := GetErrorString(err, errStr)
+				return fmt.Errorf("error updating quota of directory /%s : %s", dirPath, message)
+			}
+		}
+	}
+	plan.FullPath = types.StringValue("/" + dirPath)
+	return nil
+}
+
+// deleteDirectoryTreeEntry deletes a directory of a directory tree with its quota, without checking its references.
+// A directory or a quota which does not exist anymore is considered deleted, so that a failed removal can be applied again.
+func deleteDirectoryTreeEntry(ctx context.Context, client *client.Client, rootPath string, entry models.DirectoryTreeEntryModel, deleteMode string) error {
+	dirPath := GetDirectoryTreeEntryPath(rootPath, entry.Path.ValueString())
+	if entry.Quota != nil && entry.Quota.ID.ValueString() != "" {
+		httpResp, err := client.PscaleOpenAPIClient.QuotaApi.DeleteQuotav12QuotaQuota(ctx, entry.Quota.ID.ValueString()).Execute()
+		if err != nil && (httpResp == nil || httpResp.StatusCode != http.StatusNotFound) {
+			errStr := constants.DeleteQuotaErrorMsg + "with error: "
+			message := GetErrorString(err, errStr)
+			return fmt.Errorf("error deleting quota of directory /%s : %s", dirPath, message)
+		}
+	}
+
+	_, httpResp, err := client.PscaleOpenAPIClient.NamespaceApi.GetAcl(ctx, dirPath).Execute()
+	if err != nil && httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		tflog.Info(ctx, fmt.Sprintf("directory /%s does not exist anymore, skipping its deletion", dirPath))
+		return nil
+	}
+	return DeleteFileSystemByMode(ctx, client, dirPath, deleteMode)
+}
+
+// setDirectoryTreeOwnership sets the owner and the group of a directory of a directory tree, resolved by name.
+func setDirectoryTreeOwnership(ctx context.Context, client *client.Client, dirPath string, owner types.String, group types.String) error {
+	if owner.IsNull() && group.IsNull() {
+		return nil
+	}
+	namespaceACL := powerscale.NewNamespaceAcl()
+	namespaceACL.SetAuthoritative(mode)
+	buildMember := func(name string, memberType string) (powerscale.MemberObject, error) {
+		id, err := ResolveOwnerGroupIdentity(ctx, client, "", name, "", memberType)
+		if err != nil {
+			return powerscale.MemberObject{}, err
+		}
+		return powerscale.MemberObject{Id: &id, Name: &name, Type: &memberType}, nil
+	}
+	if !owner.IsNull() {
+		member, err := buildMember(owner.ValueString(), "user")
+		if err != nil {
+			return fmt.Errorf("error resolving owner of directory /%s : %w", dirPath, err)
+		}
+		namespaceACL.SetOwner(member)
+	}
+	if !group.IsNull() {
+		member, err := buildMember(group.ValueString(), "group")
+		if err != nil {
+			return fmt.Errorf("error resolving group of directory /%s : %w", dirPath, err)
+		}
+		namespaceACL.SetGroup(member)
+	}
+	if _, _, err := client.PscaleOpenAPIClient.NamespaceApi.SetAcl(ctx, dirPath).Acl(true).NamespaceAcl(*namespaceACL).Execute(); err != nil {
+		errStr := constants.SetFileSystemACLErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return fmt.Errorf("error setting owner and group of directory /%s : %s", dirPath, message)
+	}
+	return nil
+}
+
+// createDirectoryTreeQuota creates the directory quota of a directory of a directory tree.
+func createDirectoryTreeQuota(ctx context.Context, client *client.Client, dirPath string, quota *models.DirectoryTreeQuotaModel) (string, error) {
+	enforced := true
+	response, err := CreateQuota(ctx, client, powerscale.V12QuotaQuota{
+		Enforced:         &enforced,
+		IncludeSnapshots: false,
+		Path:             "/" + dirPath,
+		Thresholds:       directoryTreeQuotaThresholds(quota),
+		Type:             "directory",
+	}, "")
+	if err != nil {
+		errStr := constants.CreateQuotaErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return "", fmt.Errorf("error creating quota of directory /%s : %s", dirPath, message)
+	}
+	return response.Id, nil
+}
+
+// getDirectoryTreeQuota returns the directory quota of a directory of a directory tree, nil if there is none.
+func getDirectoryTreeQuota(ctx context.Context, client *client.Client, dirPath string) (*powerscale.V12QuotaQuotaExtended, error) {
+	quotas, err := GetDirectoryQuota(ctx, client, "/"+dirPath)
+	if err != nil {
+		return nil, err
+	}
+	for _, quota := range quotas.Quotas {
+		if quota.Type == "directory" && quota.Path == "/"+dirPath && quota.Persona == nil {
+			return &quota, nil
+		}
+	}
+	return nil, nil
+}
+
+func directoryTreeQuotaThresholds(quota *models.DirectoryTreeQuotaModel) *powerscale.V12QuotaQuotaThresholds {
+	return &powerscale.V12QuotaQuotaThresholds{
+		Advisory:  *powerscale.NewNullableInt64(ValueToPointer[int64](quota.Advisory)),
+		Hard:      *powerscale.NewNullableInt64(ValueToPointer[int64](quota.Hard)),
+		Soft:      *powerscale.NewNullableInt64(ValueToPointer[int64](quota.Soft)),
+		SoftGrace: *powerscale.NewNullableInt64(ValueToPointer[int64](quota.SoftGrace)),
+	}
+}
+
+// directoryTreeThreshold maps a quota threshold, 0 meaning that the threshold is not set.
+func directoryTreeThreshold(threshold int64) types.Int64 {
+	if threshold == 0 {
+		return types.Int64Null()
+	}
+	return types.Int64Value(threshold)
+}
@@ -0,0 +1,150 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"crypto/sha256"
+	powerscale "dell/powerscale-go-client"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GetFileDesiredContent returns the content configured for a file, either inline or from a local source file.
+func GetFileDesiredContent(plan models.FileResourceModel) ([]byte, error) {
+	if !plan.Source.IsNull() {
+		content, err := os.ReadFile(plan.Source.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("could not read the source file %s: %s", plan.Source.ValueString(), err.Error())
+		}
+		return content, nil
+	}
+	return []byte(plan.Content.ValueString()), nil
+}
+
+// GetContentSha256 returns the hex encoded SHA-256 hash of the content.
+func GetContentSha256(content []byte) string {
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:])
+}
+
+// WriteFile writes the content to a file of the namespace, creating or replacing it.
+func WriteFile(ctx context.Context, client *client.Client, filePath string, content []byte, accessControl string, overwrite bool) error {
+	createReq := client.PscaleOpenAPIClient.NamespaceApi.CreateFile(ctx, filePath)
+	createReq = createReq.XIsiIfsTargetType("object")
+	createReq = createReq.Overwrite(overwrite)
+	if accessControl != "" {
+		createReq = createReq.XIsiIfsAccessControl(accessControl)
+	}
+	createReq = createReq.FileContents(string(content))
+	_, _, err := createReq.Execute()
+	return err
+}
+
+// GetFileContentSha256 returns the SHA-256 hash of the content of a file of the namespace.
+func GetFileContentSha256(ctx context.Context, client *client.Client, filePath string) (string, error) {
+	contents, _, err := client.PscaleOpenAPIClient.NamespaceApi.GetFileContents(ctx, filePath).Execute()
+	if err != nil {
+		return "", err
+	}
+	content, err := readFileContents(contents)
+	if err != nil {
+		return "", err
+	}
+	return GetContentSha256(content), nil
+}
+
+// readFileContents reads the contents returned by the namespace API,
+// which are downloaded into a temporary file for binary objects.
+func readFileContents(contents interface{}) ([]byte, error) {
+	switch c := contents.(type) {
+	case *os.File:
+		if c == nil {
+			return []byte{}, nil
+		}
+		defer os.Remove(c.Name())
+		defer c.Close()
+		if _, err := c.Seek(0, io.SeekStart); err != nil {
+			return nil, err
+		}
+		return io.ReadAll(c)
+	case io.Reader:
+		return io.ReadAll(c)
+	case *string:
+		if c == nil {
+			return []byte{}, nil
+		}
+		return []byte(*c), nil
+	case string:
+		return []byte(c), nil
+	case []byte:
+		return c, nil
+	}
+	return nil, fmt.Errorf("unexpected file contents of type %T", contents)
+}
+
+// DeleteFile deletes a file of the namespace.
+func DeleteFile(ctx context.Context, client *client.Client, filePath string) error {
+	_, _, err := client.PscaleOpenAPIClient.NamespaceApi.DeleteFile(ctx, filePath).Execute()
+	return err
+}
+
+// FileAsFileSystem returns the file as a filesystem resource model,
+// so that the owner, group and access control helpers of the filesystem apply to it.
+func FileAsFileSystem(file models.FileResourceModel) *models.FileSystemResource {
+	return &models.FileSystemResource{
+		ID:            file.ID,
+		FullPath:      file.FullPath,
+		Name:          file.Name,
+		DirectoryPath: file.DirectoryPath,
+		Owner:         file.Owner,
+		Group:         file.Group,
+		Type:          file.Type,
+		CreationTime:  file.CreationTime,
+		QueryZone:     file.QueryZone,
+		AccessControl: file.AccessControl,
+		Authoritative: file.Authoritative,
+		Mode:          file.Mode,
+		UserMetadata:  file.UserMetadata,
+	}
+}
+
+// UpdateFileResourceState updates the file resource state from the metadata, the ACL and the content hash of the file.
+func UpdateFileResourceState(ctx context.Context, file *models.FileResourceModel, acl *powerscale.NamespaceAcl, meta *powerscale.NamespaceMetadataList,
+	resolvedUID, resolvedGID, contentSha256 string) diag.Diagnostics {
+	fileSystem := FileAsFileSystem(*file)
+	diags := UpdateFileSystemResourceState(ctx, fileSystem, acl, meta, resolvedUID, resolvedGID)
+	file.ID = fileSystem.ID
+	file.FullPath = fileSystem.FullPath
+	file.Owner = fileSystem.Owner
+	file.Group = fileSystem.Group
+	file.Type = fileSystem.Type
+	file.CreationTime = fileSystem.CreationTime
+	file.Authoritative = fileSystem.Authoritative
+	file.Mode = fileSystem.Mode
+	file.UserMetadata = fileSystem.UserMetadata
+	file.ContentSha256 = types.StringValue(contentSha256)
+	return diags
+}
@@ -23,16 +23,22 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"maps"
 	"net/http"
+	"path"
 	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 	"terraform-provider-powerscale/client"
 	"terraform-provider-powerscale/powerscale/constants"
 	"terraform-provider-powerscale/powerscale/models"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // NamespaceMetadata Needed because we have to marshal this manually.
@@ -125,17 +131,34 @@ func GetDirectoryQuota(ctx context.Context, client *client.Client, directory str
 	return result, err
 }
 
-// GetDirectorySnapshots returns the filesystem snapshots.
-func GetDirectorySnapshots(ctx context.Context, client *client.Client) (*powerscale.V1SnapshotSnapshots, error) {
+// GetDirectorySnapshots returns the snapshots covering a filesystem, that is the snapshots of the directory
+// and, when includeParents is set, the snapshots of its parent directories.
+// The snapshots API cannot filter by path, so the pages are filtered as they are listed.
+func GetDirectorySnapshots(ctx context.Context, client *client.Client, directory string, includeParents bool) ([]powerscale.V1SnapshotSnapshotExtended, error) {
+	var snaps []powerscale.V1SnapshotSnapshotExtended
 	result, _, err := client.PscaleOpenAPIClient.SnapshotApi.ListSnapshotv1SnapshotSnapshots(ctx).Execute()
-	return result, err
+	if err != nil {
+		return nil, err
+	}
+	snaps = append(snaps, FilterPowerScaleSnapshots(result.Snapshots, directory, includeParents)...)
+	for result.Resume != nil && *result.Resume != "" {
+		result, _, err = client.PscaleOpenAPIClient.SnapshotApi.ListSnapshotv1SnapshotSnapshots(ctx).Resume(*result.Resume).Execute()
+		if err != nil {
+			return nil, err
+		}
+		snaps = append(snaps, FilterPowerScaleSnapshots(result.Snapshots, directory, includeParents)...)
+	}
+	return snaps, nil
 }
 
-// FilterPowerScaleSnapshots returns the filtered list of filesystem snapshots.
-func FilterPowerScaleSnapshots(unfilteredSnaps *powerscale.V1SnapshotSnapshots, directory string) []powerscale.V1SnapshotSnapshotExtended {
+// FilterPowerScaleSnapshots returns the snapshots of the directory and, when includeParents is set,
+// the snapshots of its parent directories, which cover the directory too.
+func FilterPowerScaleSnapshots(unfilteredSnaps []powerscale.V1SnapshotSnapshotExtended, directory string, includeParents bool) []powerscale.V1SnapshotSnapshotExtended {
 	var snaps []powerscale.V1SnapshotSnapshotExtended
-	for _, vsse := range unfilteredSnaps.Snapshots {
-		if strings.HasSuffix(vsse.Path, directory) {
+	directory = path.Clean("/" + strings.TrimPrefix(directory, "/"))
+	for _, vsse := range unfilteredSnaps {
+		snapPath := path.Clean("/" + strings.TrimPrefix(vsse.Path, "/"))
+		if snapPath == directory || (includeParents && IsPathWithin(directory, snapPath)) {
 			snaps = append(snaps, vsse)
 		}
 	}
@@ -251,18 +274,27 @@ func extractSnapshotModel(ctx context.Context, attr []powerscale.V1SnapshotSnaps
 	return snapModel, nil
 }
 
+// GetFileSystemACLModel maps the filesystem acl.
+func GetFileSystemACLModel(ctx context.Context, acl *powerscale.NamespaceAcl) (*models.FileSystemACL, error) {
+	var aclModel models.FileSystemACL
+	err := CopyFields(ctx, acl, &aclModel)
+	if err != nil {
+		return nil, err
+	}
+	aclModel.ACL, _ = GetACLKeyObjects(acl.Acl)
+	return &aclModel, nil
+}
+
 // BuildFilesystemDatasource returns the filesystem datasource fileed.
 func BuildFilesystemDatasource(ctx context.Context, state *models.FileSystemDataSourceModel, snap []powerscale.V1SnapshotSnapshotExtended, quota *powerscale.V12QuotaQuotas, acl *powerscale.NamespaceAcl, meta *powerscale.NamespaceMetadataList) error {
-	var aclModel models.FileSystemACL
 	var quotaModel []models.FileSystemQuota
 	var snapModel []models.FileSystemSnaps
 	var metaModel []models.FileSystemAttribues
 
-	err := CopyFields(ctx, acl, &aclModel)
+	aclModel, err := GetFileSystemACLModel(ctx, acl)
 	if err != nil {
 		return err
 	}
-	aclModel.ACL, _ = GetACLKeyObjects(acl.Acl)
 	metaModel, err = extractMetaModel(ctx, meta.Attrs)
 	if err != nil {
 		return err
@@ -276,7 +308,7 @@ func BuildFilesystemDatasource(ctx context.Context, state *models.FileSystemData
 		return err
 	}
 	state.FileSystem = &models.FileSystemDetailModel{
-		FileSystemACL:       &aclModel,
+		FileSystemACL:       aclModel,
 		FileSystemAttribues: metaModel,
 		FileSystemSnapshots: snapModel,
 		FileSystemQuota:     quotaModel,
@@ -514,6 +546,9 @@ func UpdateFileSystemResourceState(ctx context.Context, plan *models.FileSystemR
 	} else if plan.Mode.IsUnknown() {
 		plan.Mode = types.StringNull()
 	}
+	userMetadata, userMetadataDiags := GetUserMetadataState(ctx, plan.UserMetadata, meta)
+	diags.Append(userMetadataDiags...)
+	plan.UserMetadata = userMetadata
 	plan.ID = types.StringValue(GetDirectoryPath(plan.DirectoryPath.ValueString(), plan.Name.ValueString()))
 	plan.FullPath = types.StringValue("/" + plan.ID.ValueString())
 	return
@@ -567,6 +602,9 @@ func UpdateFileSystemResourceImportState(ctx context.Context, id string, state *
 	state.DirectoryPath = types.StringValue("/" + dir)
 	state.Overwrite = types.BoolValue(false)
 	state.Recursive = types.BoolValue(true)
+	state.DeleteMode = types.StringValue(FileSystemDeleteModeFailIfNotEmpty)
+	// the user metadata is only managed once configured, so that importing does not delete existing attributes
+	state.UserMetadata = types.MapNull(types.StringType)
 	state.AccessControl = state.Mode
 }
 
@@ -795,6 +833,99 @@ func ExecuteCreate(reqCreate powerscale.ApiCreateDirectoryRequest) (map[string]i
 	return reqCreate.Execute()
 }
 
+// UserMetadataNamespace is the namespace of the user-defined metadata attributes.
+const UserMetadataNamespace = "user"
+
+// SetUserMetadata sets the planned user-defined metadata attributes of a directory or a file
+// and removes the ones no longer planned. Attributes that were never managed are left untouched.
+func SetUserMetadata(ctx context.Context, client *client.Client, namespacePath string, plan types.Map, state types.Map) error {
+	planned := map[string]string{}
+	if !plan.IsNull() && !plan.IsUnknown() {
+		if diags := plan.ElementsAs(ctx, &planned, false); diags.HasError() {
+			return fmt.Errorf("could not read the planned user metadata")
+		}
+	}
+	managed := map[string]string{}
+	if !state.IsNull() && !state.IsUnknown() {
+		if diags := state.ElementsAs(ctx, &managed, false); diags.HasError() {
+			return fmt.Errorf("could not read the user metadata of the state")
+		}
+	}
+
+	var attrs []powerscale.NamespaceMetadataAttrsInner
+	namespace := UserMetadataNamespace
+	updateOp, deleteOp := "update", "delete"
+	for _, name := range slices.Sorted(maps.Keys(planned)) {
+		attrName, attrValue := name, planned[name]
+		attrs = append(attrs, powerscale.NamespaceMetadataAttrsInner{Name: &attrName, Namespace: &namespace, Op: &updateOp, Value: &attrValue})
+	}
+	for _, name := range slices.Sorted(maps.Keys(managed)) {
+		if _, ok := planned[name]; !ok {
+			attrName := name
+			attrs = append(attrs, powerscale.NamespaceMetadataAttrsInner{Name: &attrName, Namespace: &namespace, Op: &deleteOp})
+		}
+	}
+	if len(attrs) == 0 {
+		return nil
+	}
+
+	// the metadata API is the same for directories and files
+	action := "update"
+	metadata := powerscale.NamespaceMetadata{Action: &action, Attrs: attrs}
+	_, _, err := client.PscaleOpenAPIClient.NamespaceApi.SetDirectoryMetadata(ctx, namespacePath).Metadata(true).DirectoryMetadata(metadata).Execute()
+	if err != nil {
+		errStr := constants.SetUserMetadataErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return fmt.Errorf("error setting user metadata of %s : %s", namespacePath, message)
+	}
+	return nil
+}
+
+// GetUserMetadataState returns the current values of the managed user-defined metadata attributes.
+func GetUserMetadataState(ctx context.Context, managed types.Map, meta *powerscale.NamespaceMetadataList) (types.Map, diag.Diagnostics) {
+	if managed.IsNull() || managed.IsUnknown() {
+		return types.MapNull(types.StringType), nil
+	}
+	names := map[string]string{}
+	if diags := managed.ElementsAs(ctx, &names, false); diags.HasError() {
+		return types.MapNull(types.StringType), diags
+	}
+
+	values := map[string]string{}
+	for name, value := range GetUserMetadataValues(meta) {
+		if _, ok := names[name]; ok {
+			values[name] = value
+		}
+	}
+	return types.MapValueFrom(ctx, types.StringType, values)
+}
+
+// GetUserMetadataValues returns all the user-defined metadata attributes of the metadata list.
+func GetUserMetadataValues(meta *powerscale.NamespaceMetadataList) map[string]string {
+	values := map[string]string{}
+	if meta == nil {
+		return values
+	}
+	for _, attribute := range meta.Attrs {
+		if attribute.GetNamespace() == UserMetadataNamespace {
+			values[attribute.GetName()] = attribute.GetValue()
+		}
+	}
+	return values
+}
+
+// MoveFileSystem renames or moves a filesystem in place, keeping its content, ACL and quotas.
+func MoveFileSystem(ctx context.Context, client *client.Client, sourcePath string, destinationPath string) error {
+	moveReq := client.PscaleOpenAPIClient.NamespaceApi.MoveDirectory(ctx, sourcePath)
+	moveReq = moveReq.XIsiIfsSetLocation("/namespace/" + strings.TrimLeft(destinationPath, "/"))
+	if _, _, err := moveReq.Execute(); err != nil {
+		errStr := constants.MoveFileSystemErrorMsg
+		message := GetErrorString(err, errStr)
+		return fmt.Errorf("error moving filesystem - %s to %s : %s", sourcePath, destinationPath, message)
+	}
+	return nil
+}
+
 // DeleteFileSystem Deletes a filesystem.
 func DeleteFileSystem(ctx context.Context, client *client.Client, dirPath string) error {
 
@@ -805,3 +936,171 @@ func DeleteFileSystem(ctx context.Context, client *client.Client, dirPath string
 	}
 	return nil
 }
+
+const (
+	// FileSystemDeleteModeFailIfNotEmpty deletes the directory only if it is empty.
+	FileSystemDeleteModeFailIfNotEmpty = "fail_if_not_empty"
+	// FileSystemDeleteModeRecursive deletes the directory and its content through the namespace API.
+	FileSystemDeleteModeRecursive = "recursive"
+	// FileSystemDeleteModeTreeDeleteJob deletes the directory and its content with a TreeDelete job.
+	FileSystemDeleteModeTreeDeleteJob = "tree_delete_job"
+)
+
+// treeDeleteJobPollInterval is the interval between two checks of the TreeDelete job.
+var treeDeleteJobPollInterval = 5 * time.Second
+
+// GetFileSystemDeleteModes returns the supported delete modes of a filesystem.
+func GetFileSystemDeleteModes() []string {
+	return []string{FileSystemDeleteModeFailIfNotEmpty, FileSystemDeleteModeRecursive, FileSystemDeleteModeTreeDeleteJob}
+}
+
+// GetFileSystemReferences returns the NFS exports, SMB shares, S3 buckets and quotas
+// of all the access zones whose path is one of the directories or lies below it.
+// The quotas listed in ignoredQuotaIDs are left out.
+func GetFileSystemReferences(ctx context.Context, client *client.Client, dirPaths []string, ignoredQuotaIDs []string) ([]string, error) {
+	for i := range dirPaths {
+		dirPaths[i] = "/" + strings.TrimLeft(dirPaths[i], "/")
+	}
+	isReferenced := func(referencePath string) bool {
+		return slices.ContainsFunc(dirPaths, func(dirPath string) bool { return IsPathWithin(referencePath, dirPath) })
+	}
+	var references []string
+
+	zones, err := GetAllAccessZones(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	for _, zone := range zones.Zones {
+		zoneName := zone.GetName()
+		exports, err := ListNFSExports(ctx, client, &models.NfsExportDatasourceFilter{Zone: types.StringValue(zoneName)})
+		if err != nil {
+			return nil, err
+		}
+		for _, export := range *exports {
+			if slices.ContainsFunc(export.GetPaths(), isReferenced) {
+				references = append(references, fmt.Sprintf("NFS export %d in zone %s", export.GetId(), zoneName))
+			}
+		}
+
+		shares, err := ListSmbShares(ctx, client, &models.SmbShareDatasourceFilter{Zone: types.StringValue(zoneName)})
+		if err != nil {
+			return nil, err
+		}
+		for _, share := range *shares {
+			if isReferenced(share.GetPath()) {
+				references = append(references, fmt.Sprintf("SMB share %s in zone %s", share.GetName(), zoneName))
+			}
+		}
+
+		buckets, err := ListS3Buckets(ctx, client, &models.S3BucketDatasourceFilter{Zone: types.StringValue(zoneName)})
+		if err != nil {
+			return nil, err
+		}
+		for _, bucket := range buckets {
+			if isReferenced(bucket.GetPath()) {
+				references = append(references, fmt.Sprintf("S3 bucket %s in zone %s", bucket.GetName(), zoneName))
+			}
+		}
+	}
+
+	quotas, err := ListQuotas(ctx, client, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, quota := range quotas {
+		if isReferenced(quota.GetPath()) && !slices.Contains(ignoredQuotaIDs, quota.GetId()) {
+			references = append(references, fmt.Sprintf("%s quota %s on %s", quota.GetType(), quota.GetId(), quota.GetPath()))
+		}
+	}
+	return references, nil
+}
+
+// CheckFileSystemReferences returns an error if an NFS export, SMB share, S3 bucket or quota
+// points at one of the directories. The quotas listed in ignoredQuotaIDs are left out.
+func CheckFileSystemReferences(ctx context.Context, client *client.Client, dirPaths []string, ignoredQuotaIDs []string) error {
+	references, err := GetFileSystemReferences(ctx, client, slices.Clone(dirPaths), ignoredQuotaIDs)
+	if err != nil {
+		errStr := constants.ReadFileSystemReferencesErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		return fmt.Errorf("error deleting filesystem - %s : %s", strings.Join(dirPaths, ", "), message)
+	}
+	if len(references) > 0 {
+		return fmt.Errorf("error deleting filesystem - %s : the directory is still referenced by %s, remove them first",
+			strings.Join(dirPaths, ", "), strings.Join(references, ", "))
+	}
+	return nil
+}
+
+// RunTreeDeleteJob starts a TreeDelete job on the directory and waits for its completion.
+func RunTreeDeleteJob(ctx context.Context, client *client.Client, dirPath string) error {
+	dirPath = "/" + strings.TrimLeft(dirPath, "/")
+	job := powerscale.V10JobJob{
+		Type:  "TreeDelete",
+		Paths: []string{dirPath},
+	}
+	createResp, _, err := client.PscaleOpenAPIClient.JobApi.CreateJobv10JobJob(ctx).V10JobJob(job).Execute()
+	if err != nil {
+		return err
+	}
+	jobID := strconv.Itoa(int(createResp.Id))
+	tflog.Info(ctx, fmt.Sprintf("Started TreeDelete job %s on %s", jobID, dirPath))
+
+	for {
+		resp, _, err := client.PscaleOpenAPIClient.JobApi.GetJobv7JobJob(ctx, jobID).Execute()
+		if err != nil {
+			return err
+		}
+		if len(resp.Jobs) == 0 {
+			return fmt.Errorf("TreeDelete job %s not found", jobID)
+		}
+		switch state := resp.Jobs[0].State; state {
+		case "succeeded":
+			return nil
+		case "failed", "cancelled_user", "cancelled_system", "unknown":
+			return fmt.Errorf("TreeDelete job %s on %s ended in state %s", jobID, dirPath, state)
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for the TreeDelete job %s on %s: %s", jobID, dirPath, ctx.Err().Error())
+		case <-time.After(treeDeleteJobPollInterval):
+		}
+	}
+}
+
+// DeleteFileSystemWithMode deletes a filesystem according to the delete mode,
+// after making sure that no NFS export, SMB share, S3 bucket or quota points at it.
+func DeleteFileSystemWithMode(ctx context.Context, client *client.Client, dirPath string, deleteMode string) error {
+	if err := CheckFileSystemReferences(ctx, client, []string{dirPath}, nil); err != nil {
+		return err
+	}
+	return DeleteFileSystemByMode(ctx, client, dirPath, deleteMode)
+}
+
+// DeleteFileSystemByMode deletes a filesystem according to the delete mode, without checking its references.
+func DeleteFileSystemByMode(ctx context.Context, client *client.Client, dirPath string, deleteMode string) error {
+	switch deleteMode {
+	case FileSystemDeleteModeRecursive:
+		if _, _, err := client.PscaleOpenAPIClient.NamespaceApi.DeleteDirectory(ctx, dirPath).Recursive(true).Execute(); err != nil {
+			errStr := constants.DeleteFileSystemErrorMsg
+			message := GetErrorString(err, errStr)
+			return fmt.Errorf("error deleting filesystem - %s : %s", dirPath, message)
+		}
+		return nil
+	case FileSystemDeleteModeTreeDeleteJob:
+		if err := RunTreeDeleteJob(ctx, client, dirPath); err != nil {
+			errStr := constants.TreeDeleteJobErrorMsg + "with error: "
+			message := GetErrorString(err, errStr)
+			return fmt.Errorf("error deleting filesystem - %s : %s", dirPath, message)
+		}
+		// the job may leave the emptied root directory behind
+		if _, httpResp, err := client.PscaleOpenAPIClient.NamespaceApi.DeleteDirectory(ctx, dirPath).Execute(); err != nil &&
+			(httpResp == nil || httpResp.StatusCode != http.StatusNotFound) {
+			errStr := constants.DeleteFileSystemErrorMsg
+			message := GetErrorString(err, errStr)
+			return fmt.Errorf("error deleting filesystem - %s : %s", dirPath, message)
+		}
+		return nil
+	default:
+		return DeleteFileSystem(ctx, client, dirPath)
+	}
+}
@@ -0,0 +1,51 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	powerscale "dell/powerscale-go-client"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_FilterPowerScaleSnapshots(t *testing.T) {
+	snapshots := []powerscale.V1SnapshotSnapshotExtended{
+		{Path: "/ifs"},
+		{Path: "/ifs/a"},
+		{Path: "/ifs/a/data"},
+		{Path: "/ifs/a/data/"},
+		{Path: "/ifs/b/data"},
+		{Path: "/ifs/data"},
+		{Path: "/ifs/a/data/child"},
+		{Path: "/ifs/a/database"},
+	}
+	paths := func(snaps []powerscale.V1SnapshotSnapshotExtended) []string {
+		var result []string
+		for _, snap := range snaps {
+			result = append(result, snap.Path)
+		}
+		return result
+	}
+
+	assert.Equal(t, []string{"/ifs/a/data", "/ifs/a/data/"}, paths(FilterPowerScaleSnapshots(snapshots, "/ifs/a/data", false)))
+	assert.Equal(t, []string{"/ifs/a/data", "/ifs/a/data/"}, paths(FilterPowerScaleSnapshots(snapshots, "ifs/a/data/", false)))
+	assert.Equal(t, []string{"/ifs", "/ifs/a", "/ifs/a/data", "/ifs/a/data/"}, paths(FilterPowerScaleSnapshots(snapshots, "ifs/a/data", true)))
+	assert.Equal(t, []string{"/ifs"}, paths(FilterPowerScaleSnapshots(snapshots, "/ifs", true)))
+	assert.Nil(t, FilterPowerScaleSnapshots(snapshots, "/ifs/c", false))
+}
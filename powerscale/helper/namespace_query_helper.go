@@ -0,0 +1,239 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NamespaceQueryDefaultResult is the list of attributes returned when no result attributes are configured.
+var NamespaceQueryDefaultResult = []string{"name", "container_path", "type", "size", "owner", "group", "mode", "last_modified"}
+
+// namespaceQueryOperators maps the operators of the namespace query conditions to the API operators.
+var namespaceQueryOperators = map[string]string{
+	"eq":   "=",
+	"ne":   "!=",
+	"gt":   ">",
+	"ge":   ">=",
+	"lt":   "<",
+	"le":   "<=",
+	"like": "like",
+}
+
+// namespaceQueryPageSize is the maximum number of matches queried per request.
+const namespaceQueryPageSize = 1000
+
+// namespaceQueryUserAttribute is the attribute name used to match user-defined metadata attributes.
+const namespaceQueryUserAttribute = "user_data"
+
+// GetNamespaceQueryOperators returns the supported operators of the namespace query conditions.
+func GetNamespaceQueryOperators() []string {
+	return []string{"eq", "ne", "gt", "ge", "lt", "le", "like"}
+}
+
+// GetNamespaceQueryMatches queries the paths below the query path matching the conditions of the state.
+func GetNamespaceQueryMatches(ctx context.Context, client *client.Client, state models.NamespaceQueryDataSourceModel) ([]models.NamespaceQueryMatchModel, error) {
+	queryPath := strings.TrimPrefix(state.Path.ValueString(), "/")
+
+	result := slices.Clone(NamespaceQueryDefaultResult)
+	if !state.Result.IsNull() && !state.Result.IsUnknown() {
+		var configured []string
+		if diags := state.Result.ElementsAs(ctx, &configured, false); diags.HasError() {
+			return nil, fmt.Errorf("could not read the result attributes")
+		}
+		// the name and the container path are always needed to build the matching paths
+		result = []string{"name", "container_path"}
+		for _, attribute := range configured {
+			if !slices.Contains(result, attribute) {
+				result = append(result, attribute)
+			}
+		}
+	}
+
+	query := powerscale.DirectoryQuery{Result: result}
+	if len(state.Conditions) > 0 {
+		logic := "and"
+		if !state.Logic.IsNull() && !state.Logic.IsUnknown() {
+			logic = state.Logic.ValueString()
+		}
+		scope := powerscale.DirectoryQueryScope{Logic: &logic}
+		for _, condition := range state.Conditions {
+			attribute, operator, value := condition.Attribute.ValueString(), namespaceQueryOperators[condition.Operator.ValueString()], condition.Value.ValueString()
+			apiCondition := powerscale.DirectoryQueryScopeConditions{Attr: &attribute, Operator: &operator, Value: &value}
+			if condition.Namespace.ValueString() == UserMetadataNamespace {
+				userAttribute := namespaceQueryUserAttribute
+				apiCondition.Attr, apiCondition.Name = &userAttribute, &attribute
+			}
+			scope.Conditions = append(scope.Conditions, apiCondition)
+		}
+		query.Scope = &scope
+	}
+
+	limit := int64(-1)
+	if !state.Limit.IsNull() && !state.Limit.IsUnknown() {
+		limit = state.Limit.ValueInt64()
+	}
+	var matches []models.NamespaceQueryMatchModel
+	resume := ""
+	for {
+		pageSize := int64(namespaceQueryPageSize)
+		if limit >= 0 {
+			pageSize = min(pageSize, limit-int64(len(matches)))
+		}
+		if pageSize <= 0 {
+			break
+		}
+		param := client.PscaleOpenAPIClient.NamespaceApi.QueryDirectory(ctx, queryPath).Query(true).
+			DirectoryQuery(query).Detail(strings.Join(result, ",")).Limit(int32(pageSize)) // #nosec G115 --- validated, pageSize is at most namespaceQueryPageSize
+		if !state.MaxDepth.IsNull() && !state.MaxDepth.IsUnknown() {
+			param = param.MaxDepth(int32(state.MaxDepth.ValueInt64())) // #nosec G115 --- validated, max_depth is limited to the int32 range
+		}
+		if resume != "" {
+			param = param.Resume(resume)
+		}
+		resp, _, err := param.Execute()
+		if err != nil {
+			return nil, err
+		}
+		for _, child := range resp.Children {
+			match, err := NamespaceQueryMatchMapper(child, queryPath)
+			if err != nil {
+				return nil, err
+			}
+			matches = append(matches, match)
+		}
+		resume = resp.GetResume()
+		if resume == "" || len(resp.Children) == 0 {
+			break
+		}
+	}
+
+	for i := range matches {
+		matchPath := strings.TrimPrefix(matches[i].Path.ValueString(), "/")
+		if state.IncludeACL.ValueBool() {
+			acl, err := GetDirectoryACL(ctx, client, matchPath, "")
+			if err != nil {
+				return nil, err
+			}
+			if matches[i].ACL, err = GetFileSystemACLModel(ctx, acl); err != nil {
+				return nil, err
+			}
+		}
+
+		matches[i].UserMetadata = types.MapNull(types.StringType)
+		if !state.IncludeUserMetadata.ValueBool() {
+			continue
+		}
+		meta, err := GetDirectoryMetadata(ctx, client, matchPath)
+		if err != nil {
+			return nil, err
+		}
+		userMetadata, diags := types.MapValueFrom(ctx, types.StringType, GetUserMetadataValues(meta))
+		if diags.HasError() {
+			return nil, fmt.Errorf("could not read the user metadata of %s", matches[i].Path.ValueString())
+		}
+		matches[i].UserMetadata = userMetadata
+	}
+	return matches, nil
+}
+
+// NamespaceQueryMatchMapper maps a namespace object returned by the namespace query to a match.
+// The object attributes are decoded generically, since the returned attributes depend on the query.
+func NamespaceQueryMatchMapper(object powerscale.NamespaceObject, queryPath string) (models.NamespaceQueryMatchModel, error) {
+	var match models.NamespaceQueryMatchModel
+	data, err := json.Marshal(object)
+	if err != nil {
+		return match, err
+	}
+	values := map[string]interface{}{}
+	decoder := json.NewDecoder(strings.NewReader(string(data)))
+	decoder.UseNumber()
+	if err := decoder.Decode(&values); err != nil {
+		return match, err
+	}
+
+	attributes := map[string]attr.Value{}
+	for name, value := range values {
+		if stringValue := namespaceQueryValueMapper(value); stringValue != nil {
+			attributes[name] = types.StringValue(*stringValue)
+		}
+	}
+	stringAttribute := func(name string) types.String {
+		if value, ok := attributes[name]; ok {
+			return value.(types.String)
+		}
+		return types.StringNull()
+	}
+
+	name := stringAttribute("name").ValueString()
+	containerPath := stringAttribute("container_path").ValueString()
+	if containerPath == "" {
+		containerPath = "/" + queryPath
+	}
+	match.Path = types.StringValue("/" + GetDirectoryPath(containerPath, name))
+	match.Name = types.StringValue(name)
+	match.Type = stringAttribute("type")
+	match.Owner = stringAttribute("owner")
+	match.Group = stringAttribute("group")
+	match.Mode = stringAttribute("mode")
+	match.LastModified = stringAttribute("last_modified")
+	match.Size = types.Int64Null()
+	if size, err := strconv.ParseInt(stringAttribute("size").ValueString(), 10, 64); err == nil {
+		match.Size = types.Int64Value(size)
+	}
+	attributesMap, diags := types.MapValue(types.StringType, attributes)
+	if diags.HasError() {
+		return match, fmt.Errorf("could not map the attributes of %s", match.Path.ValueString())
+	}
+	match.Attributes = attributesMap
+	return match, nil
+}
+
+// namespaceQueryValueMapper maps an attribute value of the namespace query to its string representation.
+// Values that are not scalar are JSON encoded.
+func namespaceQueryValueMapper(value interface{}) *string {
+	var result string
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case string:
+		result = v
+	case json.Number:
+		result = v.String()
+	case bool:
+		result = strconv.FormatBool(v)
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return nil
+		}
+		result = string(data)
+	}
+	return &result
+}
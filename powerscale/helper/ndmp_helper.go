@@ -0,0 +1,197 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"net/http"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ndmpContextsMinOnefsVersion is the first OneFS release serving the NDMP contexts, on the v7 platform API.
+const ndmpContextsMinOnefsVersion = "8.2.0"
+
+// GetNdmpSettings retrieve ndmp global settings.
+func GetNdmpSettings(ctx context.Context, client *client.Client) (*powerscale.V3NdmpSettingsGlobal, error) {
+	ndmpSettings, _, err := client.PscaleOpenAPIClient.ProtocolsApi.GetProtocolsv3NdmpSettingsGlobal(ctx).Execute()
+	return ndmpSettings, err
+}
+
+// UpdateNdmpSettings update ndmp global settings.
+func UpdateNdmpSettings(ctx context.Context, client *client.Client, v3NdmpSettingsGlobal powerscale.V3NdmpSettingsGlobalExtended) error {
+	_, err := client.PscaleOpenAPIClient.ProtocolsApi.UpdateProtocolsv3NdmpSettingsGlobal(ctx).V3NdmpSettingsGlobal(v3NdmpSettingsGlobal).Execute()
+	return err
+}
+
+// CreateNdmpUser creates an NDMP user.
+func CreateNdmpUser(ctx context.Context, client *client.Client, name string, password string) error {
+	createParam := powerscale.V3NdmpUserCreateParams{
+		Name:     name,
+		Password: password,
+	}
+	_, _, err := client.PscaleOpenAPIClient.ProtocolsApi.CreateProtocolsv3NdmpUser(ctx).V3NdmpUser(createParam).Execute()
+	return err
+}
+
+// GetNdmpUser retrieves an NDMP user by name, nil when the user does not exist.
+func GetNdmpUser(ctx context.Context, client *client.Client, name string) (*powerscale.V3NdmpUser, error) {
+	resp, httpResp, err := client.PscaleOpenAPIClient.ProtocolsApi.GetProtocolsv3NdmpUser(ctx, name).Execute()
+	if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.GetUsers()) == 0 {
+		return nil, nil
+	}
+	return &resp.GetUsers()[0], nil
+}
+
+// UpdateNdmpUserPassword sets the password of an NDMP user.
+func UpdateNdmpUserPassword(ctx context.Context, client *client.Client, name string, password string) error {
+	updateParam := powerscale.V3NdmpUserExtended{
+		Password: password,
+	}
+	_, err := client.PscaleOpenAPIClient.ProtocolsApi.UpdateProtocolsv3NdmpUser(ctx, name).V3NdmpUser(updateParam).Execute()
+	return err
+}
+
+// DeleteNdmpUser deletes an NDMP user.
+func DeleteNdmpUser(ctx context.Context, client *client.Client, name string) error {
+	httpResp, err := client.PscaleOpenAPIClient.ProtocolsApi.DeleteProtocolsv3NdmpUser(ctx, name).Execute()
+	if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		return nil // already deleted
+	}
+	return err
+}
+
+// CheckNdmpContextsSupported returns an error when the OneFS release of the cluster does not serve the NDMP contexts.
+func CheckNdmpContextsSupported(client *client.Client) error {
+	onefsVersion, err := client.GetOnefsVersion()
+	if err != nil {
+		return fmt.Errorf("failed to get OneFS version: %v", err)
+	}
+	if onefsVersion.IsLessThan(ndmpContextsMinOnefsVersion) {
+		return fmt.Errorf("NDMP contexts are available from OneFS %s, the cluster runs OneFS %s", ndmpContextsMinOnefsVersion, onefsVersion)
+	}
+	return nil
+}
+
+// GetNdmpContexts returns the NDMP backup and restore contexts, of the given type when not empty.
+func GetNdmpContexts(ctx context.Context, client *client.Client, contextType string) ([]models.NdmpContextModel, error) {
+	if err := CheckNdmpContextsSupported(client); err != nil {
+		return nil, err
+	}
+	contexts := []models.NdmpContextModel{}
+	if contextType == "" || contextType == "backup" {
+		backupContexts, _, err := client.PscaleOpenAPIClient.ProtocolsApi.ListProtocolsv7NdmpContextsBackup(ctx).Execute()
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range backupContexts.GetContexts() {
+			model, err := NdmpContextMapper(ctx, item, "backup")
+			if err != nil {
+				return nil, err
+			}
+			contexts = append(contexts, model)
+		}
+	}
+	if contextType == "" || contextType == "restore" {
+		restoreContexts, _, err := client.PscaleOpenAPIClient.ProtocolsApi.ListProtocolsv7NdmpContextsRestore(ctx).Execute()
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range restoreContexts.GetContexts() {
+			model, err := NdmpContextMapper(ctx, item, "restore")
+			if err != nil {
+				return nil, err
+			}
+			contexts = append(contexts, model)
+		}
+	}
+	return contexts, nil
+}
+
+// GetNdmpRestartableBackupContexts returns the NDMP restartable backup contexts.
+func GetNdmpRestartableBackupContexts(ctx context.Context, client *client.Client) ([]models.NdmpContextModel, error) {
+	if err := CheckNdmpContextsSupported(client); err != nil {
+		return nil, err
+	}
+	breContexts, _, err := client.PscaleOpenAPIClient.ProtocolsApi.ListProtocolsv7NdmpContextsBre(ctx).Execute()
+	if err != nil {
+		return nil, err
+	}
+	contexts := []models.NdmpContextModel{}
+	for _, item := range breContexts.GetContexts() {
+		model, err := NdmpContextMapper(ctx, item, "bre")
+		if err != nil {
+			return nil, err
+		}
+		contexts = append(contexts, model)
+	}
+	return contexts, nil
+}
+
+// NdmpContextMapper maps a context of any type to the tfsdk model.
+// The context types share their field names but not their go types, so fields are copied by json tag.
+func NdmpContextMapper(ctx context.Context, item any, contextType string) (models.NdmpContextModel, error) {
+	model := models.NdmpContextModel{}
+	if err := CopyFields(ctx, item, &model); err != nil {
+		return model, err
+	}
+	model.Type = types.StringValue(contextType)
+	return model, nil
+}
+
+// GetNdmpSessions returns the NDMP sessions, of the node and with the session identifier when given.
+func GetNdmpSessions(ctx context.Context, client *client.Client, lnn string, session string) ([]powerscale.V3NdmpSession, error) {
+	listParam := client.PscaleOpenAPIClient.ProtocolsApi.ListProtocolsv3NdmpSessions(ctx)
+	if lnn != "" {
+		listParam = listParam.Lnn(lnn)
+	}
+	if session != "" {
+		listParam = listParam.Session(session)
+	}
+	resp, _, err := listParam.Execute()
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetSessions(), nil
+}
+
+// NdmpSessionMapper maps an NDMP session to the tfsdk model.
+func NdmpSessionMapper(session powerscale.V3NdmpSession) models.NdmpSessionModel {
+	data := session.GetData()
+	mover := session.GetMover()
+	info := session.GetSession()
+	return models.NdmpSessionModel{
+		ID:             types.StringValue(session.GetId()),
+		DataState:      types.StringValue(data.GetState()),
+		DataOperation:  types.StringValue(data.GetOperation()),
+		DataBytesMoved: types.Int64Value(data.GetBytesMoved()),
+		MoverState:     types.StringValue(mover.GetState()),
+		MoverMode:      types.StringValue(mover.GetMode()),
+		ClientAddress:  types.StringValue(info.GetClientAddress()),
+	}
+}
@@ -0,0 +1,59 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"terraform-provider-powerscale/client"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_CheckNdmpContextsSupported(t *testing.T) {
+	pscaleClient := &client.Client{}
+	pscaleClient.SetOnefsVersion(8, 1, 2)
+	err := CheckNdmpContextsSupported(pscaleClient)
+	assert.ErrorContains(t, err, "NDMP contexts are available from OneFS 8.2.0")
+
+	pscaleClient.SetOnefsVersion(8, 2, 0)
+	assert.NoError(t, CheckNdmpContextsSupported(pscaleClient))
+
+	pscaleClient.SetOnefsVersion(9, 8, 0)
+	assert.NoError(t, CheckNdmpContextsSupported(pscaleClient))
+}
+
+func Test_NdmpContextMapper(t *testing.T) {
+	item := struct {
+		ID        *string `json:"id,omitempty"`
+		Path      *string `json:"path,omitempty"`
+		StartTime *int64  `json:"start_time,omitempty"`
+	}{
+		ID:        powerscale.PtrString("65536"),
+		Path:      powerscale.PtrString("/ifs/data"),
+		StartTime: powerscale.PtrInt64(1700000000),
+	}
+	model, err := NdmpContextMapper(context.Background(), item, "bre")
+	assert.NoError(t, err)
+	assert.Equal(t, "65536", model.ID.ValueString())
+	assert.Equal(t, "/ifs/data", model.Path.ValueString())
+	assert.Equal(t, int64(1700000000), model.StartTime.ValueInt64())
+	assert.Equal(t, "bre", model.Type.ValueString())
+	assert.True(t, model.Status.IsNull())
+}
@@ -0,0 +1,112 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"cmp"
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"regexp"
+	"slices"
+	"strings"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// networkInterfaceAggregateRegex matches the names of aggregated interfaces, ex. ext-agg or 40gige-agg-1,
+// the first group is the name prefix of the interfaces they aggregate.
+var networkInterfaceAggregateRegex = regexp.MustCompile(`^(.+)-agg(-\d+)?$`)
+
+// NetworkInterfaceMapper maps a network interface to its model.
+func NetworkInterfaceMapper(iface powerscale.V12NetworkInterface) models.NetworkInterfaceModel {
+	model := models.NetworkInterfaceModel{
+		ID:                 types.StringValue(iface.GetId()),
+		Lnn:                types.Int64Value(int64(iface.GetLnn())),
+		Name:               types.StringValue(iface.GetName()),
+		NicName:            types.StringValue(iface.GetNicName()),
+		Type:               types.StringValue(iface.GetType()),
+		Status:             types.StringValue(iface.GetStatus()),
+		Speed:              types.Int64Value(int64(iface.GetSpeed())),
+		Mtu:                types.Int64Value(int64(iface.GetMtu())),
+		Macaddr:            types.StringValue(iface.GetMacaddr()),
+		IPAddrs:            []types.String{},
+		Aggregate:          types.StringNull(),
+		AggregationMembers: []types.String{},
+	}
+	for _, ipAddr := range iface.GetIpAddrs() {
+		model.IPAddrs = append(model.IPAddrs, types.StringValue(ipAddr))
+	}
+	return model
+}
+
+// SetNetworkInterfaceAggregation records the aggregation membership of the interfaces.
+// OneFS names an aggregated interface after the interfaces it aggregates on the same node,
+// ex. 40gige-agg-1 aggregates 40gige-1 and 40gige-2, ext-agg aggregates ext-1 and ext-2.
+func SetNetworkInterfaceAggregation(interfaces []models.NetworkInterfaceModel) {
+	for i := range interfaces {
+		match := networkInterfaceAggregateRegex.FindStringSubmatch(interfaces[i].Name.ValueString())
+		if match == nil {
+			continue
+		}
+		memberRegex := regexp.MustCompile(`^` + regexp.QuoteMeta(match[1]) + `-\d+$`)
+		for j := range interfaces {
+			if interfaces[j].Lnn.Equal(interfaces[i].Lnn) && memberRegex.MatchString(interfaces[j].Name.ValueString()) {
+				interfaces[i].AggregationMembers = append(interfaces[i].AggregationMembers, interfaces[j].Name)
+				interfaces[j].Aggregate = interfaces[i].Name
+			}
+		}
+		slices.SortFunc(interfaces[i].AggregationMembers, func(a, b types.String) int {
+			return compareNetworkInterfaceNames(a.ValueString(), b.ValueString())
+		})
+	}
+}
+
+// compareNetworkInterfaceNames orders interface names with the same prefix by their number, ex. ext-2 before ext-10.
+func compareNetworkInterfaceNames(a, b string) int {
+	return cmp.Or(cmp.Compare(len(a), len(b)), strings.Compare(a, b))
+}
+
+// FilterNetworkInterfaces returns the network interfaces matching the filter.
+func FilterNetworkInterfaces(ctx context.Context, interfaces []models.NetworkInterfaceModel, filter *models.NetworkInterfaceFilterType) ([]models.NetworkInterfaceModel, error) {
+	if filter == nil {
+		return interfaces, nil
+	}
+	lnns, err := inventoryFilterValues[int64](ctx, filter.Lnns)
+	if err != nil {
+		return nil, err
+	}
+	ifaceTypes, err := inventoryFilterValues[string](ctx, filter.Types)
+	if err != nil {
+		return nil, err
+	}
+	statuses, err := inventoryFilterValues[string](ctx, filter.Statuses)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := []models.NetworkInterfaceModel{}
+	for _, iface := range interfaces {
+		if matchInventoryFilter(lnns, iface.Lnn.ValueInt64()) &&
+			matchInventoryFilter(ifaceTypes, iface.Type.ValueString()) &&
+			matchInventoryFilter(statuses, iface.Status.ValueString()) {
+			filtered = append(filtered, iface)
+		}
+	}
+	return filtered, nil
+}
@@ -0,0 +1,84 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"terraform-provider-powerscale/powerscale/models"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func networkInterfaceModel(lnn int64, name string, ifaceType string, status string) models.NetworkInterfaceModel {
+	return models.NetworkInterfaceModel{
+		Lnn:                types.Int64Value(lnn),
+		Name:               types.StringValue(name),
+		Type:               types.StringValue(ifaceType),
+		Status:             types.StringValue(status),
+		Aggregate:          types.StringNull(),
+		AggregationMembers: []types.String{},
+	}
+}
+
+func Test_SetNetworkInterfaceAggregation(t *testing.T) {
+	interfaces := []models.NetworkInterfaceModel{
+		networkInterfaceModel(1, "40gige-agg-1", "aggregated", "up"),
+		networkInterfaceModel(1, "40gige-10", "40gige", "up"),
+		networkInterfaceModel(1, "40gige-2", "40gige", "up"),
+		networkInterfaceModel(1, "ext-1", "gige", "up"),
+		networkInterfaceModel(1, "ext-agg", "aggregated", "up"),
+		networkInterfaceModel(2, "40gige-1", "40gige", "no_carrier"),
+	}
+	SetNetworkInterfaceAggregation(interfaces)
+
+	assert.Equal(t, []types.String{types.StringValue("40gige-2"), types.StringValue("40gige-10")}, interfaces[0].AggregationMembers)
+	assert.Equal(t, "40gige-agg-1", interfaces[1].Aggregate.ValueString())
+	assert.Equal(t, "40gige-agg-1", interfaces[2].Aggregate.ValueString())
+	assert.Equal(t, "ext-agg", interfaces[3].Aggregate.ValueString())
+	assert.Equal(t, []types.String{types.StringValue("ext-1")}, interfaces[4].AggregationMembers)
+	// interfaces of other nodes are not aggregated
+	assert.True(t, interfaces[5].Aggregate.IsNull())
+	assert.Empty(t, interfaces[5].AggregationMembers)
+}
+
+func Test_FilterNetworkInterfaces(t *testing.T) {
+	ctx := context.Background()
+	interfaces := []models.NetworkInterfaceModel{
+		networkInterfaceModel(1, "ext-1", "gige", "up"),
+		networkInterfaceModel(1, "ext-2", "gige", "no_carrier"),
+		networkInterfaceModel(2, "ext-1", "gige", "up"),
+	}
+
+	filtered, err := FilterNetworkInterfaces(ctx, interfaces, nil)
+	assert.NoError(t, err)
+	assert.Len(t, filtered, 3)
+
+	lnns, _ := types.SetValueFrom(ctx, types.Int64Type, []int64{1})
+	statuses, _ := types.SetValueFrom(ctx, types.StringType, []string{"up"})
+	filtered, err = FilterNetworkInterfaces(ctx, interfaces, &models.NetworkInterfaceFilterType{
+		Lnns:     lnns,
+		Types:    types.SetNull(types.StringType),
+		Statuses: statuses,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, filtered, 1)
+	assert.Equal(t, "ext-1", filtered[0].Name.ValueString())
+	assert.Equal(t, int64(1), filtered[0].Lnn.ValueInt64())
+}
@@ -18,12 +18,38 @@ limitations under the License.
 package helper
 
 import (
+	"cmp"
 	"context"
 	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"slices"
+	"strings"
 	"terraform-provider-powerscale/client"
 	"terraform-provider-powerscale/powerscale/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// networkPoolRebalancePollInterval is the interval between two samples of the IP assignments of a pool being rebalanced.
+var networkPoolRebalancePollInterval = 5 * time.Second
+
+// networkPoolRebalanceStableSamples is the number of identical consecutive samples after which the IP assignments are stable.
+const networkPoolRebalanceStableSamples = 3
+
+// networkPoolRebalanceSettlePeriod is the time after which the IP assignments may be stable without having changed,
+// ex. when the pool was already balanced. Before it, unchanged assignments may only mean that the rebalance did not start yet.
+var networkPoolRebalanceSettlePeriod = 30 * time.Second
+
+// NetworkPoolIPAssignmentAttrTypes are the attribute types of an IP assignment of a pool.
+var NetworkPoolIPAssignmentAttrTypes = map[string]attr.Type{
+	"lnn":      types.Int64Type,
+	"iface":    types.StringType,
+	"ip_addrs": types.ListType{ElemType: types.StringType},
+}
+
 // GetNetworkPools Get a list of Network Pools.
 func GetNetworkPools(ctx context.Context, client *client.Client, state models.NetworkPoolDataSourceModel) (*powerscale.V12NetworkPools, error) {
 	networkPoolParams := client.PscaleOpenAPIClient.NetworkApi.GetNetworkv12NetworkPools(ctx)
@@ -92,3 +118,103 @@ func DeleteNetworkPool(ctx context.Context, client *client.Client, npID string,
 	_, err := client.PscaleOpenAPIClient.NetworkApi.DeleteNetworkv12GroupnetsGroupnetSubnetsSubnetPool(ctx, npID, groupnet, subnet).Execute()
 	return err
 }
+
+// GetNetworkInterfaces returns the network interfaces of all the nodes of the cluster.
+func GetNetworkInterfaces(ctx context.Context, client *client.Client) ([]powerscale.V12NetworkInterface, error) {
+	resp, _, err := client.PscaleOpenAPIClient.NetworkApi.GetNetworkv12NetworkInterfaces(ctx).Execute()
+	if err != nil {
+		return nil, err
+	}
+	interfaces := resp.Interfaces
+	for resp.Resume != nil {
+		resp, _, err = client.PscaleOpenAPIClient.NetworkApi.GetNetworkv12NetworkInterfaces(ctx).Resume(*resp.Resume).Execute()
+		if err != nil {
+			return interfaces, err
+		}
+		interfaces = append(interfaces, resp.Interfaces...)
+	}
+	return interfaces, nil
+}
+
+// GetNetworkPoolIPAssignments returns the IP addresses of the pool assigned to each of its member interfaces,
+// sorted by LNN and interface name. Member interfaces without any address of the pool are kept with an empty list.
+func GetNetworkPoolIPAssignments(poolID string, interfaces []powerscale.V12NetworkInterface) []models.NetworkPoolIPAssignmentModel {
+	assignments := []models.NetworkPoolIPAssignmentModel{}
+	for _, iface := range interfaces {
+		for _, owner := range iface.GetOwners() {
+			if fmt.Sprintf("%s.%s.%s", owner.GetGroupnet(), owner.GetSubnet(), owner.GetPool()) != poolID {
+				continue
+			}
+			ipAddrs := slices.Clone(owner.GetIpAddrs())
+			slices.Sort(ipAddrs)
+			assignment := models.NetworkPoolIPAssignmentModel{
+				Lnn:     types.Int64Value(int64(iface.GetLnn())),
+				Iface:   types.StringValue(iface.GetName()),
+				IPAddrs: []types.String{},
+			}
+			for _, ipAddr := range ipAddrs {
+				assignment.IPAddrs = append(assignment.IPAddrs, types.StringValue(ipAddr))
+			}
+			assignments = append(assignments, assignment)
+		}
+	}
+	slices.SortFunc(assignments, func(a, b models.NetworkPoolIPAssignmentModel) int {
+		if c := cmp.Compare(a.Lnn.ValueInt64(), b.Lnn.ValueInt64()); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Iface.ValueString(), b.Iface.ValueString())
+	})
+	return assignments
+}
+
+// NetworkPoolIPAssignmentsValue converts the IP assignments of a pool to a list value.
+func NetworkPoolIPAssignmentsValue(ctx context.Context, assignments []models.NetworkPoolIPAssignmentModel) (types.List, diag.Diagnostics) {
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: NetworkPoolIPAssignmentAttrTypes}, assignments)
+}
+
+// RebalanceNetworkPool triggers a manual SmartConnect rebalance of the IP addresses of a pool.
+func RebalanceNetworkPool(ctx context.Context, client *client.Client, groupnet string, subnet string, pool string) error {
+	rebalanceParam := client.PscaleOpenAPIClient.NetworkGroupnetsApi.CreateNetworkGroupnetsv3SubnetsSubnetPoolsPoolRebalanceIp(ctx, pool, groupnet, subnet)
+	_, _, err := rebalanceParam.V3PoolsPoolRebalanceIp(map[string]interface{}{}).Execute()
+	return err
+}
+
+// WaitForNetworkPoolIPAssignments samples the IP assignments of a pool after a rebalance until they stay the same
+// for several consecutive samples, and returns the stable assignments. The assignments captured before the rebalance
+// are only accepted as stable once the settle period passed, so that a rebalance which did not start yet is waited for.
+func WaitForNetworkPoolIPAssignments(ctx context.Context, client *client.Client, poolID string,
+	before []models.NetworkPoolIPAssignmentModel, timeout time.Duration) ([]models.NetworkPoolIPAssignmentModel, error) {
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	settled := time.Now().Add(networkPoolRebalanceSettlePeriod)
+	initial := fmt.Sprint(before)
+	var previous string
+	stableSamples := 0
+	for {
+		select {
+		case <-waitCtx.Done():
+			return nil, fmt.Errorf("timed out after %s waiting for the IP assignments of pool %s to stabilize: %s", timeout, poolID, waitCtx.Err().Error())
+		case <-time.After(networkPoolRebalancePollInterval):
+		}
+
+		interfaces, err := GetNetworkInterfaces(waitCtx, client)
+		if err != nil {
+			return nil, err
+		}
+		assignments := GetNetworkPoolIPAssignments(poolID, interfaces)
+		current := fmt.Sprint(assignments)
+		if current == initial && time.Now().Before(settled) {
+			previous, stableSamples = "", 0
+			continue
+		}
+		if current == previous {
+			stableSamples++
+		} else {
+			previous = current
+			stableSamples = 1
+		}
+		if stableSamples >= networkPoolRebalanceStableSamples {
+			return assignments, nil
+		}
+	}
+}
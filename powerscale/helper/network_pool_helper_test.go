@@ -0,0 +1,63 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	powerscale "dell/powerscale-go-client"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetNetworkPoolIPAssignments(t *testing.T) {
+	var interfaces []powerscale.V12NetworkInterface
+	err := json.Unmarshal([]byte(`[
+		{"lnn": 2, "name": "ext-1", "owners": [
+			{"groupnet": "groupnet0", "subnet": "subnet0", "pool": "pool0", "ip_addrs": ["10.0.0.12", "10.0.0.11"]}
+		]},
+		{"lnn": 1, "name": "ext-2", "owners": [
+			{"groupnet": "groupnet0", "subnet": "subnet0", "pool": "pool0", "ip_addrs": []}
+		]},
+		{"lnn": 1, "name": "ext-1", "owners": [
+			{"groupnet": "groupnet0", "subnet": "subnet0", "pool": "pool1", "ip_addrs": ["10.0.1.10"]},
+			{"groupnet": "groupnet0", "subnet": "subnet0", "pool": "pool0", "ip_addrs": ["10.0.0.10"]}
+		]},
+		{"lnn": 3, "name": "ext-1", "owners": []}
+	]`), &interfaces)
+	assert.NoError(t, err)
+
+	assignments := GetNetworkPoolIPAssignments("groupnet0.subnet0.pool0", interfaces)
+	assert.Len(t, assignments, 3)
+
+	assert.Equal(t, int64(1), assignments[0].Lnn.ValueInt64())
+	assert.Equal(t, "ext-1", assignments[0].Iface.ValueString())
+	assert.Len(t, assignments[0].IPAddrs, 1)
+	assert.Equal(t, "10.0.0.10", assignments[0].IPAddrs[0].ValueString())
+
+	assert.Equal(t, int64(1), assignments[1].Lnn.ValueInt64())
+	assert.Equal(t, "ext-2", assignments[1].Iface.ValueString())
+	assert.NotNil(t, assignments[1].IPAddrs)
+	assert.Empty(t, assignments[1].IPAddrs)
+
+	assert.Equal(t, int64(2), assignments[2].Lnn.ValueInt64())
+	assert.Equal(t, "10.0.0.11", assignments[2].IPAddrs[0].ValueString())
+	assert.Equal(t, "10.0.0.12", assignments[2].IPAddrs[1].ValueString())
+
+	assert.Empty(t, GetNetworkPoolIPAssignments("groupnet0.subnet0.pool2", interfaces))
+}
@@ -20,14 +20,22 @@ package helper
 import (
 	"context"
 	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"net"
+	"net/http"
+	"path"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
 	"terraform-provider-powerscale/client"
 	"terraform-provider-powerscale/powerscale/models"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // GetNFSExport retrieve nfs export information.
@@ -293,3 +301,210 @@ func assignKnownObjectToUnknown(ctx context.Context, source types.Object, target
 	result, _ := basetypes.NewObjectValue(target.AttributeTypes(ctx), targetMap)
 	return result
 }
+
+// Since the client lists of an export are written back as a whole, concurrent updates
+// of the individual clients would overwrite each other, so we need to lock the mutex here.
+var nfsExportClients sync.Mutex
+
+// nfsExportClientsUpdateAttempts is the number of times the client list is read and written back
+// before giving up when it keeps being changed outside of the provider.
+const nfsExportClientsUpdateAttempts = 5
+
+// NfsExportClientListTypes lists the client lists of an export.
+var NfsExportClientListTypes = []string{"clients", "root_clients", "read_only_clients", "read_write_clients"}
+
+// GetNFSExportClientList returns the client list of the export with the given type.
+func GetNFSExportClientList(export powerscale.V2NfsExportExtended, listType string) []string {
+	switch listType {
+	case "root_clients":
+		return export.RootClients
+	case "read_only_clients":
+		return export.ReadOnlyClients
+	case "read_write_clients":
+		return export.ReadWriteClients
+	}
+	return export.Clients
+}
+
+// setNFSExportClientList sets the client list of the export update with the given type.
+func setNFSExportClientList(export *powerscale.V2NfsExportExtendedExtended, listType string, clients []string) {
+	switch listType {
+	case "root_clients":
+		export.RootClients = clients
+	case "read_only_clients":
+		export.ReadOnlyClients = clients
+	case "read_write_clients":
+		export.ReadWriteClients = clients
+	default:
+		export.Clients = clients
+	}
+}
+
+// FindNFSExportClient returns the index of the client in the list, or -1 if it is not there.
+func FindNFSExportClient(clients []string, client string) int {
+	return slices.IndexFunc(clients, func(item string) bool {
+		return strings.EqualFold(item, client)
+	})
+}
+
+// GetNFSExportIDByPath returns the ID of the only export of the zone sharing the path.
+func GetNFSExportIDByPath(ctx context.Context, client *client.Client, zone, exportPath string) (int64, error) {
+	filter := &models.NfsExportDatasourceFilter{Path: types.StringValue(exportPath)}
+	if zone != "" {
+		filter.Zone = types.StringValue(zone)
+	}
+	exports, err := ListNFSExports(ctx, client, filter)
+	if err != nil {
+		return 0, err
+	}
+	var ids []int64
+	for _, export := range *exports {
+		if slices.ContainsFunc(export.Paths, func(item string) bool { return path.Clean(item) == path.Clean(exportPath) }) {
+			ids = append(ids, export.GetId())
+		}
+	}
+	switch len(ids) {
+	case 0:
+		return 0, fmt.Errorf("no nfs export found for path %s", exportPath)
+	case 1:
+		return ids[0], nil
+	}
+	return 0, fmt.Errorf("%d nfs exports found for path %s, use the export id instead", len(ids), exportPath)
+}
+
+// ModifyNFSExportClients applies the modify function to the client list of the export and writes it back.
+// The export is read again right before writing, and the update starts over when the list was changed in the meantime.
+func ModifyNFSExportClients(ctx context.Context, client *client.Client, plan models.NfsExportClientResourceModel, modify func([]string) []string) error {
+	nfsExportClients.Lock()
+	defer nfsExportClients.Unlock()
+
+	exportID := strconv.FormatInt(plan.ExportID.ValueInt64(), 10)
+	listType := plan.ListType.ValueString()
+	for attempt := 0; attempt < nfsExportClientsUpdateAttempts; attempt++ {
+		current, err := getNFSExportClientList(ctx, client, exportID, plan.Zone.ValueString(), listType)
+		if err != nil {
+			return err
+		}
+		clients := modify(append([]string{}, current...))
+		if slices.Equal(clients, current) {
+			return nil
+		}
+
+		latest, err := getNFSExportClientList(ctx, client, exportID, plan.Zone.ValueString(), listType)
+		if err != nil {
+			return err
+		}
+		if !slices.Equal(latest, current) {
+			tflog.Debug(ctx, fmt.Sprintf("%s of nfs export %s changed during the update, retrying", listType, exportID))
+			continue
+		}
+
+		export := powerscale.V2NfsExportExtendedExtended{}
+		setNFSExportClientList(&export, listType, clients)
+		updateParam := client.PscaleOpenAPIClient.ProtocolsApi.UpdateProtocolsv2NfsExport(ctx, exportID)
+		if !plan.Zone.IsNull() {
+			updateParam = updateParam.Zone(plan.Zone.ValueString())
+		}
+		if !plan.IgnoreUnresolvableHosts.IsNull() {
+			updateParam = updateParam.IgnoreUnresolvableHosts(plan.IgnoreUnresolvableHosts.ValueBool())
+		}
+		_, err = updateParam.V2NfsExport(export).Execute()
+		return err
+	}
+	return fmt.Errorf("the %s of nfs export %s were changed by another client during each of the %d update attempts",
+		listType, exportID, nfsExportClientsUpdateAttempts)
+}
+
+// getNFSExportClientList returns the client list of the export with the given type.
+func getNFSExportClientList(ctx context.Context, client *client.Client, exportID, zone, listType string) ([]string, error) {
+	exportResponse, err := GetNFSExportByID(ctx, client, exportID, zone)
+	if err != nil {
+		return nil, err
+	}
+	if len(exportResponse.Exports) == 0 {
+		return nil, fmt.Errorf("nfs export %s not found", exportID)
+	}
+	return GetNFSExportClientList(exportResponse.Exports[0], listType), nil
+}
+
+// ParseNFSExportClientID parses the [zone:]export_id:list_type:client identifier of an export client.
+func ParseNFSExportClientID(id string) (zone string, exportID int64, listType string, exportClient string, err error) {
+	parts := strings.SplitN(id, ":", 4)
+	if _, parseErr := strconv.ParseInt(parts[0], 10, 64); parseErr == nil {
+		parts = append([]string{""}, strings.SplitN(id, ":", 3)...)
+	}
+	if len(parts) == 4 && parts[3] != "" && slices.Contains(NfsExportClientListTypes, parts[2]) {
+		if exportID, err = strconv.ParseInt(parts[1], 10, 64); err == nil {
+			return parts[0], exportID, parts[2], parts[3], nil
+		}
+	}
+	return "", 0, "", "", fmt.Errorf("invalid identifier %s, expected [zone:]export_id:list_type:client", id)
+}
+
+// nfsExportHostResolveTimeout bounds the resolution of a host name of the export clients at plan time.
+const nfsExportHostResolveTimeout = 5 * time.Second
+
+// GetNFSExportAllClients returns the clients of all the client lists of an export.
+func GetNFSExportAllClients(export powerscale.V2NfsExportExtended) []string {
+	var clients []string
+	for _, listType := range NfsExportClientListTypes {
+		clients = append(clients, GetNFSExportClientList(export, listType)...)
+	}
+	return clients
+}
+
+// FindNFSExportConflicts returns the conflicts of an export with the other exports of its zone.
+// Two exports conflict when they share a path and either share a client or are both open to all the clients.
+func FindNFSExportConflicts(exportID int64, paths []string, clients []string, exports []powerscale.V2NfsExportExtended) []string {
+	var conflicts []string
+	for _, export := range exports {
+		if export.GetId() == exportID {
+			continue
+		}
+		otherClients := GetNFSExportAllClients(export)
+		for _, exportPath := range paths {
+			if !slices.ContainsFunc(export.GetPaths(), func(otherPath string) bool { return path.Clean(otherPath) == path.Clean(exportPath) }) {
+				continue
+			}
+			if len(clients) == 0 && len(otherClients) == 0 {
+				conflicts = append(conflicts, fmt.Sprintf("path %s is already exported to all the clients by export %d", exportPath, export.GetId()))
+				continue
+			}
+			for _, exportClient := range clients {
+				if FindNFSExportClient(otherClients, exportClient) >= 0 {
+					conflicts = append(conflicts, fmt.Sprintf("path %s is already exported to client %s by export %d", exportPath, exportClient, export.GetId()))
+				}
+			}
+		}
+	}
+	return conflicts
+}
+
+// IsNFSExportHostName returns whether an export client is a host name, and not an address, a network, a netgroup or a pattern.
+func IsNFSExportHostName(exportClient string) bool {
+	if exportClient == "" || strings.HasPrefix(exportClient, "@") || strings.ContainsAny(exportClient, "*?[/") {
+		return false
+	}
+	return net.ParseIP(exportClient) == nil
+}
+
+// ResolveNFSExportHost resolves a host name of the export clients with the resolver of the Terraform host.
+// The cluster may use other DNS servers, so that a failure is not conclusive.
+func ResolveNFSExportHost(ctx context.Context, host string) error {
+	resolveCtx, cancel := context.WithTimeout(ctx, nfsExportHostResolveTimeout)
+	defer cancel()
+	_, err := net.DefaultResolver.LookupHost(resolveCtx, host)
+	return err
+}
+
+// NFSExportPathExists returns whether the path of an export exists on the cluster.
+func NFSExportPathExists(ctx context.Context, client *client.Client, exportPath string) (bool, error) {
+	_, httpResp, err := client.PscaleOpenAPIClient.NamespaceApi.GetAcl(ctx, strings.TrimPrefix(path.Clean(exportPath), "/")).Acl(true).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
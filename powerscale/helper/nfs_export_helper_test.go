@@ -0,0 +1,71 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	powerscale "dell/powerscale-go-client"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ParseNFSExportClientID(t *testing.T) {
+	zone, exportID, listType, exportClient, err := ParseNFSExportClientID("System:3:root_clients:10.10.0.0/24")
+	assert.Nil(t, err)
+	assert.Equal(t, "System", zone)
+	assert.Equal(t, int64(3), exportID)
+	assert.Equal(t, []string{"root_clients", "10.10.0.0/24"}, []string{listType, exportClient})
+
+	zone, exportID, listType, exportClient, err = ParseNFSExportClientID("3:clients:fd00::1")
+	assert.Nil(t, err)
+	assert.Equal(t, "", zone)
+	assert.Equal(t, int64(3), exportID)
+	assert.Equal(t, []string{"clients", "fd00::1"}, []string{listType, exportClient})
+
+	_, _, _, _, err = ParseNFSExportClientID("System:3:all_clients:host")
+	assert.NotNil(t, err)
+
+	_, _, _, _, err = ParseNFSExportClientID("System:export:clients:host")
+	assert.NotNil(t, err)
+}
+
+func Test_FindNFSExportClient(t *testing.T) {
+	assert.Equal(t, 1, FindNFSExportClient([]string{"10.10.0.1", "Host.Example.com"}, "host.example.com"))
+	assert.Equal(t, -1, FindNFSExportClient([]string{"10.10.0.1"}, "10.10.0.2"))
+}
+
+func Test_FindNFSExportConflicts(t *testing.T) {
+	openExport := powerscale.V2NfsExportExtended{Id: powerscale.PtrInt64(1), Paths: []string{"/ifs/data"}}
+	rootExport := powerscale.V2NfsExportExtended{Id: powerscale.PtrInt64(2), Paths: []string{"/ifs/data/"}, RootClients: []string{"Backup.example.com"}}
+	exports := []powerscale.V2NfsExportExtended{openExport, rootExport}
+
+	assert.Equal(t, []string{"path /ifs/data is already exported to all the clients by export 1"},
+		FindNFSExportConflicts(3, []string{"/ifs/data"}, nil, exports))
+	assert.Equal(t, []string{"path /ifs/data is already exported to client backup.example.com by export 2"},
+		FindNFSExportConflicts(3, []string{"/ifs/data"}, []string{"10.10.0.1", "backup.example.com"}, exports))
+	// the export does not conflict with itself, nor with the exports of other paths
+	assert.Empty(t, FindNFSExportConflicts(1, []string{"/ifs/data"}, nil, exports))
+	assert.Empty(t, FindNFSExportConflicts(3, []string{"/ifs/other"}, nil, exports))
+}
+
+func Test_IsNFSExportHostName(t *testing.T) {
+	assert.True(t, IsNFSExportHostName("host.example.com"))
+	for _, exportClient := range []string{"10.10.0.1", "fd00::1", "10.10.0.0/24", "@netgroup", "*.example.com", ""} {
+		assert.False(t, IsNFSExportHostName(exportClient), exportClient)
+	}
+}
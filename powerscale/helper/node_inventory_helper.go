@@ -0,0 +1,280 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// NodeHealthOk is the health of a responding node that is neither smartfailed nor read-only.
+	NodeHealthOk = "ok"
+	// NodeHealthSmartfailed is the health of a smartfailed node.
+	NodeHealthSmartfailed = "smartfailed"
+	// NodeHealthReadonly is the health of a node in read-only mode.
+	NodeHealthReadonly = "readonly"
+	// NodeHealthDown is the health of a node that did not respond.
+	NodeHealthDown = "down"
+
+	// DriveHealthOk is the health of a drive in use.
+	DriveHealthOk = "ok"
+	// DriveHealthSmartfail is the health of a drive being or having been smartfailed.
+	DriveHealthSmartfail = "smartfail"
+	// DriveHealthEmpty is the health of an empty bay.
+	DriveHealthEmpty = "empty"
+	// DriveHealthUnhealthy is the health of any other drive.
+	DriveHealthUnhealthy = "unhealthy"
+)
+
+// driveHealthyStates lists the UI states of drives in normal use.
+var driveHealthyStates = []string{"HEALTHY", "L3", "JOURNAL"}
+
+// GetNodeHealthValues returns the supported node health values.
+func GetNodeHealthValues() []string {
+	return []string{NodeHealthOk, NodeHealthSmartfailed, NodeHealthReadonly, NodeHealthDown}
+}
+
+// GetDriveHealthValues returns the supported drive health values.
+func GetDriveHealthValues() []string {
+	return []string{DriveHealthOk, DriveHealthSmartfail, DriveHealthEmpty, DriveHealthUnhealthy}
+}
+
+// GetNodeInventory returns the nodes of the cluster.
+func GetNodeInventory(ctx context.Context, client *client.Client) ([]models.ClusterNode, error) {
+	nodes, err := GetClusterNodes(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	var clusterNodes models.ClusterNodes
+	if err := CopyFields(ctx, nodes, &clusterNodes); err != nil {
+		return nil, err
+	}
+	return clusterNodes.Nodes, nil
+}
+
+// GetNodePoolNames returns the name of the node pool of each node, keyed by LNN.
+func GetNodePoolNames(ctx context.Context, client *client.Client) (map[int64]string, error) {
+	resp, _, err := client.PscaleOpenAPIClient.StoragepoolApi.ListStoragepoolv16StoragepoolNodepools(ctx).Execute()
+	if err != nil {
+		return nil, err
+	}
+	pools := make(map[int64]string)
+	for _, pool := range resp.GetNodepools() {
+		for _, lnn := range pool.GetLnns() {
+			pools[int64(lnn)] = pool.GetName()
+		}
+	}
+	return pools, nil
+}
+
+// GetNodeHealth returns the health of a node.
+func GetNodeHealth(node models.ClusterNode) string {
+	if node.Error.ValueString() != "" {
+		return NodeHealthDown
+	}
+	if node.State != nil {
+		if node.State.Smartfail.Smartfailed.ValueBool() {
+			return NodeHealthSmartfailed
+		}
+		if node.State.Readonly.Mode.ValueBool() {
+			return NodeHealthReadonly
+		}
+	}
+	return NodeHealthOk
+}
+
+// GetDriveHealth returns the health of a drive.
+func GetDriveHealth(drive models.ClusterNodeDrive) string {
+	state := strings.ToUpper(drive.UIState.ValueString())
+	switch {
+	case IsDriveSmartfail(drive):
+		return DriveHealthSmartfail
+	case state == "EMPTY":
+		return DriveHealthEmpty
+	case slices.Contains(driveHealthyStates, state) && drive.Present.ValueBool():
+		return DriveHealthOk
+	default:
+		return DriveHealthUnhealthy
+	}
+}
+
+// IsDriveSmartfail returns whether a drive is being or has been smartfailed.
+func IsDriveSmartfail(drive models.ClusterNodeDrive) bool {
+	return strings.Contains(strings.ToUpper(drive.UIState.ValueString()), "SMARTFAIL") ||
+		strings.Contains(strings.ToUpper(drive.Purpose.ValueString()), "SMARTFAIL")
+}
+
+// NodeInventoryMapper flattens a node into the node inventory model.
+func NodeInventoryMapper(node models.ClusterNode, pools map[int64]string) models.NodeInventoryModel {
+	model := models.NodeInventoryModel{
+		ID:          node.ID,
+		Lnn:         node.Lnn,
+		NodePool:    types.StringValue(pools[node.Lnn.ValueInt64()]),
+		DriveCount:  types.Int64Value(int64(len(node.Drives))),
+		Smartfailed: types.BoolValue(false),
+		Readonly:    types.BoolValue(false),
+		Health:      types.StringValue(GetNodeHealth(node)),
+		Error:       node.Error,
+	}
+	if node.Hardware != nil {
+		model.SerialNumber = node.Hardware.SerialNumber
+		model.Product = node.Hardware.Product
+		model.Class = node.Hardware.Class
+		model.Hwgen = node.Hardware.Hwgen
+		model.RAM = node.Hardware.RAM
+	}
+	if node.State != nil {
+		model.Smartfailed = types.BoolValue(node.State.Smartfail.Smartfailed.ValueBool())
+		model.Readonly = types.BoolValue(node.State.Readonly.Mode.ValueBool())
+	}
+	if node.Status != nil {
+		model.Release = node.Status.Release
+		model.Version = node.Status.Version
+		model.Uptime = node.Status.Uptime
+		var capacity int64
+		for _, item := range node.Status.Capacity {
+			capacity += item.Bytes.ValueInt64()
+		}
+		model.CapacityBytes = types.Int64Value(capacity)
+	}
+	return model
+}
+
+// DriveInventoryMapper flattens a drive of a node into the drive inventory model.
+func DriveInventoryMapper(node models.ClusterNode, drive models.ClusterNodeDrive, pools map[int64]string) models.DriveInventoryModel {
+	model := models.DriveInventoryModel{
+		Lnn:                node.Lnn,
+		NodePool:           types.StringValue(pools[node.Lnn.ValueInt64()]),
+		Baynum:             drive.Baynum,
+		Lnum:               drive.Lnum,
+		Devname:            drive.Devname,
+		Locnstr:            drive.Locnstr,
+		Serial:             drive.Serial,
+		Model:              drive.Model,
+		Wwn:                drive.Wwn,
+		MediaType:          drive.MediaType,
+		InterfaceType:      drive.InterfaceType,
+		CapacityBytes:      types.Int64Value(drive.Blocks.ValueInt64() * drive.LogicalBlockLength.ValueInt64()),
+		Purpose:            drive.Purpose,
+		PurposeDescription: drive.PurposeDescription,
+		State:              drive.UIState,
+		Present:            drive.Present,
+		Smartfail:          types.BoolValue(IsDriveSmartfail(drive)),
+		Health:             types.StringValue(GetDriveHealth(drive)),
+	}
+	if node.Hardware != nil {
+		model.NodeSerialNumber = node.Hardware.SerialNumber
+	}
+	if drive.Firmware != nil {
+		model.Firmware = drive.Firmware.CurrentFirmware
+		model.DesiredFirmware = drive.Firmware.DesiredFirmware
+	}
+	return model
+}
+
+// inventoryFilterValues reads the values of a set filter, nil if the filter is not set.
+func inventoryFilterValues[T any](ctx context.Context, set types.Set) ([]T, error) {
+	if set.IsNull() || set.IsUnknown() {
+		return nil, nil
+	}
+	var values []T
+	if diags := set.ElementsAs(ctx, &values, false); diags.HasError() {
+		return nil, fmt.Errorf("could not read the filter values")
+	}
+	return values, nil
+}
+
+// matchInventoryFilter returns whether value is one of values, an unset filter matches everything.
+func matchInventoryFilter[T comparable](values []T, value T) bool {
+	return values == nil || slices.Contains(values, value)
+}
+
+// FilterNodeInventory returns the nodes matching the filter.
+func FilterNodeInventory(ctx context.Context, nodes []models.NodeInventoryModel, filter *models.NodeFilterType) ([]models.NodeInventoryModel, error) {
+	if filter == nil {
+		return nodes, nil
+	}
+	lnns, err := inventoryFilterValues[int64](ctx, filter.Lnns)
+	if err != nil {
+		return nil, err
+	}
+	pools, err := inventoryFilterValues[string](ctx, filter.NodePools)
+	if err != nil {
+		return nil, err
+	}
+	health, err := inventoryFilterValues[string](ctx, filter.Health)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := []models.NodeInventoryModel{}
+	for _, node := range nodes {
+		if matchInventoryFilter(lnns, node.Lnn.ValueInt64()) &&
+			matchInventoryFilter(pools, node.NodePool.ValueString()) &&
+			matchInventoryFilter(health, node.Health.ValueString()) {
+			filtered = append(filtered, node)
+		}
+	}
+	return filtered, nil
+}
+
+// FilterDriveInventory returns the drives matching the filter.
+func FilterDriveInventory(ctx context.Context, drives []models.DriveInventoryModel, filter *models.DriveFilterType) ([]models.DriveInventoryModel, error) {
+	if filter == nil {
+		return drives, nil
+	}
+	lnns, err := inventoryFilterValues[int64](ctx, filter.Lnns)
+	if err != nil {
+		return nil, err
+	}
+	pools, err := inventoryFilterValues[string](ctx, filter.NodePools)
+	if err != nil {
+		return nil, err
+	}
+	states, err := inventoryFilterValues[string](ctx, filter.States)
+	if err != nil {
+		return nil, err
+	}
+	purposes, err := inventoryFilterValues[string](ctx, filter.Purposes)
+	if err != nil {
+		return nil, err
+	}
+	health, err := inventoryFilterValues[string](ctx, filter.Health)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := []models.DriveInventoryModel{}
+	for _, drive := range drives {
+		if matchInventoryFilter(lnns, drive.Lnn.ValueInt64()) &&
+			matchInventoryFilter(pools, drive.NodePool.ValueString()) &&
+			matchInventoryFilter(states, drive.State.ValueString()) &&
+			matchInventoryFilter(purposes, drive.Purpose.ValueString()) &&
+			matchInventoryFilter(health, drive.Health.ValueString()) {
+			filtered = append(filtered, drive)
+		}
+	}
+	return filtered, nil
+}
@@ -0,0 +1,308 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"bytes"
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"net"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// smbOpenfileDrive matches the drive prefix of the files reported as open over SMB, ex. C:\ifs\data.
+var smbOpenfileDrive = regexp.MustCompile(`^[A-Za-z]:`)
+
+// nfsClientProtocols are the protocols of the client statistics listed as NFS clients.
+var nfsClientProtocols = []string{"nfs3", "nfs4"}
+
+// smbClientProtocols are the protocols of the client statistics used to find the node of the SMB sessions.
+var smbClientProtocols = []string{"smb1", "smb2"}
+
+// GetSmbSessions returns the SMB sessions open on the cluster, in the access zone when given.
+func GetSmbSessions(ctx context.Context, client *client.Client, zone string) ([]powerscale.V1SmbSession, error) {
+	listParam := client.PscaleOpenAPIClient.ProtocolsApi.ListProtocolsv1SmbSessions(ctx)
+	if zone != "" {
+		listParam = listParam.Zone(zone)
+	}
+	resp, _, err := listParam.Execute()
+	if err != nil {
+		return nil, err
+	}
+	sessions := resp.Sessions
+	for resp.Resume != nil {
+		resp, _, err = client.PscaleOpenAPIClient.ProtocolsApi.ListProtocolsv1SmbSessions(ctx).Resume(*resp.Resume).Execute()
+		if err != nil {
+			return sessions, err
+		}
+		sessions = append(sessions, resp.Sessions...)
+	}
+	return sessions, nil
+}
+
+// FilterSmbSessions returns the SMB sessions of the user and of the client computer or network, when given.
+// When nodeClients is not nil, only the sessions of the computers among the SMB clients of the nodes are returned.
+func FilterSmbSessions(sessions []powerscale.V1SmbSession, user string, computer string, nodeClients []powerscale.V1SummaryClientClientItem) []powerscale.V1SmbSession {
+	var filtered []powerscale.V1SmbSession
+	for _, session := range sessions {
+		if user != "" && !MatchProtocolUser(session.GetUser(), user) {
+			continue
+		}
+		if computer != "" && !MatchClientAddress(session.GetComputer(), computer) {
+			continue
+		}
+		if nodeClients != nil && !slices.ContainsFunc(nodeClients, func(smbClient powerscale.V1SummaryClientClientItem) bool {
+			return MatchClientAddress(session.GetComputer(), smbClient.GetRemoteAddr()) || strings.EqualFold(session.GetComputer(), smbClient.GetRemoteName())
+		}) {
+			continue
+		}
+		filtered = append(filtered, session)
+	}
+	return filtered
+}
+
+// SmbSessionMapper maps an SMB session to the tfsdk model.
+func SmbSessionMapper(session powerscale.V1SmbSession) models.SmbSessionModel {
+	return models.SmbSessionModel{
+		ActiveTime: types.Int64Value(int64(session.GetActiveTime())),
+		ClientType: types.StringValue(session.GetClientType()),
+		Computer:   types.StringValue(session.GetComputer()),
+		Encryption: types.BoolValue(session.GetEncryption()),
+		GuestLogin: types.BoolValue(session.GetGuestLogin()),
+		IdleTime:   types.Int64Value(int64(session.GetIdleTime())),
+		Openfiles:  types.Int64Value(int64(session.GetOpenfiles())),
+		User:       types.StringValue(session.GetUser()),
+	}
+}
+
+// CloseSmbSession closes the SMB session of the user from the client computer.
+func CloseSmbSession(ctx context.Context, client *client.Client, zone string, computer string, user string) error {
+	deleteParam := client.PscaleOpenAPIClient.ProtocolsApi.DeleteProtocolsv1SmbSessionsComputerUser(ctx, user, computer)
+	if zone != "" {
+		deleteParam = deleteParam.Zone(zone)
+	}
+	_, err := deleteParam.Execute()
+	return err
+}
+
+// GetSmbOpenfiles returns the files open over SMB on the cluster.
+func GetSmbOpenfiles(ctx context.Context, client *client.Client) ([]powerscale.V1SmbOpenfile, error) {
+	resp, _, err := client.PscaleOpenAPIClient.ProtocolsApi.ListProtocolsv1SmbOpenfiles(ctx).Execute()
+	if err != nil {
+		return nil, err
+	}
+	openfiles := resp.Openfiles
+	for resp.Resume != nil {
+		resp, _, err = client.PscaleOpenAPIClient.ProtocolsApi.ListProtocolsv1SmbOpenfiles(ctx).Resume(*resp.Resume).Execute()
+		if err != nil {
+			return openfiles, err
+		}
+		openfiles = append(openfiles, resp.Openfiles...)
+	}
+	return openfiles, nil
+}
+
+// GetSmbOpenfilePath returns the path in the /ifs namespace of a file reported as open over SMB, ex. /ifs/data for C:\ifs\data.
+func GetSmbOpenfilePath(file string) string {
+	return path.Clean("/" + strings.TrimLeft(strings.ReplaceAll(smbOpenfileDrive.ReplaceAllString(file, ""), `\`, "/"), "/"))
+}
+
+// FilterSmbOpenfiles returns the files open by the user, within the path and within the base path of the access zone, when given.
+func FilterSmbOpenfiles(openfiles []powerscale.V1SmbOpenfile, user string, filePath string, zonePath string) []powerscale.V1SmbOpenfile {
+	var filtered []powerscale.V1SmbOpenfile
+	for _, openfile := range openfiles {
+		if user != "" && !MatchProtocolUser(openfile.GetUser(), user) {
+			continue
+		}
+		if filePath != "" && !IsPathWithin(GetSmbOpenfilePath(openfile.GetFile()), filePath) {
+			continue
+		}
+		if zonePath != "" && !IsPathWithin(GetSmbOpenfilePath(openfile.GetFile()), zonePath) {
+			continue
+		}
+		filtered = append(filtered, openfile)
+	}
+	return filtered
+}
+
+// SmbOpenfileMapper maps a file open over SMB to the tfsdk model.
+func SmbOpenfileMapper(ctx context.Context, openfile powerscale.V1SmbOpenfile) (models.SmbOpenfileModel, error) {
+	permissions, diags := types.ListValueFrom(ctx, types.StringType, openfile.GetPermissions())
+	if diags.HasError() {
+		return models.SmbOpenfileModel{}, fmt.Errorf("could not read the permissions of open file %d", openfile.GetId())
+	}
+	return models.SmbOpenfileModel{
+		ID:          types.Int64Value(int64(openfile.GetId())),
+		File:        types.StringValue(openfile.GetFile()),
+		Path:        types.StringValue(GetSmbOpenfilePath(openfile.GetFile())),
+		Locks:       types.Int64Value(int64(openfile.GetLocks())),
+		Permissions: permissions,
+		User:        types.StringValue(openfile.GetUser()),
+	}, nil
+}
+
+// CloseSmbOpenfile closes a file open over SMB.
+func CloseSmbOpenfile(ctx context.Context, client *client.Client, id int32) error {
+	_, err := client.PscaleOpenAPIClient.ProtocolsApi.DeleteProtocolsv1SmbOpenfile(ctx, strconv.FormatInt(int64(id), 10)).Execute()
+	return err
+}
+
+// GetNfsClients returns the NFS clients active on the nodes of the cluster, on the given nodes only when given.
+func GetNfsClients(ctx context.Context, client *client.Client, nodes []int64) ([]powerscale.V1SummaryClientClientItem, error) {
+	return getProtocolClients(ctx, client, nfsClientProtocols, nodes)
+}
+
+// GetSmbClients returns the SMB clients active on the nodes of the cluster, on the given nodes only when given.
+func GetSmbClients(ctx context.Context, client *client.Client, nodes []int64) ([]powerscale.V1SummaryClientClientItem, error) {
+	clients, err := getProtocolClients(ctx, client, smbClientProtocols, nodes)
+	if clients == nil && err == nil {
+		// no active client on the nodes means that no session matches, rather than no filter
+		clients = []powerscale.V1SummaryClientClientItem{}
+	}
+	return clients, err
+}
+
+// getProtocolClients returns the clients of the protocols from the client statistics, on the given nodes only when given.
+func getProtocolClients(ctx context.Context, client *client.Client, protocols []string, nodes []int64) ([]powerscale.V1SummaryClientClientItem, error) {
+	param := client.PscaleOpenAPIClient.StatisticsApi.GetStatisticsv1SummaryClient(ctx).Protocols(protocols)
+	if len(nodes) > 0 {
+		var nodeNames []string
+		for _, node := range nodes {
+			nodeNames = append(nodeNames, strconv.FormatInt(node, 10))
+		}
+		param = param.Nodes(nodeNames)
+	}
+	resp, _, err := param.Execute()
+	if err != nil {
+		return nil, err
+	}
+	return resp.Client, nil
+}
+
+// GetAccessZonePath returns the base path of an access zone.
+func GetAccessZonePath(ctx context.Context, client *client.Client, zone string) (string, error) {
+	zones, err := GetAllAccessZones(ctx, client)
+	if err != nil {
+		return "", err
+	}
+	for _, accessZone := range zones.Zones {
+		if strings.EqualFold(accessZone.GetName(), zone) {
+			return accessZone.GetPath(), nil
+		}
+	}
+	return "", fmt.Errorf("access zone %s not found", zone)
+}
+
+// GetAccessZoneAddressRanges returns the IP address ranges of the network pools of an access zone.
+func GetAccessZoneAddressRanges(ctx context.Context, client *client.Client, zone string) ([]powerscale.V12GroupnetSubnetScServiceAddr, error) {
+	pools, err := GetNetworkPools(ctx, client, models.NetworkPoolDataSourceModel{
+		NetworkPoolFilter: &models.NetworkPoolFilterType{AccessZone: types.StringValue(zone)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	// a zone without pools has no address range, rather than no filter
+	ranges := []powerscale.V12GroupnetSubnetScServiceAddr{}
+	for _, pool := range pools.Pools {
+		ranges = append(ranges, pool.Ranges...)
+	}
+	return ranges, nil
+}
+
+// FilterNfsClients returns the NFS clients of the user and of the client address or network, connected to an address of the ranges, when given.
+func FilterNfsClients(clients []powerscale.V1SummaryClientClientItem, ranges []powerscale.V12GroupnetSubnetScServiceAddr, user string, clientAddress string) []powerscale.V1SummaryClientClientItem {
+	var filtered []powerscale.V1SummaryClientClientItem
+	for _, nfsClient := range clients {
+		clientUser := nfsClient.GetUser()
+		if user != "" && !MatchProtocolUser(clientUser.GetName(), user) {
+			continue
+		}
+		if clientAddress != "" && !MatchClientAddress(nfsClient.GetRemoteAddr(), clientAddress) {
+			continue
+		}
+		if ranges != nil && !isAddressInRanges(nfsClient.GetLocalAddr(), ranges) {
+			continue
+		}
+		filtered = append(filtered, nfsClient)
+	}
+	return filtered
+}
+
+// NfsClientMapper maps the statistics of an NFS client to the tfsdk model.
+func NfsClientMapper(nfsClient powerscale.V1SummaryClientClientItem) models.NfsClientModel {
+	clientUser := nfsClient.GetUser()
+	return models.NfsClientModel{
+		Node:          types.Int64Value(int64(nfsClient.GetNode())),
+		Protocol:      types.StringValue(nfsClient.GetProtocol()),
+		RemoteAddress: types.StringValue(nfsClient.GetRemoteAddr()),
+		RemoteName:    types.StringValue(nfsClient.GetRemoteName()),
+		LocalAddress:  types.StringValue(nfsClient.GetLocalAddr()),
+		LocalName:     types.StringValue(nfsClient.GetLocalName()),
+		User:          types.StringValue(clientUser.GetName()),
+		Ops:           types.Float64Value(float64(nfsClient.GetOps())),
+		Time:          types.Int64Value(int64(nfsClient.GetTime())),
+	}
+}
+
+// MatchProtocolUser returns whether the user of a session matches the wanted user, which matches any domain when it has none.
+func MatchProtocolUser(user string, wanted string) bool {
+	if strings.EqualFold(user, wanted) {
+		return true
+	}
+	if strings.Contains(wanted, `\`) {
+		return false
+	}
+	_, name, found := strings.Cut(user, `\`)
+	return found && strings.EqualFold(name, wanted)
+}
+
+// MatchClientAddress returns whether a client address is the wanted address, or is within the wanted network in CIDR notation.
+func MatchClientAddress(address string, wanted string) bool {
+	ip := net.ParseIP(address)
+	if _, network, err := net.ParseCIDR(wanted); err == nil {
+		return ip != nil && network.Contains(ip)
+	}
+	if wantedIP := net.ParseIP(wanted); wantedIP != nil {
+		return ip != nil && ip.Equal(wantedIP)
+	}
+	return strings.EqualFold(address, wanted)
+}
+
+// isAddressInRanges returns whether an IP address is within one of the address ranges.
+func isAddressInRanges(address string, ranges []powerscale.V12GroupnetSubnetScServiceAddr) bool {
+	ip := net.ParseIP(address).To16()
+	if ip == nil {
+		return false
+	}
+	for _, addrRange := range ranges {
+		low, high := net.ParseIP(addrRange.GetLow()).To16(), net.ParseIP(addrRange.GetHigh()).To16()
+		if low != nil && high != nil && bytes.Compare(ip, low) >= 0 && bytes.Compare(ip, high) <= 0 {
+			return true
+		}
+	}
+	return false
+}
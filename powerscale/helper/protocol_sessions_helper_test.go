@@ -0,0 +1,98 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	powerscale "dell/powerscale-go-client"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetSmbOpenfilePath(t *testing.T) {
+	assert.Equal(t, "/ifs/data/file.txt", GetSmbOpenfilePath(`C:\ifs\data\file.txt`))
+	assert.Equal(t, "/ifs/data", GetSmbOpenfilePath(`\ifs\data\`))
+	assert.Equal(t, "/ifs/data/file.txt", GetSmbOpenfilePath("/ifs/data/file.txt"))
+}
+
+func Test_FilterSmbOpenfiles(t *testing.T) {
+	openfiles := []powerscale.V1SmbOpenfile{
+		{Id: powerscale.PtrInt32(1), File: powerscale.PtrString(`C:\ifs\share\a.txt`), User: powerscale.PtrString(`DOMAIN\alice`)},
+		{Id: powerscale.PtrInt32(2), File: powerscale.PtrString(`C:\ifs\share2\b.txt`), User: powerscale.PtrString(`DOMAIN\bob`)},
+	}
+	assert.Len(t, FilterSmbOpenfiles(openfiles, "", "", ""), 2)
+	assert.Equal(t, int32(1), FilterSmbOpenfiles(openfiles, "", "/ifs/share", "")[0].GetId())
+	assert.Equal(t, int32(2), FilterSmbOpenfiles(openfiles, "bob", "", "")[0].GetId())
+	assert.Empty(t, FilterSmbOpenfiles(openfiles, `OTHER\bob`, "", ""))
+	// the zone keeps the files within its base path
+	assert.Equal(t, int32(2), FilterSmbOpenfiles(openfiles, "", "", "/ifs/share2")[0].GetId())
+	assert.Len(t, FilterSmbOpenfiles(openfiles, "", "", "/ifs"), 2)
+}
+
+func Test_FilterSmbSessions(t *testing.T) {
+	sessions := []powerscale.V1SmbSession{
+		{Computer: powerscale.PtrString("10.10.0.1"), User: powerscale.PtrString(`DOMAIN\alice`)},
+		{Computer: powerscale.PtrString("10.10.1.2"), User: powerscale.PtrString(`DOMAIN\bob`)},
+		{Computer: powerscale.PtrString("client3"), User: powerscale.PtrString(`DOMAIN\bob`)},
+	}
+	nodeClients := []powerscale.V1SummaryClientClientItem{
+		{RemoteAddr: powerscale.PtrString("10.10.1.2"), RemoteName: powerscale.PtrString("client2")},
+		{RemoteAddr: powerscale.PtrString("10.10.2.3"), RemoteName: powerscale.PtrString("CLIENT3")},
+	}
+
+	assert.Len(t, FilterSmbSessions(sessions, "", "", nil), 3)
+	assert.Len(t, FilterSmbSessions(sessions, "bob", "", nil), 2)
+	assert.Equal(t, "10.10.0.1", FilterSmbSessions(sessions, "", "10.10.0.0/24", nil)[0].GetComputer())
+	assert.Equal(t, "client3", FilterSmbSessions(sessions, "", "Client3", nil)[0].GetComputer())
+	// the sessions are matched with the clients of the nodes by address or by name
+	filtered := FilterSmbSessions(sessions, "", "", nodeClients)
+	assert.Len(t, filtered, 2)
+	assert.Equal(t, "10.10.1.2", filtered[0].GetComputer())
+	assert.Equal(t, "client3", filtered[1].GetComputer())
+	// no active client on the nodes matches no session
+	assert.Empty(t, FilterSmbSessions(sessions, "", "", []powerscale.V1SummaryClientClientItem{}))
+}
+
+func Test_MatchProtocolUser(t *testing.T) {
+	assert.True(t, MatchProtocolUser(`DOMAIN\Alice`, "alice"))
+	assert.True(t, MatchProtocolUser(`DOMAIN\Alice`, `domain\alice`))
+	assert.True(t, MatchProtocolUser("root", "root"))
+	assert.False(t, MatchProtocolUser(`DOMAIN\Alice`, `OTHER\alice`))
+	assert.False(t, MatchProtocolUser("alice", `DOMAIN\alice`))
+}
+
+func Test_MatchClientAddress(t *testing.T) {
+	assert.True(t, MatchClientAddress("10.10.0.5", "10.10.0.0/24"))
+	assert.False(t, MatchClientAddress("10.10.1.5", "10.10.0.0/24"))
+	assert.True(t, MatchClientAddress("fd00::1", "fd00:0::1"))
+	assert.False(t, MatchClientAddress("10.10.0.5", "10.10.0.6"))
+}
+
+func Test_FilterNfsClients(t *testing.T) {
+	clients := []powerscale.V1SummaryClientClientItem{
+		{LocalAddr: powerscale.PtrString("10.0.0.5"), RemoteAddr: powerscale.PtrString("10.10.0.1")},
+		{LocalAddr: powerscale.PtrString("10.1.0.5"), RemoteAddr: powerscale.PtrString("10.10.0.2")},
+	}
+	ranges := []powerscale.V12GroupnetSubnetScServiceAddr{{Low: "10.0.0.1", High: "10.0.0.10"}}
+
+	assert.Len(t, FilterNfsClients(clients, nil, "", ""), 2)
+	assert.Equal(t, "10.10.0.1", FilterNfsClients(clients, ranges, "", "")[0].GetRemoteAddr())
+	assert.Equal(t, "10.10.0.2", FilterNfsClients(clients, nil, "", "10.10.0.2")[0].GetRemoteAddr())
+	// a zone without pools has no client
+	assert.Empty(t, FilterNfsClients(clients, []powerscale.V12GroupnetSubnetScServiceAddr{}, "", ""))
+}
@@ -23,6 +23,7 @@ import (
 	"errors"
 	"terraform-provider-powerscale/client"
 	"terraform-provider-powerscale/powerscale/models"
+	"time"
 )
 
 // GenerateS3Key generates S3 Key.
@@ -69,3 +70,10 @@ func DeleteS3Key(ctx context.Context, c *client.Client, state models.S3KeyResour
 	}
 	return err
 }
+
+// IsS3KeyRotationDue returns whether the secret key generated at the timestamp, in seconds, is due for rotation at the given time.
+// The key is due rotateBeforeExpiry days before it is rotationDays old.
+func IsS3KeyRotationDue(secretKeyTimestamp int64, rotationDays int64, rotateBeforeExpiry int64, now time.Time) bool {
+	dueTime := time.Unix(secretKeyTimestamp, 0).Add(time.Duration(rotationDays-rotateBeforeExpiry) * 24 * time.Hour)
+	return !now.Before(dueTime)
+}
@@ -0,0 +1,36 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_IsS3KeyRotationDue(t *testing.T) {
+	generated := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	timestamp := generated.Unix()
+
+	assert.False(t, IsS3KeyRotationDue(timestamp, 30, 0, generated.Add(29*24*time.Hour)))
+	assert.True(t, IsS3KeyRotationDue(timestamp, 30, 0, generated.Add(30*24*time.Hour)))
+	// the key is rotated ahead of its maximum age
+	assert.True(t, IsS3KeyRotationDue(timestamp, 30, 7, generated.Add(23*24*time.Hour)))
+	assert.False(t, IsS3KeyRotationDue(timestamp, 30, 7, generated.Add(22*24*time.Hour)))
+}
@@ -20,6 +20,9 @@ package helper
 import (
 	"context"
 	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"slices"
+	"strings"
 	"sync"
 	"terraform-provider-powerscale/client"
 	"terraform-provider-powerscale/powerscale/models"
@@ -115,3 +118,104 @@ func ListSmbShares(ctx context.Context, client *client.Client, smbFilter *models
 	}
 	return &totalSmbShares, nil
 }
+
+// SmbSharePermissionMatches returns whether a permission entry of an SMB share is the entry of the trustee
+// and the permission type. The trustee is matched by ID when known, else by name and type.
+func SmbSharePermissionMatches(permission powerscale.V1SmbSharePermission, trustee models.V1AuthAccessAccessItemFileGroup, permissionType string) bool {
+	if permission.PermissionType != permissionType {
+		return false
+	}
+	if id := trustee.ID.ValueString(); id != "" && permission.Trustee.GetId() == id {
+		return true
+	}
+	return trustee.Name.ValueString() != "" && strings.EqualFold(permission.Trustee.GetName(), trustee.Name.ValueString()) &&
+		permission.Trustee.GetType() == trustee.Type.ValueString()
+}
+
+// FindSmbSharePermission returns the permission entry of the trustee and the permission type, nil if there is none.
+func FindSmbSharePermission(share powerscale.V7SmbShareExtended, trustee models.V1AuthAccessAccessItemFileGroup, permissionType string) *powerscale.V1SmbSharePermission {
+	for _, permission := range share.Permissions {
+		if SmbSharePermissionMatches(permission, trustee, permissionType) {
+			return &permission
+		}
+	}
+	return nil
+}
+
+// ModifySmbSharePermissions reads the permission entries of an SMB share, modifies them and writes them back.
+// The whole read-modify-write runs under the SMB share lock, so that the entries managed concurrently
+// by several resources of the same apply are merged instead of overwritten.
+func ModifySmbSharePermissions(ctx context.Context, client *client.Client, shareName string, zone *string,
+	modify func([]powerscale.V1SmbSharePermission) []powerscale.V1SmbSharePermission) error {
+	smbShare.Lock()
+	defer smbShare.Unlock()
+
+	share, err := GetSmbShare(ctx, client, shareName, zone)
+	if err != nil {
+		return err
+	}
+	if len(share.Shares) == 0 {
+		return fmt.Errorf("smb share %s not found", shareName)
+	}
+	permissions := modify(slices.Clone(share.Shares[0].Permissions))
+	if permissions == nil {
+		permissions = []powerscale.V1SmbSharePermission{}
+	}
+
+	updateParam := client.PscaleOpenAPIClient.ProtocolsApi.UpdateProtocolsv7SmbShare(ctx, shareName).
+		V7SmbShare(powerscale.V7SmbShareExtendedExtended{Permissions: permissions})
+	if zone != nil {
+		updateParam = updateParam.Zone(*zone)
+	}
+	_, err = updateParam.Execute()
+	return err
+}
+
+// SetSmbSharePermission adds the permission entry of the trustee and the permission type to an SMB share,
+// or updates its permission if the entry already exists. The other entries are kept.
+func SetSmbSharePermission(ctx context.Context, client *client.Client, plan models.SmbSharePermissionResourceModel) error {
+	return ModifySmbSharePermissions(ctx, client, plan.ShareName.ValueString(), plan.Zone.ValueStringPointer(),
+		func(permissions []powerscale.V1SmbSharePermission) []powerscale.V1SmbSharePermission {
+			for i := range permissions {
+				if SmbSharePermissionMatches(permissions[i], plan.Trustee, plan.PermissionType.ValueString()) {
+					permissions[i].Permission = plan.Permission.ValueString()
+					return permissions
+				}
+			}
+			return append(permissions, powerscale.V1SmbSharePermission{
+				Permission:     plan.Permission.ValueString(),
+				PermissionType: plan.PermissionType.ValueString(),
+				Trustee: powerscale.V1AuthAccessAccessItemFileGroup{
+					Id:   ValueToPointer[string](plan.Trustee.ID),
+					Name: ValueToPointer[string](plan.Trustee.Name),
+					Type: ValueToPointer[string](plan.Trustee.Type),
+				},
+			})
+		})
+}
+
+// RemoveSmbSharePermission removes the permission entry of the trustee and the permission type from an SMB share.
+// The other entries are kept.
+func RemoveSmbSharePermission(ctx context.Context, client *client.Client, state models.SmbSharePermissionResourceModel) error {
+	return ModifySmbSharePermissions(ctx, client, state.ShareName.ValueString(), state.Zone.ValueStringPointer(),
+		func(permissions []powerscale.V1SmbSharePermission) []powerscale.V1SmbSharePermission {
+			return slices.DeleteFunc(permissions, func(permission powerscale.V1SmbSharePermission) bool {
+				return SmbSharePermissionMatches(permission, state.Trustee, state.PermissionType.ValueString())
+			})
+		})
+}
+
+// ParseSmbSharePermissionID parses the identifier of an SMB share permission entry,
+// of the form [zone:]share_name:permission_type:trustee_id, the trustee ID itself containing colons.
+func ParseSmbSharePermissionID(id string) (zone string, shareName string, permissionType string, trusteeID string, err error) {
+	parts := strings.Split(id, ":")
+	for i := 1; i < len(parts)-1 && i <= 2; i++ {
+		if parts[i] == "allow" || parts[i] == "deny" {
+			if i == 2 {
+				zone = parts[0]
+			}
+			return zone, parts[i-1], parts[i], strings.Join(parts[i+1:], ":"), nil
+		}
+	}
+	return "", "", "", "", fmt.Errorf("invalid identifier %s, expected [zone:]share_name:permission_type:trustee_id", id)
+}
@@ -0,0 +1,37 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ParseSmbSharePermissionID(t *testing.T) {
+	zone, shareName, permissionType, trusteeID, err := ParseSmbSharePermissionID("System:share:allow:SID:S-1-1-0")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"System", "share", "allow", "SID:S-1-1-0"}, []string{zone, shareName, permissionType, trusteeID})
+
+	zone, shareName, permissionType, trusteeID, err = ParseSmbSharePermissionID("share:deny:UID:2000")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"", "share", "deny", "UID:2000"}, []string{zone, shareName, permissionType, trusteeID})
+
+	_, _, _, _, err = ParseSmbSharePermissionID("share:UID:2000")
+	assert.NotNil(t, err)
+}
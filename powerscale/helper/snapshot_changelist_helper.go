@@ -0,0 +1,326 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ErrSnapshotChangelistNotFound is returned when the changelist does not exist on the cluster.
+var ErrSnapshotChangelistNotFound = errors.New("changelist not found")
+
+// changelistDefaultPageSize is the number of entries requested per page when none is configured.
+const changelistDefaultPageSize = 1000
+
+// changelistChangeTypes maps the change_types bit flags of a changelist entry to readable names.
+var changelistChangeTypes = []struct {
+	flag int64
+	name string
+}{
+	{0x1, "added"},
+	{0x2, "removed"},
+	{0x4, "path_changed"},
+	{0x8, "modified"},
+	{0x10, "ads"},
+	{0x20, "has_ads"},
+	{0x40, "symlink"},
+	{0x80, "hardlinks"},
+}
+
+// ChangelistChangeTypeNames returns the supported change type names.
+func ChangelistChangeTypeNames() []string {
+	names := make([]string, 0, len(changelistChangeTypes))
+	for _, changeType := range changelistChangeTypes {
+		names = append(names, changeType.name)
+	}
+	return names
+}
+
+// DecodeChangelistChangeTypes decodes the change_types bit flags of a changelist entry.
+func DecodeChangelistChangeTypes(flags int64) []string {
+	changes := []string{}
+	for _, changeType := range changelistChangeTypes {
+		if flags&changeType.flag != 0 {
+			changes = append(changes, changeType.name)
+		}
+	}
+	return changes
+}
+
+// GetSnapshotChangelistName returns the name OneFS gives to the changelist of a snapshot pair.
+func GetSnapshotChangelistName(olderSnapID, newerSnapID int64) string {
+	return fmt.Sprintf("%d_%d", olderSnapID, newerSnapID)
+}
+
+// GetSnapshotChangelist returns a specific changelist.
+// ErrSnapshotChangelistNotFound is returned when the cluster lists no such changelist.
+func GetSnapshotChangelist(ctx context.Context, client *client.Client, changelistID string) (*powerscale.V1SnapshotChangelistExtended, *http.Response, error) {
+	result, httpResp, err := client.PscaleOpenAPIClient.SnapshotApi.GetSnapshotv1SnapshotChangelist(ctx, changelistID).Execute()
+	if err != nil {
+		return nil, httpResp, err
+	}
+	if len(result.Changelists) == 0 {
+		return nil, httpResp, fmt.Errorf("%w: %s", ErrSnapshotChangelistNotFound, changelistID)
+	}
+	return &result.Changelists[0], httpResp, nil
+}
+
+// DeleteSnapshotChangelist deletes a changelist.
+func DeleteSnapshotChangelist(ctx context.Context, client *client.Client, changelistID string) error {
+	httpResp, err := client.PscaleOpenAPIClient.SnapshotApi.DeleteSnapshotv1SnapshotChangelist(ctx, changelistID).Execute()
+	if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		return nil // already deleted
+	}
+	return err
+}
+
+// GetSnapshotChangelistEntries pages through the entries of a changelist.
+// Pages are requested until the resume token is exhausted or the configured limit is reached.
+func GetSnapshotChangelistEntries(ctx context.Context, client *client.Client, changelistID string, filter *models.SnapshotChangelistFilterType) ([]powerscale.V1SnapshotChangelistLinExtended, error) {
+	pageSize := int32(changelistDefaultPageSize)
+	var limit int64
+	if filter != nil {
+		if !filter.PageSize.IsNull() {
+			pageSize = filter.PageSize.ValueInt32()
+		}
+		if !filter.Limit.IsNull() {
+			limit = filter.Limit.ValueInt64()
+		}
+	}
+
+	var entries []powerscale.V1SnapshotChangelistLinExtended
+	linParams := client.PscaleOpenAPIClient.SnapshotChangelistsApi.ListSnapshotChangelistsv1ChangelistLins(ctx, changelistID).Limit(pageSize)
+	for {
+		result, _, err := linParams.Execute()
+		if err != nil {
+			return entries, err
+		}
+		for _, lin := range result.Lins {
+			if !matchChangelistEntry(lin, filter) {
+				continue
+			}
+			entries = append(entries, lin)
+			if limit > 0 && int64(len(entries)) >= limit {
+				return entries, nil
+			}
+		}
+		if result.Resume == nil || *result.Resume == "" {
+			break
+		}
+		// the resume token carries the page size of the original request
+		linParams = client.PscaleOpenAPIClient.SnapshotChangelistsApi.ListSnapshotChangelistsv1ChangelistLins(ctx, changelistID).Resume(*result.Resume)
+	}
+	return entries, nil
+}
+
+// matchChangelistEntry checks whether a changelist entry satisfies the path prefix and change type filters.
+func matchChangelistEntry(lin powerscale.V1SnapshotChangelistLinExtended, filter *models.SnapshotChangelistFilterType) bool {
+	if filter == nil {
+		return true
+	}
+	if prefix := filter.PathPrefix.ValueString(); prefix != "" && !IsPathWithin(lin.GetPath(), prefix) {
+		return false
+	}
+	if !filter.ChangeTypes.IsNull() && len(filter.ChangeTypes.Elements()) > 0 {
+		wanted := make(map[string]bool)
+		for _, element := range filter.ChangeTypes.Elements() {
+			if changeType, ok := element.(types.String); ok {
+				wanted[changeType.ValueString()] = true
+			}
+		}
+		for _, changeType := range DecodeChangelistChangeTypes(int64(lin.GetChangeTypes())) {
+			if wanted[changeType] {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// IsPathWithin checks whether the path is the given directory or lies below it.
+// Both paths are cleaned first, so "/ifs/data/" and "/ifs/data" are the same directory
+// while "/ifs/database" does not lie below "/ifs/data".
+func IsPathWithin(filePath string, directory string) bool {
+	filePath = path.Clean("/" + strings.TrimPrefix(filePath, "/"))
+	directory = path.Clean("/" + strings.TrimPrefix(directory, "/"))
+	if directory == "/" || filePath == directory {
+		return true
+	}
+	return strings.HasPrefix(filePath, directory+"/")
+}
+
+// SnapshotChangelistEntryMapper does the mapping from a changelist entry to model.
+func SnapshotChangelistEntryMapper(ctx context.Context, lin powerscale.V1SnapshotChangelistLinExtended) (models.SnapshotChangelistEntry, error) {
+	entry := models.SnapshotChangelistEntry{}
+	err := CopyFields(ctx, &lin, &entry)
+	if err != nil {
+		return entry, err
+	}
+	changeTypes, diags := types.ListValueFrom(ctx, types.StringType, DecodeChangelistChangeTypes(int64(lin.GetChangeTypes())))
+	if diags.HasError() {
+		return entry, fmt.Errorf("could not decode change types of %s", lin.GetPath())
+	}
+	entry.ChangeTypes = changeTypes
+	return entry, nil
+}
+
+// UpdateSnapshotChangelistState updates the resource state from the changelist returned by the array.
+func UpdateSnapshotChangelistState(ctx context.Context, state *models.SnapshotChangelistResourceModel, changelist *powerscale.V1SnapshotChangelistExtended) error {
+	detail := models.SnapshotChangelistDetail{}
+	if err := CopyFields(ctx, changelist, &detail); err != nil {
+		return err
+	}
+	state.ID = types.StringValue(changelist.GetId())
+	state.NumEntries = detail.NumEntries
+	state.RootPath = detail.RootPath
+	state.Status = detail.Status
+	if !detail.JobID.IsNull() && !detail.JobID.IsUnknown() {
+		state.JobID = detail.JobID
+	}
+	if state.JobID.IsUnknown() {
+		state.JobID = types.Int64Null()
+	}
+	// older and newer snapshot ids are encoded into the changelist name
+	if ids := strings.Split(changelist.GetId(), "_"); len(ids) == 2 {
+		if older, err := strconv.ParseInt(ids[0], 10, 64); err == nil {
+			state.OlderSnapshotID = types.Int64Value(older)
+		}
+		if newer, err := strconv.ParseInt(ids[1], 10, 64); err == nil {
+			state.NewerSnapshotID = types.Int64Value(newer)
+		}
+	}
+	return nil
+}
+
+// CreateSnapshotChangelist launches a ChangelistCreate job for the snapshot pair and waits for it to finish.
+func CreateSnapshotChangelist(ctx context.Context, client *client.Client, plan models.SnapshotChangelistResourceModel) (state models.SnapshotChangelistResourceModel, diags diag.Diagnostics) {
+	state = plan
+	olderID := plan.OlderSnapshotID.ValueInt64()
+	newerID := plan.NewerSnapshotID.ValueInt64()
+	if olderID >= newerID {
+		diags.AddError(
+			"Invalid snapshot pair",
+			fmt.Sprintf("older_snapshot_id (%d) must be less than newer_snapshot_id (%d)", olderID, newerID),
+		)
+		return state, diags
+	}
+	if olderID > int64(^uint32(0)>>1) || newerID > int64(^uint32(0)>>1) {
+		diags.AddError("Invalid snapshot pair", "Snapshot IDs are out of range")
+		return state, diags
+	}
+
+	// both snapshots must exist and cover the same root path
+	var snapPaths []string
+	for _, snapID := range []int64{olderID, newerID} {
+		snap, err := GetSpecificSnapshot(ctx, client, strconv.FormatInt(snapID, 10))
+		if err != nil {
+			errStr := constants.ReadSnapshotErrorMessage + "with error: "
+			message := GetErrorString(err, errStr)
+			diags.AddError(
+				fmt.Sprintf("Error getting the snapshot with id %d", snapID),
+				message,
+			)
+			return state, diags
+		}
+		snapPaths = append(snapPaths, snap.Path)
+	}
+	if path.Clean(snapPaths[0]) != path.Clean(snapPaths[1]) {
+		diags.AddError(
+			"Invalid snapshot pair",
+			fmt.Sprintf("Snapshots %d and %d do not share the same path (%s, %s)", olderID, newerID, snapPaths[0], snapPaths[1]),
+		)
+		return state, diags
+	}
+
+	payload := powerscale.V10JobJob{
+		Type:     "ChangelistCreate",
+		AllowDup: plan.AllowDup.ValueBoolPointer(),
+		ChangelistcreateParams: &powerscale.V1JobJobChangelistcreateParams{
+			OlderSnapid:    int32(olderID), // #nosec G115 --- validated above
+			NewerSnapid:    int32(newerID), // #nosec G115 --- validated above
+			RetainRepstate: plan.RetainRepstate.ValueBoolPointer(),
+		},
+	}
+	createResponse, err := CreateSnapshotRestoreJob(ctx, client, payload)
+	if err != nil {
+		errStr := constants.CreateSnapshotChangelistErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		diags.AddError(
+			"Error creating ChangelistCreate job",
+			message,
+		)
+		return state, diags
+	}
+	strID := strconv.Itoa(int(createResponse.Id))
+	tflog.Info(ctx, fmt.Sprintf("ChangelistCreate job id: %v", createResponse.Id))
+	jobResponse, err := GetSnapshotRestoreJob(ctx, client, strID)
+	if err != nil {
+		errStr := constants.ReadSnapshotRestoreJobErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		diags.AddError(
+			"Error getting job",
+			message,
+		)
+		return state, diags
+	}
+	jobResponse, jobDiags := CheckJobStatus(ctx, client, strID, jobResponse)
+	if jobDiags.HasError() {
+		return state, jobDiags
+	}
+	if jobResponse.State == "failed" {
+		diags.AddError(
+			"Error creating snapshot changelist",
+			fmt.Sprintf("ChangelistCreate job %s failed", strID),
+		)
+		return state, diags
+	}
+
+	state.JobID = types.Int64Value(int64(createResponse.Id))
+	changelist, _, err := GetSnapshotChangelist(ctx, client, GetSnapshotChangelistName(olderID, newerID))
+	if err != nil {
+		errStr := constants.ReadSnapshotChangelistErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		diags.AddError(
+			"Error reading snapshot changelist",
+			message,
+		)
+		return state, diags
+	}
+	if err := UpdateSnapshotChangelistState(ctx, &state, changelist); err != nil {
+		diags.AddError(
+			"Error reading snapshot changelist",
+			err.Error(),
+		)
+	}
+	return state, diags
+}
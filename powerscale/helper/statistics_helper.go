@@ -0,0 +1,211 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GetStatistics queries the current values of the statistics keys of the state,
+// or their historical values when a history window is set.
+func GetStatistics(ctx context.Context, client *client.Client, state models.StatisticsDataSourceModel) ([]models.StatisticModel, error) {
+	var keys []string
+	if diags := state.Keys.ElementsAs(ctx, &keys, false); diags.HasError() {
+		return nil, fmt.Errorf("could not read the statistics keys")
+	}
+	var devids []string
+	if !state.Nodes.IsNull() && !state.Nodes.IsUnknown() {
+		var nodes []int64
+		if diags := state.Nodes.ElementsAs(ctx, &nodes, false); diags.HasError() {
+			return nil, fmt.Errorf("could not read the statistics nodes")
+		}
+		for _, node := range nodes {
+			devids = append(devids, strconv.FormatInt(node, 10))
+		}
+	}
+
+	var statistics []models.StatisticModel
+	if state.HistoryWindow.IsNull() {
+		param := client.PscaleOpenAPIClient.StatisticsApi.GetStatisticsv1StatisticsCurrent(ctx).Keys(keys)
+		if len(devids) > 0 {
+			param = param.Devid(devids)
+		}
+		resp, _, err := param.Execute()
+		if err != nil {
+			return nil, err
+		}
+		for _, stat := range resp.Stats {
+			value, number := StatisticValueMapper(stat.GetValue())
+			statistics = append(statistics, models.StatisticModel{
+				Key:         types.StringValue(stat.GetKey()),
+				Devid:       types.Int64Value(int64(stat.GetDevid())),
+				Time:        types.Int64Value(int64(stat.GetTime())),
+				Value:       value,
+				ValueNumber: number,
+				Values:      []models.StatisticValueModel{},
+				Error:       types.StringValue(stat.GetError()),
+				ErrorCode:   types.Int64Value(int64(stat.GetErrorCode())),
+			})
+		}
+		return statistics, nil
+	}
+
+	// negative begin times are relative to now
+	param := client.PscaleOpenAPIClient.StatisticsApi.GetStatisticsv1StatisticsHistory(ctx).Keys(keys).
+		Begin(-int32(state.HistoryWindow.ValueInt64())) // #nosec G115 --- validated, history_window is limited to the int32 range
+	if len(devids) > 0 {
+		param = param.Devid(devids)
+	}
+	if !state.Resolution.IsNull() {
+		param = param.Resolution(int32(state.Resolution.ValueInt64())) // #nosec G115 --- validated, resolution is limited to the int32 range
+	}
+	resp, _, err := param.Execute()
+	if err != nil {
+		return nil, err
+	}
+	for _, stat := range resp.Stats {
+		model := models.StatisticModel{
+			Key:         types.StringValue(stat.GetKey()),
+			Devid:       types.Int64Value(int64(stat.GetDevid())),
+			Time:        types.Int64Null(),
+			Value:       types.StringNull(),
+			ValueNumber: types.Float64Null(),
+			Values:      []models.StatisticValueModel{},
+			Error:       types.StringValue(stat.GetError()),
+			ErrorCode:   types.Int64Value(int64(stat.GetErrorCode())),
+		}
+		for _, historical := range stat.GetValues() {
+			value, number := StatisticValueMapper(historical.GetValue())
+			model.Values = append(model.Values, models.StatisticValueModel{
+				Time:        types.Int64Value(int64(historical.GetTime())),
+				Value:       value,
+				ValueNumber: number,
+			})
+		}
+		// the latest value is exposed the same way as for current statistics
+		if len(model.Values) > 0 {
+			latest := model.Values[len(model.Values)-1]
+			model.Time, model.Value, model.ValueNumber = latest.Time, latest.Value, latest.ValueNumber
+		}
+		statistics = append(statistics, model)
+	}
+	return statistics, nil
+}
+
+// StatisticValueMapper maps a statistics value, which depending on the key can be a number,
+// a string or a structure, to its string representation and to its numeric value if any.
+func StatisticValueMapper(value interface{}) (types.String, types.Float64) {
+	switch v := value.(type) {
+	case nil:
+		return types.StringNull(), types.Float64Null()
+	case *string:
+		if v == nil {
+			return types.StringNull(), types.Float64Null()
+		}
+		return StatisticValueMapper(*v)
+	case string:
+		if number, err := strconv.ParseFloat(v, 64); err == nil {
+			return types.StringValue(v), types.Float64Value(number)
+		}
+		return types.StringValue(v), types.Float64Null()
+	case float64:
+		return types.StringValue(strconv.FormatFloat(v, 'f', -1, 64)), types.Float64Value(v)
+	case float32:
+		return types.StringValue(strconv.FormatFloat(float64(v), 'f', -1, 32)), types.Float64Value(float64(v))
+	case int32:
+		return types.StringValue(strconv.FormatInt(int64(v), 10)), types.Float64Value(float64(v))
+	case int64:
+		return types.StringValue(strconv.FormatInt(v, 10)), types.Float64Value(float64(v))
+	case int:
+		return types.StringValue(strconv.Itoa(v)), types.Float64Value(float64(v))
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return types.StringValue(fmt.Sprintf("%v", value)), types.Float64Null()
+	}
+	return StatisticValueMapper(string(encoded))
+}
+
+// GetStatisticsKeys returns the statistics keys supported by the cluster.
+func GetStatisticsKeys(ctx context.Context, client *client.Client, filter *models.StatisticsKeysFilterType) ([]models.StatisticsKeyModel, error) {
+	param := client.PscaleOpenAPIClient.StatisticsApi.GetStatisticsv1StatisticsKeys(ctx)
+	if filter != nil && !filter.Queryable.IsNull() {
+		param = param.Queryable(filter.Queryable.ValueBool())
+	}
+	resp, _, err := param.Execute()
+	if err != nil {
+		return nil, err
+	}
+	keys := resp.Keys
+	for resp.Resume != nil {
+		resp, _, err = client.PscaleOpenAPIClient.StatisticsApi.GetStatisticsv1StatisticsKeys(ctx).Resume(*resp.Resume).Execute()
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, resp.Keys...)
+	}
+
+	var statisticsKeys []models.StatisticsKeyModel
+	for _, key := range keys {
+		statisticsKeys = append(statisticsKeys, models.StatisticsKeyModel{
+			Key:              types.StringValue(key.GetKey()),
+			Description:      types.StringValue(key.GetDescription()),
+			Type:             types.StringValue(key.GetType()),
+			Units:            types.StringValue(key.GetUnits()),
+			Scope:            types.StringValue(key.GetScope()),
+			AggregationType:  types.StringValue(key.GetAggregationType()),
+			DefaultCacheTime: types.Int64Value(int64(key.GetDefaultCacheTime())),
+		})
+	}
+	return statisticsKeys, nil
+}
+
+// FilterStatisticsKeys returns the statistics keys matching the names and substring of the filter.
+func FilterStatisticsKeys(ctx context.Context, keys []models.StatisticsKeyModel, filter *models.StatisticsKeysFilterType) ([]models.StatisticsKeyModel, error) {
+	if filter == nil {
+		return keys, nil
+	}
+
+	var names []string
+	if !filter.Keys.IsNull() && !filter.Keys.IsUnknown() {
+		if diags := filter.Keys.ElementsAs(ctx, &names, false); diags.HasError() {
+			return nil, fmt.Errorf("could not read the keys filter")
+		}
+	}
+
+	var filtered []models.StatisticsKeyModel
+	for _, key := range keys {
+		if len(names) > 0 && !slices.Contains(names, key.Key.ValueString()) {
+			continue
+		}
+		if !filter.Substr.IsNull() && !strings.Contains(key.Key.ValueString(), filter.Substr.ValueString()) {
+			continue
+		}
+		filtered = append(filtered, key)
+	}
+	return filtered, nil
+}
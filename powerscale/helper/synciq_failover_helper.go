@@ -0,0 +1,358 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// SyncIQFailoverDirection drives the failover workflow.
+	SyncIQFailoverDirection = "failover"
+	// SyncIQFailbackDirection drives the failback workflow.
+	SyncIQFailbackDirection = "failback"
+	// SyncIQFailoverStatusComplete is the status of a workflow whose steps have all completed.
+	SyncIQFailoverStatusComplete = "complete"
+	// SyncIQFailoverStatusIncomplete is the status of a workflow that still has steps left to run.
+	SyncIQFailoverStatusIncomplete = "incomplete"
+
+	// syncIQJobPollInterval is the interval between two polls of a running SyncIQ job.
+	syncIQJobPollInterval = 5 * time.Second
+)
+
+// SyncIQFailoverStep describes a single step of the failover or failback workflow.
+type SyncIQFailoverStep struct {
+	// Name of the step as recorded in the state.
+	Name string
+	// Whether the step runs on the target cluster.
+	OnTarget bool
+	// Name of the policy the job is started for.
+	Policy string
+	// Action of the SyncIQ job.
+	Action string
+}
+
+// GetSyncIQFailoverMirrorPolicyName returns the mirror policy name, defaulting to <policy>_mirror.
+func GetSyncIQFailoverMirrorPolicyName(plan models.SyncIQFailoverResourceModel) string {
+	if !plan.MirrorPolicyName.IsNull() && !plan.MirrorPolicyName.IsUnknown() && plan.MirrorPolicyName.ValueString() != "" {
+		return plan.MirrorPolicyName.ValueString()
+	}
+	return plan.PolicyName.ValueString() + "_mirror"
+}
+
+// GetSyncIQFailoverSteps returns the ordered steps of the workflow selected by direction.
+func GetSyncIQFailoverSteps(plan models.SyncIQFailoverResourceModel) []SyncIQFailoverStep {
+	policy := plan.PolicyName.ValueString()
+	mirror := GetSyncIQFailoverMirrorPolicyName(plan)
+
+	if plan.Direction.ValueString() == SyncIQFailbackDirection {
+		return []SyncIQFailoverStep{
+			{Name: "resync_prep_on_source", OnTarget: false, Policy: policy, Action: "resync_prep"},
+			{Name: "run_mirror_policy_on_target", OnTarget: true, Policy: mirror, Action: "run"},
+			{Name: "allow_write_mirror_on_source", OnTarget: false, Policy: mirror, Action: "allow_write"},
+			{Name: "resync_prep_mirror_on_target", OnTarget: true, Policy: mirror, Action: "resync_prep"},
+		}
+	}
+
+	var steps []SyncIQFailoverStep
+	if plan.SyncBeforeFailover.ValueBool() {
+		steps = append(steps, SyncIQFailoverStep{Name: "run_policy_on_source", OnTarget: false, Policy: policy, Action: "run"})
+	}
+	return append(steps, SyncIQFailoverStep{Name: "allow_write_on_target", OnTarget: true, Policy: policy, Action: "allow_write"})
+}
+
+// NewSyncIQFailoverTargetClient returns a client for the target cluster of the failover workflow.
+func NewSyncIQFailoverTargetClient(ctx context.Context, target types.Object) (*client.Client, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var cluster models.SyncIQFailoverClusterModel
+
+	diags.Append(target.As(ctx, &cluster, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true})...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// use the same defaults as the provider
+	authType := int64(client.SessionAuthType)
+	if !cluster.AuthType.IsNull() && !cluster.AuthType.IsUnknown() {
+		authType = cluster.AuthType.ValueInt64()
+	}
+	timeout := int64(2000)
+	if !cluster.Timeout.IsNull() && !cluster.Timeout.IsUnknown() {
+		timeout = cluster.Timeout.ValueInt64()
+	}
+
+	targetClient, err := client.NewClient(
+		cluster.Endpoint.ValueString(),
+		cluster.Insecure.ValueBool(),
+		cluster.Username.ValueString(),
+		cluster.Password.ValueString(),
+		authType,
+		timeout,
+	)
+	if err != nil {
+		errStr := constants.SyncIQFailoverTargetClientErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		diags.AddError(
+			"Error connecting to the SyncIQ target cluster",
+			message,
+		)
+		return nil, diags
+	}
+	return targetClient, diags
+}
+
+// GetSyncIQTargetPolicyFailoverState returns the failover/failback state of a policy on the target cluster.
+func GetSyncIQTargetPolicyFailoverState(ctx context.Context, client *client.Client, policy string) (string, *http.Response, error) {
+	targetPolicy, httpResp, err := GetSyncIQTargetPolicy(ctx, client, policy)
+	if err != nil {
+		return "", httpResp, err
+	}
+	return targetPolicy.GetFailoverFailbackState(), httpResp, nil
+}
+
+// GetSyncIQJobStart returns the ID of the latest report of a policy, empty when it has none, and the current time of the cluster.
+// The report of a job started afterwards is the first report newer than both.
+func GetSyncIQJobStart(ctx context.Context, client *client.Client, policy string) (string, int64, error) {
+	report, err := getLatestSyncIQReport(ctx, client, policy)
+	if err != nil {
+		return "", 0, err
+	}
+	var previousReportID string
+	if report != nil {
+		previousReportID = report.GetId()
+	}
+	clusterTime, err := GetClusterTime(ctx, client)
+	if err != nil {
+		return "", 0, err
+	}
+	if len(clusterTime.Nodes) == 0 || clusterTime.Nodes[0].Time == nil {
+		return "", 0, fmt.Errorf("could not read the time of the cluster")
+	}
+	return previousReportID, int64(*clusterTime.Nodes[0].Time), nil
+}
+
+// WaitForSyncIQJob waits for the job of a policy started at startTime, in cluster time, to end and
+// returns an error unless its report finished.
+func WaitForSyncIQJob(ctx context.Context, client *client.Client, policy string, previousReportID string, startTime int64, timeout time.Duration) error {
+	report, err := waitForSyncIQReport(ctx, client, policy, "", previousReportID, startTime, timeout)
+	if err != nil {
+		return err
+	}
+	if report.GetState() != "finished" {
+		return fmt.Errorf("job of policy %s ended in state %s: %s", policy, report.GetState(), strings.Join(report.GetErrors(), "; "))
+	}
+	return nil
+}
+
+// waitForSyncIQReport waits until no job of the policy is running and a report of the job started at startTime,
+// newer than previousReportID and with the given action if any, exists, and returns that report.
+// A job that is not listed yet is waited for the same way as a running one.
+func waitForSyncIQReport(ctx context.Context, client *client.Client, policy string, action string, previousReportID string, startTime int64, timeout time.Duration) (*powerscale.V15SyncReport, error) {
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	for {
+		select {
+		case <-waitCtx.Done():
+			return nil, fmt.Errorf("timed out after %s waiting for the job of policy %s: %s", timeout, policy, waitCtx.Err().Error())
+		case <-time.After(syncIQJobPollInterval):
+		}
+
+		jobs, httpResp, err := GetSyncIQReplicationJob(waitCtx, client, policy)
+		if err != nil && (httpResp == nil || httpResp.StatusCode != http.StatusNotFound) {
+			return nil, err
+		}
+		if err == nil && len(jobs.Jobs) > 0 {
+			if state := jobs.Jobs[0].State; state == "failed" || state == "needs_attention" {
+				return nil, fmt.Errorf("job of policy %s is in state %s", policy, state)
+			}
+			continue
+		}
+
+		// no job is running, it either did not start yet or ended and left its report
+		report, err := getLatestSyncIQReport(waitCtx, client, policy)
+		if err != nil {
+			return nil, err
+		}
+		if report == nil || report.GetId() == previousReportID || int64(report.GetEndTime()) < startTime {
+			continue
+		}
+		if action != "" && report.GetAction() != action {
+			continue
+		}
+		return report, nil
+	}
+}
+
+// getLatestSyncIQReport returns the report of the last job of a policy, nil when the policy has no report.
+func getLatestSyncIQReport(ctx context.Context, client *client.Client, policy string) (*powerscale.V15SyncReport, error) {
+	reports, _, err := client.PscaleOpenAPIClient.SyncApi.GetSyncv15SyncReports(ctx).PolicyName(policy).Sort("end_time").Dir("DESC").Limit(1).Execute()
+	if err != nil {
+		return nil, err
+	}
+	if len(reports.Reports) == 0 {
+		return nil, nil
+	}
+	return &reports.Reports[0], nil
+}
+
+// IsSyncIQFailoverStepDone returns whether the policy and target policy states show that a workflow step already took effect,
+// ex. when a workflow is run again after a failed create, so that it is not repeated.
+func IsSyncIQFailoverStepDone(ctx context.Context, source, target *client.Client, plan models.SyncIQFailoverResourceModel, step SyncIQFailoverStep) (bool, error) {
+	policy := plan.PolicyName.ValueString()
+	mirror := GetSyncIQFailoverMirrorPolicyName(plan)
+
+	switch step.Name {
+	case "run_policy_on_source", "allow_write_on_target":
+		// the policy cannot run anymore once writes are allowed on the target
+		return isSyncIQTargetPolicyInState(ctx, target, policy, "writes_enabled")
+	case "resync_prep_on_source":
+		// resync_prep creates the mirror policy on the target
+		policies, err := GetAllSyncIQPolicies(ctx, target)
+		if err != nil {
+			return false, err
+		}
+		for _, p := range policies.Policies {
+			if p.Name == mirror {
+				return true, nil
+			}
+		}
+		return false, nil
+	case "run_mirror_policy_on_target", "allow_write_mirror_on_source":
+		// the mirror policy cannot run anymore once writes are allowed on the source
+		return isSyncIQTargetPolicyInState(ctx, source, mirror, "writes_enabled")
+	case "resync_prep_mirror_on_target":
+		// resync_prep of the mirror policy disables the writes on the target again
+		return isSyncIQTargetPolicyInState(ctx, target, policy, "writes_disabled")
+	}
+	return false, nil
+}
+
+// isSyncIQTargetPolicyInState returns whether the target policy of a policy is in the failover/failback state, false when it does not exist.
+func isSyncIQTargetPolicyInState(ctx context.Context, client *client.Client, policy string, state string) (bool, error) {
+	current, httpResp, err := GetSyncIQTargetPolicyFailoverState(ctx, client, policy)
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return current == state, nil
+}
+
+// RunSyncIQFailoverStep starts the job of a single workflow step and waits for it to end.
+func RunSyncIQFailoverStep(ctx context.Context, client *client.Client, step SyncIQFailoverStep, timeout time.Duration) error {
+	previousReportID, startTime, err := GetSyncIQJobStart(ctx, client, step.Policy)
+	if err != nil {
+		return err
+	}
+	action := step.Action
+	job := powerscale.V1SyncJob{
+		Id:     step.Policy,
+		Action: &action,
+	}
+	if _, err := CreateSyncIQReplicationJob(ctx, client, job); err != nil {
+		return err
+	}
+	return WaitForSyncIQJob(ctx, client, step.Policy, previousReportID, startTime, timeout)
+}
+
+// RunSyncIQFailoverWorkflow runs the steps of the workflow that are not in completed yet and returns the updated list of completed steps.
+func RunSyncIQFailoverWorkflow(ctx context.Context, source, target *client.Client, plan models.SyncIQFailoverResourceModel, completed []string) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	timeout := time.Duration(plan.JobTimeout.ValueInt64()) * time.Second
+
+	for _, step := range GetSyncIQFailoverSteps(plan) {
+		if slices.Contains(completed, step.Name) {
+			tflog.Info(ctx, fmt.Sprintf("step %s already completed, skipping", step.Name))
+			continue
+		}
+
+		done, err := IsSyncIQFailoverStepDone(ctx, source, target, plan, step)
+		if err != nil {
+			errStr := constants.SyncIQFailoverStepErrorMsg + step.Name + " with error: "
+			message := GetErrorString(err, errStr)
+			diags.AddError(
+				fmt.Sprintf("Error checking SyncIQ %s step %s", plan.Direction.ValueString(), step.Name),
+				message,
+			)
+			return completed, diags
+		}
+		if done {
+			tflog.Info(ctx, fmt.Sprintf("step %s already took effect for policy %s, skipping", step.Name, step.Policy))
+			completed = append(completed, step.Name)
+			continue
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("running step %s for policy %s", step.Name, step.Policy))
+		stepClient := source
+		if step.OnTarget {
+			stepClient = target
+		}
+		if err := RunSyncIQFailoverStep(ctx, stepClient, step, timeout); err != nil {
+			errStr := constants.SyncIQFailoverStepErrorMsg + step.Name + " with error: "
+			message := GetErrorString(err, errStr)
+			diags.AddError(
+				fmt.Sprintf("Error running SyncIQ %s step %s", plan.Direction.ValueString(), step.Name),
+				message+". Apply again to resume the workflow from this step.",
+			)
+			return completed, diags
+		}
+		completed = append(completed, step.Name)
+	}
+	return completed, diags
+}
+
+// UpdateSyncIQFailoverState records the progress of the workflow in the state.
+func UpdateSyncIQFailoverState(ctx context.Context, state *models.SyncIQFailoverResourceModel, completed []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	state.ID = state.PolicyName
+	state.MirrorPolicyName = types.StringValue(GetSyncIQFailoverMirrorPolicyName(*state))
+
+	completedList := make([]attr.Value, 0, len(completed))
+	for _, name := range completed {
+		completedList = append(completedList, types.StringValue(name))
+	}
+	state.CompletedSteps, diags = types.ListValue(types.StringType, completedList)
+
+	state.LastCompletedStep = types.StringValue("")
+	if len(completed) > 0 {
+		state.LastCompletedStep = types.StringValue(completed[len(completed)-1])
+	}
+
+	state.Status = types.StringValue(SyncIQFailoverStatusIncomplete)
+	if len(completed) == len(GetSyncIQFailoverSteps(*state)) {
+		state.Status = types.StringValue(SyncIQFailoverStatusComplete)
+	}
+	return diags
+}
@@ -20,10 +20,37 @@ package helper
 import (
 	"context"
 	powerscale "dell/powerscale-go-client"
+	"errors"
+	"fmt"
 	"net/http"
+	"strings"
 	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// SyncIQAssessmentReportAttrTypes are the attribute types of the report of a SyncIQ assessment job.
+var SyncIQAssessmentReportAttrTypes = map[string]attr.Type{
+	"id":                         types.StringType,
+	"state":                      types.StringType,
+	"start_time":                 types.Int64Type,
+	"end_time":                   types.Int64Type,
+	"duration":                   types.Int64Type,
+	"files_selected":             types.Int64Type,
+	"total_files":                types.Int64Type,
+	"total_data_bytes":           types.Int64Type,
+	"file_data_bytes":            types.Int64Type,
+	"dirs_new":                   types.Int64Type,
+	"dirs_changed":               types.Int64Type,
+	"dirs_deleted":               types.Int64Type,
+	"source_directories_visited": types.Int64Type,
+	"errors":                     types.ListType{ElemType: types.StringType},
+	"warnings":                   types.ListType{ElemType: types.StringType},
+}
+
 // GetSyncIQReplicationJob get syncIQ replication job.
 func GetSyncIQReplicationJob(ctx context.Context, client *client.Client, jobID string) (*powerscale.V1SyncJobsExtended, *http.Response, error) {
 	resp, httpResp, err := client.PscaleOpenAPIClient.SyncApi.GetSyncv1SyncJob(ctx, jobID).Execute()
@@ -65,3 +92,40 @@ func DeleteSyncIQReplicationJob(ctx context.Context, client *client.Client, jobI
 	}
 	return err
 }
+
+// WaitForSyncIQAssessment waits for the assessment job of a policy started at startTime, in cluster time, to end and returns its report,
+// the first test report newer than previousReportID. Both are returned by GetSyncIQJobStart before the job is started.
+func WaitForSyncIQAssessment(ctx context.Context, client *client.Client, policy string, previousReportID string, startTime int64, timeout time.Duration) (*powerscale.V15SyncReport, error) {
+	return waitForSyncIQReport(ctx, client, policy, "test", previousReportID, startTime, timeout)
+}
+
+// SyncIQAssessmentReportMapper maps the report of an assessment job to its model.
+func SyncIQAssessmentReportMapper(ctx context.Context, report *powerscale.V15SyncReport) (models.SyncIQAssessmentReportModel, error) {
+	model := models.SyncIQAssessmentReportModel{
+		Errors:   types.ListNull(types.StringType),
+		Warnings: types.ListNull(types.StringType),
+	}
+	err := CopyFields(ctx, report, &model)
+	return model, err
+}
+
+// CheckSyncIQAssessmentThresholds returns an error when the assessment job did not finish or its report exceeds any threshold.
+func CheckSyncIQAssessmentThresholds(report models.SyncIQAssessmentReportModel, assessment models.SyncIQAssessmentModel) error {
+	var exceeded []string
+	if report.State.ValueString() != "finished" {
+		exceeded = append(exceeded, fmt.Sprintf("the assessment job ended in state %s", report.State.ValueString()))
+	}
+	if !assessment.MaxFilesSelected.IsNull() && report.FilesSelected.ValueInt64() > assessment.MaxFilesSelected.ValueInt64() {
+		exceeded = append(exceeded, fmt.Sprintf("%d files selected exceed the maximum of %d", report.FilesSelected.ValueInt64(), assessment.MaxFilesSelected.ValueInt64()))
+	}
+	if !assessment.MaxBytesSelected.IsNull() && report.TotalDataBytes.ValueInt64() > assessment.MaxBytesSelected.ValueInt64() {
+		exceeded = append(exceeded, fmt.Sprintf("%d bytes selected exceed the maximum of %d", report.TotalDataBytes.ValueInt64(), assessment.MaxBytesSelected.ValueInt64()))
+	}
+	if errorCount := int64(len(report.Errors.Elements())); !assessment.MaxErrors.IsNull() && errorCount > assessment.MaxErrors.ValueInt64() {
+		exceeded = append(exceeded, fmt.Sprintf("%d errors exceed the maximum of %d", errorCount, assessment.MaxErrors.ValueInt64()))
+	}
+	if len(exceeded) > 0 {
+		return errors.New(strings.Join(exceeded, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"terraform-provider-powerscale/powerscale/models"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_CheckSyncIQAssessmentThresholds(t *testing.T) {
+	report := models.SyncIQAssessmentReportModel{
+		State:          types.StringValue("finished"),
+		FilesSelected:  types.Int64Value(1000),
+		TotalDataBytes: types.Int64Value(1048576),
+		Errors:         types.ListValueMust(types.StringType, []attr.Value{types.StringValue("unreadable file")}),
+	}
+
+	// no threshold
+	assessment := models.SyncIQAssessmentModel{
+		MaxFilesSelected: types.Int64Null(),
+		MaxBytesSelected: types.Int64Null(),
+		MaxErrors:        types.Int64Null(),
+	}
+	assert.NoError(t, CheckSyncIQAssessmentThresholds(report, assessment))
+
+	// within the thresholds
+	assessment.MaxFilesSelected = types.Int64Value(1000)
+	assessment.MaxBytesSelected = types.Int64Value(1048576)
+	assessment.MaxErrors = types.Int64Value(1)
+	assert.NoError(t, CheckSyncIQAssessmentThresholds(report, assessment))
+
+	// every exceeded threshold is reported
+	assessment.MaxFilesSelected = types.Int64Value(999)
+	assessment.MaxErrors = types.Int64Value(0)
+	err := CheckSyncIQAssessmentThresholds(report, assessment)
+	assert.ErrorContains(t, err, "1000 files selected exceed the maximum of 999")
+	assert.ErrorContains(t, err, "1 errors exceed the maximum of 0")
+	assert.NotContains(t, err.Error(), "bytes selected")
+
+	// an assessment job which did not finish fails
+	report.State = types.StringValue("failed")
+	assert.ErrorContains(t, CheckSyncIQAssessmentThresholds(report, models.SyncIQAssessmentModel{}), "ended in state failed")
+}
@@ -0,0 +1,140 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"cmp"
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"slices"
+	"strings"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// syncIQRpoReportsPerPolicy is the number of most recent reports of each policy used to compute its RPO status.
+const syncIQRpoReportsPerPolicy = 100
+
+// GetSyncIQRpoReports returns the most recent replication reports of each policy, newest first.
+func GetSyncIQRpoReports(ctx context.Context, client *client.Client) ([]powerscale.V15SyncReport, error) {
+	resp, _, err := client.PscaleOpenAPIClient.SyncApi.GetSyncv15SyncReports(ctx).Sort("end_time").Dir("DESC").ReportsPerPolicy(syncIQRpoReportsPerPolicy).Execute()
+	if err != nil {
+		return nil, err
+	}
+	reports := resp.Reports
+	for resp.Resume != nil {
+		resp, _, err = client.PscaleOpenAPIClient.SyncApi.GetSyncv15SyncReports(ctx).Resume(*resp.Resume).Execute()
+		if err != nil {
+			return reports, err
+		}
+		reports = append(reports, resp.Reports...)
+	}
+	return reports, nil
+}
+
+// SyncIQRpoStatusMapper computes the RPO status of a policy at the time now, in seconds since the epoch,
+// from its most recent reports. The policy breaches its RPO when it is enabled, has an RPO alert and
+// has not succeeded within the RPO alert. Assessment reports, with action test, do not replicate any data
+// and count neither as success nor as failure.
+func SyncIQRpoStatusMapper(policy powerscale.V14SyncPolicyExtended, reports []powerscale.V15SyncReport, now int64) models.SyncIQRpoStatusModel {
+	status := models.SyncIQRpoStatusModel{
+		PolicyID:            types.StringValue(policy.GetId()),
+		PolicyName:          types.StringValue(policy.GetName()),
+		Enabled:             types.BoolValue(policy.GetEnabled()),
+		Schedule:            types.StringValue(policy.GetSchedule()),
+		RpoAlert:            types.Int64Value(int64(policy.GetRpoAlert())),
+		LastSuccess:         types.Int64Null(),
+		Lag:                 types.Int64Null(),
+		ConsecutiveFailures: types.Int64Value(0),
+		LastError:           types.StringNull(),
+		LastJobState:        types.StringNull(),
+	}
+
+	reports = slices.Clone(reports)
+	slices.SortStableFunc(reports, func(a, b powerscale.V15SyncReport) int {
+		return cmp.Compare(b.GetEndTime(), a.GetEndTime())
+	})
+	if len(reports) > 0 {
+		status.LastJobState = types.StringValue(reports[0].GetState())
+	}
+
+	var lastSuccess int64
+	for _, report := range reports {
+		if report.GetAction() == "test" {
+			continue
+		}
+		state := report.GetState()
+		if state == "finished" {
+			lastSuccess = int64(report.GetEndTime())
+			break
+		}
+		if state == "failed" || state == "needs_attention" {
+			if status.ConsecutiveFailures.ValueInt64() == 0 {
+				status.LastError = types.StringValue(strings.Join(report.GetErrors(), "; "))
+			}
+			status.ConsecutiveFailures = types.Int64Value(status.ConsecutiveFailures.ValueInt64() + 1)
+		}
+	}
+	// the last success may be older than the reports kept for the policy
+	if lastSuccess == 0 {
+		lastSuccess = int64(policy.GetLastSuccess())
+	}
+	if lastSuccess > 0 {
+		status.LastSuccess = types.Int64Value(lastSuccess)
+		status.Lag = types.Int64Value(max(now-lastSuccess, 0))
+	}
+
+	rpoAlert := status.RpoAlert.ValueInt64()
+	status.Breached = types.BoolValue(policy.GetEnabled() && rpoAlert > 0 && (lastSuccess == 0 || now-lastSuccess > rpoAlert))
+	return status
+}
+
+// GetSyncIQRpoStatuses joins the policies with their reports and returns the RPO status of each policy.
+func GetSyncIQRpoStatuses(policies []powerscale.V14SyncPolicyExtended, reports []powerscale.V15SyncReport, now int64) []models.SyncIQRpoStatusModel {
+	policyReports := map[string][]powerscale.V15SyncReport{}
+	for _, report := range reports {
+		policyReports[report.GetPolicyId()] = append(policyReports[report.GetPolicyId()], report)
+	}
+	statuses := []models.SyncIQRpoStatusModel{}
+	for _, policy := range policies {
+		statuses = append(statuses, SyncIQRpoStatusMapper(policy, policyReports[policy.GetId()], now))
+	}
+	return statuses
+}
+
+// FilterSyncIQRpoStatuses returns the RPO statuses matching the filter.
+func FilterSyncIQRpoStatuses(ctx context.Context, statuses []models.SyncIQRpoStatusModel, filter *models.SyncIQRpoStatusFilterType) ([]models.SyncIQRpoStatusModel, error) {
+	if filter == nil {
+		return statuses, nil
+	}
+	names, err := inventoryFilterValues[string](ctx, filter.Names)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := []models.SyncIQRpoStatusModel{}
+	for _, status := range statuses {
+		if matchInventoryFilter(names, status.PolicyName.ValueString()) &&
+			(filter.Breached.IsNull() || filter.Breached.Equal(status.Breached)) {
+			filtered = append(filtered, status)
+		}
+	}
+	return filtered, nil
+}
@@ -0,0 +1,116 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	powerscale "dell/powerscale-go-client"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func syncIQRpoPolicy(id string, enabled bool, rpoAlert int32, lastSuccess int32) powerscale.V14SyncPolicyExtended {
+	policy := powerscale.V14SyncPolicyExtended{}
+	policy.SetId(id)
+	policy.SetName("policy-" + id)
+	policy.SetEnabled(enabled)
+	policy.SetRpoAlert(rpoAlert)
+	policy.SetLastSuccess(lastSuccess)
+	return policy
+}
+
+func syncIQRpoReport(policyID string, state string, endTime int32, errors ...string) powerscale.V15SyncReport {
+	report := powerscale.V15SyncReport{}
+	report.SetPolicyId(policyID)
+	report.SetState(state)
+	report.SetEndTime(endTime)
+	report.SetErrors(errors)
+	return report
+}
+
+func Test_SyncIQRpoStatusMapper(t *testing.T) {
+	policy := syncIQRpoPolicy("p1", true, 3600, 1000)
+	reports := []powerscale.V15SyncReport{
+		syncIQRpoReport("p1", "finished", 5000),
+		syncIQRpoReport("p1", "failed", 9000, "target unreachable"),
+		syncIQRpoReport("p1", "needs_attention", 7000, "stale"),
+		syncIQRpoReport("p1", "finished", 3000),
+	}
+
+	// within the RPO
+	status := SyncIQRpoStatusMapper(policy, reports, 8000)
+	assert.Equal(t, "policy-p1", status.PolicyName.ValueString())
+	assert.Equal(t, int64(5000), status.LastSuccess.ValueInt64())
+	assert.Equal(t, int64(3000), status.Lag.ValueInt64())
+	assert.False(t, status.Breached.ValueBool())
+	assert.Equal(t, int64(2), status.ConsecutiveFailures.ValueInt64())
+	assert.Equal(t, "target unreachable", status.LastError.ValueString())
+	assert.Equal(t, "failed", status.LastJobState.ValueString())
+
+	// past the RPO
+	status = SyncIQRpoStatusMapper(policy, reports, 9000)
+	assert.True(t, status.Breached.ValueBool())
+
+	// the last success of the policy is used when no report succeeded
+	status = SyncIQRpoStatusMapper(policy, reports[1:3], 4000)
+	assert.Equal(t, int64(1000), status.LastSuccess.ValueInt64())
+	assert.True(t, status.Breached.ValueBool())
+
+	// a policy without RPO alert never breaches
+	policy.SetRpoAlert(0)
+	status = SyncIQRpoStatusMapper(policy, nil, 9000)
+	assert.False(t, status.Breached.ValueBool())
+	assert.True(t, status.LastJobState.IsNull())
+	assert.True(t, status.LastError.IsNull())
+	assert.Equal(t, int64(0), status.ConsecutiveFailures.ValueInt64())
+
+	// assessment reports neither succeed nor fail
+	policy.SetRpoAlert(3600)
+	assessment := syncIQRpoReport("p1", "finished", 8000)
+	assessment.SetAction("test")
+	failedAssessment := syncIQRpoReport("p1", "failed", 8500, "assessment failed")
+	failedAssessment.SetAction("test")
+	status = SyncIQRpoStatusMapper(policy, append([]powerscale.V15SyncReport{assessment, failedAssessment}, reports...), 9500)
+	assert.Equal(t, int64(5000), status.LastSuccess.ValueInt64())
+	assert.True(t, status.Breached.ValueBool())
+	assert.Equal(t, int64(2), status.ConsecutiveFailures.ValueInt64())
+	assert.Equal(t, "target unreachable", status.LastError.ValueString())
+}
+
+func Test_GetSyncIQRpoStatuses(t *testing.T) {
+	policies := []powerscale.V14SyncPolicyExtended{
+		syncIQRpoPolicy("p1", true, 60, 0),
+		syncIQRpoPolicy("p2", false, 60, 0),
+		syncIQRpoPolicy("p3", true, 60, 0),
+	}
+	reports := []powerscale.V15SyncReport{
+		syncIQRpoReport("p2", "finished", 100),
+		syncIQRpoReport("p1", "finished", 150),
+	}
+
+	statuses := GetSyncIQRpoStatuses(policies, reports, 200)
+	assert.Len(t, statuses, 3)
+	assert.Equal(t, int64(50), statuses[0].Lag.ValueInt64())
+	assert.False(t, statuses[0].Breached.ValueBool())
+	// disabled policies do not breach their RPO
+	assert.Equal(t, int64(100), statuses[1].Lag.ValueInt64())
+	assert.False(t, statuses[1].Breached.ValueBool())
+	// a policy which never succeeded breaches its RPO
+	assert.True(t, statuses[2].LastSuccess.IsNull())
+	assert.True(t, statuses[2].Breached.ValueBool())
+}
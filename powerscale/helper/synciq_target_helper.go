@@ -0,0 +1,153 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"net/http"
+	"slices"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+)
+
+// GetSyncIQTargetPolicies returns the full list of SyncIQ policies that target this cluster.
+func GetSyncIQTargetPolicies(ctx context.Context, client *client.Client) ([]powerscale.V1SyncTargetPolicy, error) {
+	resp, _, err := client.PscaleOpenAPIClient.SyncApi.ListSyncv1SyncTargetPolicies(ctx).Execute()
+	if err != nil {
+		return nil, err
+	}
+	policies := resp.Policies
+	for resp.Resume != nil {
+		resp, _, err = client.PscaleOpenAPIClient.SyncApi.ListSyncv1SyncTargetPolicies(ctx).Resume(*resp.Resume).Execute()
+		if err != nil {
+			return policies, err
+		}
+		policies = append(policies, resp.Policies...)
+	}
+	return policies, nil
+}
+
+// GetSyncIQTargetPolicy returns a SyncIQ target policy by name or ID.
+func GetSyncIQTargetPolicy(ctx context.Context, client *client.Client, policy string) (*powerscale.V1SyncTargetPolicy, *http.Response, error) {
+	resp, httpResp, err := client.PscaleOpenAPIClient.SyncApi.GetSyncv1SyncTargetPolicy(ctx, policy).Execute()
+	if err != nil {
+		return nil, httpResp, err
+	}
+	if len(resp.Policies) == 0 {
+		return nil, httpResp, fmt.Errorf("target policy %s not found", policy)
+	}
+	return &resp.Policies[0], httpResp, nil
+}
+
+// FilterSyncIQTargetPolicies returns the target policies matching the filter.
+func FilterSyncIQTargetPolicies(ctx context.Context, policies []powerscale.V1SyncTargetPolicy, filter *models.SyncIQTargetPolicyFilterType) ([]powerscale.V1SyncTargetPolicy, error) {
+	if filter == nil {
+		return policies, nil
+	}
+
+	var names []string
+	if !filter.Names.IsNull() && !filter.Names.IsUnknown() {
+		if diags := filter.Names.ElementsAs(ctx, &names, false); diags.HasError() {
+			return nil, fmt.Errorf("could not read the names filter")
+		}
+	}
+
+	var filtered []powerscale.V1SyncTargetPolicy
+	for _, policy := range policies {
+		if len(names) > 0 && !slices.Contains(names, policy.GetName()) && !slices.Contains(names, policy.GetId()) {
+			continue
+		}
+		if !filter.TargetPath.IsNull() && policy.GetTargetPath() != filter.TargetPath.ValueString() {
+			continue
+		}
+		if !filter.FailoverFailbackState.IsNull() && policy.GetFailoverFailbackState() != filter.FailoverFailbackState.ValueString() {
+			continue
+		}
+		filtered = append(filtered, policy)
+	}
+	return filtered, nil
+}
+
+// SyncIQTargetPolicyMapper maps a target policy to the tfsdk model.
+func SyncIQTargetPolicyMapper(ctx context.Context, policy powerscale.V1SyncTargetPolicy) (models.SyncIQTargetPolicyModel, error) {
+	model := models.SyncIQTargetPolicyModel{}
+	err := CopyFields(ctx, policy, &model)
+	return model, err
+}
+
+// BreakSyncIQTargetPolicy breaks the association between the target directory and the source cluster of a policy.
+func BreakSyncIQTargetPolicy(ctx context.Context, client *client.Client, policy string, force bool) error {
+	resp, err := client.PscaleOpenAPIClient.SyncApi.DeleteSyncv1SyncTargetPolicy(ctx, policy).Force(force).Execute()
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("target policy %s not found", policy)
+	}
+	return err
+}
+
+// GetSyncIQTargetReports gets a list of the reports of the jobs run on this cluster as a SyncIQ target.
+func GetSyncIQTargetReports(ctx context.Context, client *client.Client, state models.SyncIQTargetReportsDatasourceModel) ([]powerscale.V15SyncTargetReport, error) {
+	listParam := client.PscaleOpenAPIClient.SyncApi.GetSyncv15SyncTargetReports(ctx)
+	if state.Filter != nil {
+		if !state.Filter.Sort.IsNull() {
+			listParam = listParam.Sort(state.Filter.Sort.ValueString())
+		}
+		if !state.Filter.NewerThan.IsNull() {
+			listParam = listParam.NewerThan(state.Filter.NewerThan.ValueInt32())
+		}
+		if !state.Filter.PolicyName.IsNull() {
+			listParam = listParam.PolicyName(state.Filter.PolicyName.ValueString())
+		}
+		if !state.Filter.State.IsNull() {
+			listParam = listParam.State(state.Filter.State.ValueString())
+		}
+		if !state.Filter.Limit.IsNull() {
+			listParam = listParam.Limit(state.Filter.Limit.ValueInt32())
+		}
+		if !state.Filter.ReportsPerPolicy.IsNull() {
+			listParam = listParam.ReportsPerPolicy(state.Filter.ReportsPerPolicy.ValueInt32())
+		}
+		if !state.Filter.Dir.IsNull() {
+			listParam = listParam.Dir(state.Filter.Dir.ValueString())
+		}
+		if !state.Filter.Summary.IsNull() {
+			listParam = listParam.Summary(state.Filter.Summary.ValueBool())
+		}
+	}
+	resp, _, err := listParam.Execute()
+	if err != nil {
+		return nil, err
+	}
+	reports := resp.Reports
+	for resp.Resume != nil && (state.Filter == nil || state.Filter.Limit.IsNull()) {
+		resp, _, err = client.PscaleOpenAPIClient.SyncApi.GetSyncv15SyncTargetReports(ctx).Resume(*resp.Resume).Execute()
+		if err != nil {
+			return reports, err
+		}
+		reports = append(reports, resp.Reports...)
+	}
+	return reports, nil
+}
+
+// SyncIQTargetReportMapper maps a target report to the tfsdk model.
+func SyncIQTargetReportMapper(ctx context.Context, report powerscale.V15SyncTargetReport) (models.ReplicationReportsDetail, error) {
+	model := models.ReplicationReportsDetail{}
+	err := CopyFields(ctx, report, &model)
+	return model, err
+}
@@ -0,0 +1,519 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// MappingGroup describes a group of a user mapping identity.
+type MappingGroup struct {
+	Name string `json:"name"`
+	GID  string `json:"gid"`
+	SID  string `json:"sid"`
+}
+
+// MappingIdentity describes a user identity known to the user mapping evaluator, and the access token it maps to.
+type MappingIdentity struct {
+	Domain       string         `json:"domain"`
+	Name         string         `json:"name"`
+	UID          string         `json:"uid"`
+	SID          string         `json:"sid"`
+	PrimaryGroup MappingGroup   `json:"primary_group"`
+	Groups       []MappingGroup `json:"groups"`
+}
+
+// QualifiedName returns the [domain\]name form of the identity.
+func (identity MappingIdentity) QualifiedName() string {
+	if identity.Domain == "" {
+		return identity.Name
+	}
+	return identity.Domain + `\` + identity.Name
+}
+
+// MappingResult holds the access token a user maps to.
+type MappingResult struct {
+	User string
+	// Token is nil when the user is denied by a replace rule without source user
+	Token *MappingIdentity
+	// AppliedRules holds the zero-based indexes of the rules applied to the token, in order
+	AppliedRules []int
+}
+
+// EvaluateUserMappingRules evaluates the user mapping rules against the user, written as [domain\]name,
+// with the identities standing for the directory services of the zone.
+// The rules are processed in order like OneFS does, so that no rule needs to be applied to the cluster:
+//   - replace replaces the token with the source user, or denies the user when there is no source user
+//   - union joins the identities of the target and source users, whichever of them is mapped
+//   - insert copies the fields of the source user into the token, overwriting the existing ones
+//   - append copies the fields of the source user into the token, keeping the existing ones
+//   - trim removes the supplemental groups from the token
+//
+// The user and domain names of the rules may hold '*' and '?' wildcards, and a '*' in the source user
+// is replaced by the part of the name matched by the first '*' of the target user.
+// The default user of a rule is used when its source user is not found, and break stops the processing
+// once the rule is applied.
+func EvaluateUserMappingRules(rules []powerscale.V1MappingUsersRulesRule, identities []MappingIdentity, user string) (*MappingResult, error) {
+	domain, name := "", user
+	if before, after, found := strings.Cut(user, `\`); found {
+		domain, name = before, after
+	}
+	identity := findMappingIdentity(identities, domain, name)
+	if identity == nil {
+		return nil, fmt.Errorf("user %s is not one of the identities", user)
+	}
+	token := cloneMappingIdentity(*identity)
+	result := &MappingResult{User: user, Token: &token}
+
+	for index, rule := range rules {
+		applied := false
+		switch operator := rule.GetOperator(); operator {
+		case "replace":
+			matched, capture := matchMappingUser(rule.User1, token)
+			if !matched {
+				continue
+			}
+			if rule.User2 == nil || rule.User2.User == "" {
+				result.Token = nil
+				result.AppliedRules = append(result.AppliedRules, index)
+				return result, nil
+			}
+			if other := resolveMappingUser(rule, *rule.User2, capture, token, identities); other != nil {
+				token = cloneMappingIdentity(*other)
+				applied = true
+			}
+		case "union":
+			matched, capture := matchMappingUser(rule.User1, token)
+			otherUser := rule.User2
+			if !matched && rule.User2 != nil {
+				// joins work both ways
+				matched, capture = matchMappingUser(*rule.User2, token)
+				otherUser = &rule.User1
+			}
+			if !matched || otherUser == nil {
+				continue
+			}
+			// a user is not joined with itself
+			if other := resolveMappingUser(rule, *otherUser, capture, token, identities); other != nil &&
+				!strings.EqualFold(other.QualifiedName(), token.QualifiedName()) {
+				joinMappingIdentity(&token, *other)
+				applied = true
+			}
+		case "insert", "append":
+			matched, capture := matchMappingUser(rule.User1, token)
+			if !matched || rule.User2 == nil {
+				continue
+			}
+			if other := resolveMappingUser(rule, *rule.User2, capture, token, identities); other != nil {
+				copyMappingIdentity(&token, *other, rule.Options, operator == "insert")
+				applied = true
+			}
+		case "trim":
+			if matched, _ := matchMappingUser(rule.User1, token); matched {
+				token.Groups = nil
+				applied = true
+			}
+		default:
+			return nil, fmt.Errorf("rule %d has unsupported operator %s", index, operator)
+		}
+
+		if applied {
+			result.AppliedRules = append(result.AppliedRules, index)
+			if rule.Options != nil && rule.Options.GetBreak() {
+				break
+			}
+		}
+	}
+	result.Token = &token
+	return result, nil
+}
+
+// findMappingIdentity returns the identity with the name in the domain.
+// When the domain is empty, the identities without domain are preferred over the ones of any domain.
+func findMappingIdentity(identities []MappingIdentity, domain, name string) *MappingIdentity {
+	index := slices.IndexFunc(identities, func(identity MappingIdentity) bool {
+		return strings.EqualFold(identity.Name, name) && strings.EqualFold(identity.Domain, domain)
+	})
+	if index < 0 && domain == "" {
+		index = slices.IndexFunc(identities, func(identity MappingIdentity) bool {
+			return strings.EqualFold(identity.Name, name)
+		})
+	}
+	if index < 0 {
+		return nil
+	}
+	return &identities[index]
+}
+
+// cloneMappingIdentity returns a copy of the identity which does not share its groups.
+func cloneMappingIdentity(identity MappingIdentity) MappingIdentity {
+	identity.Groups = slices.Clone(identity.Groups)
+	return identity
+}
+
+// matchMappingUser matches the token against a rule user, and returns the part of the name matched by the first '*'.
+func matchMappingUser(user powerscale.V1MappingUsersRulesRuleUser2, token MappingIdentity) (bool, string) {
+	if user.Domain != nil && *user.Domain != "" {
+		if matched, _ := matchMappingPattern(*user.Domain, token.Domain); !matched {
+			return false, ""
+		}
+	}
+	matched, capture := matchMappingPattern(user.User, token.Name)
+	if matched && !strings.Contains(user.User, "*") {
+		capture = token.Name
+	}
+	return matched, capture
+}
+
+// matchMappingPattern matches the value against a pattern with '*' and '?' wildcards, ignoring case.
+// It returns the part of the value matched by the first '*' of the pattern.
+func matchMappingPattern(pattern, value string) (bool, string) {
+	return matchMappingRunes([]rune(pattern), []rune(value), true)
+}
+
+func matchMappingRunes(pattern, value []rune, first bool) (bool, string) {
+	if len(pattern) == 0 {
+		return len(value) == 0, ""
+	}
+	switch pattern[0] {
+	case '*':
+		for i := 0; i <= len(value); i++ {
+			if matched, capture := matchMappingRunes(pattern[1:], value[i:], false); matched {
+				if first {
+					return true, string(value[:i])
+				}
+				return true, capture
+			}
+		}
+		return false, ""
+	case '?':
+		if len(value) == 0 {
+			return false, ""
+		}
+	default:
+		if len(value) == 0 || !strings.EqualFold(string(pattern[0]), string(value[0])) {
+			return false, ""
+		}
+	}
+	return matchMappingRunes(pattern[1:], value[1:], first)
+}
+
+// resolveMappingUser returns the identity of the rule user, substituting its wildcards from the token,
+// or the identity of the default user of the rule when it is not found.
+func resolveMappingUser(rule powerscale.V1MappingUsersRulesRule, user powerscale.V1MappingUsersRulesRuleUser2, capture string,
+	token MappingIdentity, identities []MappingIdentity) *MappingIdentity {
+	domain := ""
+	if user.Domain != nil {
+		domain = strings.ReplaceAll(*user.Domain, "*", token.Domain)
+	}
+	if identity := findMappingIdentity(identities, domain, strings.Replace(user.User, "*", capture, 1)); identity != nil {
+		return identity
+	}
+	if rule.Options == nil || rule.Options.DefaultUser == nil {
+		return nil
+	}
+	domain = ""
+	if rule.Options.DefaultUser.Domain != nil {
+		domain = *rule.Options.DefaultUser.Domain
+	}
+	return findMappingIdentity(identities, domain, rule.Options.DefaultUser.User)
+}
+
+// joinMappingIdentity fills the missing fields of the token from the other identity,
+// and adds the groups of the other identity to the supplemental groups.
+func joinMappingIdentity(token *MappingIdentity, other MappingIdentity) {
+	if token.UID == "" {
+		token.UID = other.UID
+	}
+	if token.SID == "" {
+		token.SID = other.SID
+	}
+	if token.PrimaryGroup == (MappingGroup{}) {
+		token.PrimaryGroup = other.PrimaryGroup
+	} else if other.PrimaryGroup != (MappingGroup{}) && !sameMappingGroup(token.PrimaryGroup, other.PrimaryGroup) {
+		token.Groups = mergeMappingGroups(token.Groups, []MappingGroup{other.PrimaryGroup})
+	}
+	token.Groups = mergeMappingGroups(token.Groups, other.Groups)
+}
+
+// copyMappingIdentity copies the fields selected by the options from the other identity into the token,
+// all of them when no field is selected. Insert overwrites the fields of the token, append only fills the missing ones.
+func copyMappingIdentity(token *MappingIdentity, other MappingIdentity, options *powerscale.V1MappingUsersRulesRuleOptions, insert bool) {
+	copyUser, copyGroup, copyGroups := true, true, true
+	if options != nil && (options.User != nil || options.Group != nil || options.Groups != nil) {
+		copyUser, copyGroup, copyGroups = options.GetUser(), options.GetGroup(), options.GetGroups()
+	}
+	if copyUser {
+		if insert || token.UID == "" {
+			token.UID = other.UID
+		}
+		if insert || token.SID == "" {
+			token.SID = other.SID
+		}
+	}
+	if copyGroup && (insert || token.PrimaryGroup == (MappingGroup{})) {
+		token.PrimaryGroup = other.PrimaryGroup
+	}
+	if copyGroups {
+		if insert {
+			token.Groups = mergeMappingGroups(slices.Clone(other.Groups), token.Groups)
+		} else {
+			token.Groups = mergeMappingGroups(token.Groups, other.Groups)
+		}
+	}
+}
+
+// mergeMappingGroups appends the groups which are not in the list yet.
+func mergeMappingGroups(groups, others []MappingGroup) []MappingGroup {
+	for _, other := range others {
+		if !slices.ContainsFunc(groups, func(group MappingGroup) bool { return sameMappingGroup(group, other) }) {
+			groups = append(groups, other)
+		}
+	}
+	return groups
+}
+
+// sameMappingGroup reports whether both groups are the same, by SID, GID or name.
+func sameMappingGroup(group, other MappingGroup) bool {
+	switch {
+	case group.SID != "" && other.SID != "":
+		return strings.EqualFold(group.SID, other.SID)
+	case group.GID != "" && other.GID != "":
+		return group.GID == other.GID
+	}
+	return strings.EqualFold(group.Name, other.Name)
+}
+
+// GetUserMappingRulesInput builds the user mapping rules of a list of rule objects.
+func GetUserMappingRulesInput(ctx context.Context, rulesList types.List) ([]powerscale.V1MappingUsersRulesRule, error) {
+	var ruleObjects []models.V1MappingUsersRulesRule
+	if diags := rulesList.ElementsAs(ctx, &ruleObjects, false); diags.HasError() {
+		return nil, fmt.Errorf("could not read the user mapping rules")
+	}
+	rules := make([]powerscale.V1MappingUsersRulesRule, 0, len(ruleObjects))
+	for _, ruleObject := range ruleObjects {
+		rule, err := buildUserMappingRuleInput(ctx, ruleObject)
+		if err != nil {
+			return nil, err
+		}
+		if ruleObject.User2.IsNull() {
+			rule.User2 = nil
+		}
+		rules = append(rules, *rule)
+	}
+	return rules, nil
+}
+
+// GetMappingIdentities returns the mapping identities of the identity models.
+func GetMappingIdentities(identityModels []models.UserMappingIdentityModel) []MappingIdentity {
+	identities := make([]MappingIdentity, 0, len(identityModels))
+	for _, identityModel := range identityModels {
+		identity := MappingIdentity{
+			Domain: identityModel.Domain.ValueString(),
+			Name:   identityModel.Name.ValueString(),
+			UID:    identityModel.UID.ValueString(),
+			SID:    identityModel.SID.ValueString(),
+		}
+		if identityModel.PrimaryGroup != nil {
+			identity.PrimaryGroup = mappingGroup(*identityModel.PrimaryGroup)
+		}
+		for _, group := range identityModel.Groups {
+			identity.Groups = append(identity.Groups, mappingGroup(group))
+		}
+		identities = append(identities, identity)
+	}
+	return identities
+}
+
+func mappingGroup(group models.UserMappingGroupModel) MappingGroup {
+	return MappingGroup{Name: group.Name.ValueString(), GID: group.GID.ValueString(), SID: group.SID.ValueString()}
+}
+
+// DecodeUserMappingRules decodes the user mapping rules of a dynamic value, holding the same attributes as the rules of the user mapping rules resource.
+func DecodeUserMappingRules(value attr.Value) ([]powerscale.V1MappingUsersRulesRule, error) {
+	var ruleMaps []map[string]interface{}
+	if err := decodeDynamicValue(value, &ruleMaps); err != nil {
+		return nil, fmt.Errorf("invalid user mapping rules: %s", err.Error())
+	}
+	rules := make([]powerscale.V1MappingUsersRulesRule, 0, len(ruleMaps))
+	for index, ruleMap := range ruleMaps {
+		// the attributes are named after the users of the rule in the API
+		ruleMap["user1"], ruleMap["user2"] = ruleMap["target_user"], ruleMap["source_user"]
+		delete(ruleMap, "target_user")
+		delete(ruleMap, "source_user")
+		var rule powerscale.V1MappingUsersRulesRule
+		ruleJSON, err := json.Marshal(ruleMap)
+		if err == nil {
+			err = json.Unmarshal(ruleJSON, &rule)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid user mapping rule %d: %s", index, err.Error())
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// DecodeMappingIdentities decodes the mapping identities of a dynamic value, holding the same attributes as the identities of the evaluation data source.
+func DecodeMappingIdentities(value attr.Value) ([]MappingIdentity, error) {
+	var identities []MappingIdentity
+	if err := decodeDynamicValue(value, &identities); err != nil {
+		return nil, fmt.Errorf("invalid identities: %s", err.Error())
+	}
+	return identities, nil
+}
+
+// decodeDynamicValue decodes a Terraform value into the destination through its JSON form.
+func decodeDynamicValue(value attr.Value, destination interface{}) error {
+	native, err := dynamicValueToNative(value)
+	if err != nil {
+		return err
+	}
+	valueJSON, err := json.Marshal(native)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(valueJSON, destination)
+}
+
+// dynamicValueToNative converts a Terraform value into the maps, slices and scalars of its JSON form.
+func dynamicValueToNative(value attr.Value) (interface{}, error) {
+	if value == nil || value.IsNull() {
+		return nil, nil
+	}
+	if value.IsUnknown() {
+		return nil, fmt.Errorf("value is not known yet")
+	}
+	switch v := value.(type) {
+	case basetypes.DynamicValue:
+		return dynamicValueToNative(v.UnderlyingValue())
+	case basetypes.StringValue:
+		return v.ValueString(), nil
+	case basetypes.BoolValue:
+		return v.ValueBool(), nil
+	case basetypes.NumberValue:
+		return json.Number(v.ValueBigFloat().Text('f', -1)), nil
+	case basetypes.Int64Value:
+		return v.ValueInt64(), nil
+	case basetypes.Float64Value:
+		return v.ValueFloat64(), nil
+	case basetypes.ObjectValue:
+		return attrMapToNative(v.Attributes())
+	case basetypes.MapValue:
+		return attrMapToNative(v.Elements())
+	case basetypes.ListValue:
+		return attrSliceToNative(v.Elements())
+	case basetypes.SetValue:
+		return attrSliceToNative(v.Elements())
+	case basetypes.TupleValue:
+		return attrSliceToNative(v.Elements())
+	}
+	return nil, fmt.Errorf("unsupported value type %s", value.Type(context.Background()).String())
+}
+
+func attrMapToNative(elements map[string]attr.Value) (map[string]interface{}, error) {
+	native := make(map[string]interface{}, len(elements))
+	for key, element := range elements {
+		value, err := dynamicValueToNative(element)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", key, err.Error())
+		}
+		native[key] = value
+	}
+	return native, nil
+}
+
+func attrSliceToNative(elements []attr.Value) ([]interface{}, error) {
+	native := make([]interface{}, 0, len(elements))
+	for index, element := range elements {
+		value, err := dynamicValueToNative(element)
+		if err != nil {
+			return nil, fmt.Errorf("[%d]: %s", index, err.Error())
+		}
+		native = append(native, value)
+	}
+	return native, nil
+}
+
+// EvaluateUserMappings evaluates the user mapping rules against each of the users.
+func EvaluateUserMappings(rules []powerscale.V1MappingUsersRulesRule, identities []MappingIdentity, users []string) ([]models.UserMappingResultModel, error) {
+	results := make([]models.UserMappingResultModel, 0, len(users))
+	for _, user := range users {
+		result, err := EvaluateUserMappingRules(rules, identities, user)
+		if err != nil {
+			return nil, err
+		}
+		resultModel := models.UserMappingResultModel{
+			User:         types.StringValue(user),
+			Denied:       types.BoolValue(result.Token == nil),
+			AppliedRules: make([]types.Int64, 0, len(result.AppliedRules)),
+		}
+		for _, index := range result.AppliedRules {
+			resultModel.AppliedRules = append(resultModel.AppliedRules, types.Int64Value(int64(index)))
+		}
+		if result.Token != nil {
+			resultModel.Token = mappingIdentityModel(*result.Token)
+		}
+		results = append(results, resultModel)
+	}
+	return results, nil
+}
+
+func mappingIdentityModel(identity MappingIdentity) *models.UserMappingIdentityModel {
+	identityModel := &models.UserMappingIdentityModel{
+		Domain: mappingStringValue(identity.Domain),
+		Name:   types.StringValue(identity.Name),
+		UID:    mappingStringValue(identity.UID),
+		SID:    mappingStringValue(identity.SID),
+		Groups: make([]models.UserMappingGroupModel, 0, len(identity.Groups)),
+	}
+	if identity.PrimaryGroup != (MappingGroup{}) {
+		primaryGroup := mappingGroupModel(identity.PrimaryGroup)
+		identityModel.PrimaryGroup = &primaryGroup
+	}
+	for _, group := range identity.Groups {
+		identityModel.Groups = append(identityModel.Groups, mappingGroupModel(group))
+	}
+	return identityModel
+}
+
+func mappingGroupModel(group MappingGroup) models.UserMappingGroupModel {
+	return models.UserMappingGroupModel{
+		Name: mappingStringValue(group.Name),
+		GID:  mappingStringValue(group.GID),
+		SID:  mappingStringValue(group.SID),
+	}
+}
+
+// mappingStringValue returns null for the fields missing from a mapping identity.
+func mappingStringValue(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
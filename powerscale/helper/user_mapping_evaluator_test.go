@@ -0,0 +1,178 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// userMappingExpectedCase is a candidate rule list with the token expected for a user once the rules are applied.
+// The expected tokens are written by hand in the format of the mapping users lookup API, following the OneFS
+// documentation of the rule operators, they are not captured from a cluster.
+type userMappingExpectedCase struct {
+	Name         string                                       `json:"name"`
+	Rules        []powerscale.V1MappingUsersRulesRule         `json:"rules"`
+	User         string                                       `json:"user"`
+	AppliedRules []int                                        `json:"applied_rules"`
+	Denied       bool                                         `json:"denied"`
+	Mapping      []powerscale.V1MappingUsersLookupMappingItem `json:"mapping"`
+}
+
+func Test_EvaluateUserMappingRulesExpectedTokens(t *testing.T) {
+	fixture, err := os.ReadFile("testdata/user_mapping_expected_tokens.json")
+	assert.Nil(t, err)
+	var expectations struct {
+		Identities []MappingIdentity         `json:"identities"`
+		Cases      []userMappingExpectedCase `json:"cases"`
+	}
+	assert.Nil(t, json.Unmarshal(fixture, &expectations))
+
+	for _, tt := range expectations.Cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			result, err := EvaluateUserMappingRules(tt.Rules, expectations.Identities, tt.User)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.AppliedRules, append([]int{}, result.AppliedRules...))
+			if tt.Denied {
+				assert.Nil(t, result.Token)
+				return
+			}
+
+			expected := tt.Mapping[0]
+			token := result.Token
+			assert.True(t, strings.EqualFold(expected.User.Name, token.QualifiedName()), "name %s != %s", expected.User.Name, token.QualifiedName())
+			assert.Equal(t, expected.User.Sid.GetId(), token.SID)
+			assert.Equal(t, expected.User.Uid.GetId(), token.UID)
+			assert.Equal(t, expected.User.PrimaryGroupSid.GetId(), token.PrimaryGroup.SID)
+			assert.Equal(t, expected.User.PrimaryGroupSid.GetName(), token.PrimaryGroup.Name)
+			assert.Equal(t, len(expected.Groups), len(token.Groups))
+			for index, group := range expected.Groups {
+				assert.Equal(t, group.Name, token.Groups[index].Name)
+				assert.Equal(t, group.Sid.GetId(), token.Groups[index].SID)
+				if group.Gid != nil {
+					assert.Equal(t, group.Gid.GetId(), token.Groups[index].GID)
+				}
+			}
+		})
+	}
+
+	_, err = EvaluateUserMappingRules(nil, expectations.Identities, `AD\unknown`)
+	assert.ErrorContains(t, err, "is not one of the identities")
+}
+
+// userMappingRecordedCase is a candidate rule list with the token the mapping users lookup API returned for a user once the rules were applied,
+// recorded from a cluster by TestAccUserMappingRulesRecordLookups. Token is nil when the lookup denied the user.
+type userMappingRecordedCase struct {
+	Name  string                               `json:"name"`
+	Rules []powerscale.V1MappingUsersRulesRule `json:"rules"`
+	User  string                               `json:"user"`
+	Token *MappingIdentity                     `json:"token"`
+}
+
+func Test_EvaluateUserMappingRulesRecordedLookups(t *testing.T) {
+	fixture, err := os.ReadFile("testdata/user_mapping_recorded_lookups.json")
+	if os.IsNotExist(err) {
+		t.Skip("no lookups recorded yet, run TestAccUserMappingRulesRecordLookups against a cluster to record them")
+	}
+	assert.Nil(t, err)
+	var recorded struct {
+		Identities []MappingIdentity         `json:"identities"`
+		Cases      []userMappingRecordedCase `json:"cases"`
+	}
+	assert.Nil(t, json.Unmarshal(fixture, &recorded))
+
+	for _, tt := range recorded.Cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			result, err := EvaluateUserMappingRules(tt.Rules, recorded.Identities, tt.User)
+			assert.Nil(t, err)
+			if tt.Token == nil {
+				assert.Nil(t, result.Token)
+				return
+			}
+
+			token := result.Token
+			assert.NotNil(t, token)
+			assert.True(t, strings.EqualFold(tt.Token.QualifiedName(), token.QualifiedName()), "name %s != %s", tt.Token.QualifiedName(), token.QualifiedName())
+			assert.Equal(t, tt.Token.SID, token.SID)
+			assert.Equal(t, tt.Token.UID, token.UID)
+			assert.Equal(t, tt.Token.PrimaryGroup.SID, token.PrimaryGroup.SID)
+			// the lookup does not return the groups in the order they were added
+			var expectedGroups, groups []string
+			for _, group := range tt.Token.Groups {
+				expectedGroups = append(expectedGroups, group.SID)
+			}
+			for _, group := range token.Groups {
+				groups = append(groups, group.SID)
+			}
+			assert.ElementsMatch(t, expectedGroups, groups)
+		})
+	}
+}
+
+func Test_MatchMappingPattern(t *testing.T) {
+	tests := []struct {
+		pattern, value string
+		matched        bool
+		capture        string
+	}{
+		{"*", "Alice", true, "Alice"},
+		{"svc_*", "SVC_backup", true, "backup"},
+		{"a?ice", "ALICE", true, ""},
+		{"*_*", "a_b_c", true, "a"},
+		{"bob", "alice", false, ""},
+		{"a?", "a", false, ""},
+	}
+	for _, tt := range tests {
+		matched, capture := matchMappingPattern(tt.pattern, tt.value)
+		assert.Equal(t, tt.matched, matched, tt.pattern)
+		assert.Equal(t, tt.capture, capture, tt.pattern)
+	}
+}
+
+func Test_DecodeUserMappingRules(t *testing.T) {
+	userType := map[string]attr.Type{"domain": types.StringType, "user": types.StringType}
+	targetUser := types.ObjectValueMust(userType, map[string]attr.Value{"domain": types.StringValue("AD"), "user": types.StringValue("*")})
+	rule := types.ObjectValueMust(
+		map[string]attr.Type{
+			"operator":    types.StringType,
+			"target_user": types.ObjectType{AttrTypes: userType},
+			"source_user": types.ObjectType{AttrTypes: userType},
+		},
+		map[string]attr.Value{
+			"operator":    types.StringValue("trim"),
+			"target_user": targetUser,
+			"source_user": types.ObjectNull(userType),
+		},
+	)
+	rules, err := DecodeUserMappingRules(types.DynamicValue(types.TupleValueMust([]attr.Type{rule.Type(context.Background())}, []attr.Value{rule})))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(rules))
+	assert.Equal(t, "trim:AD\\*", UserMappingRuleKey(rules[0]))
+	assert.Nil(t, rules[0].User2)
+
+	_, err = DecodeMappingIdentities(types.DynamicValue(types.StringValue("invalid")))
+	assert.NotNil(t, err)
+}
@@ -21,7 +21,10 @@ import (
 	"context"
 	powerscale "dell/powerscale-go-client"
 	"fmt"
+	"reflect"
+	"slices"
 	"strings"
+	"sync"
 	"terraform-provider-powerscale/client"
 	"terraform-provider-powerscale/powerscale/constants"
 	"terraform-provider-powerscale/powerscale/models"
@@ -29,6 +32,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // UpdateUserMappingRulesDatasourceState updates datasource state.
@@ -500,3 +504,242 @@ func buildUserMappingRuleInput(ctx context.Context, rule models.V1MappingUsersRu
 
 	return ruleBody, nil
 }
+
+// Since the rules of a zone are written back as a whole, concurrent updates
+// of the individual rules would overwrite each other, so we need to lock the mutex here.
+var userMappingRules sync.Mutex
+
+// userMappingRuleOperators lists the operators of the user mapping rules.
+var userMappingRuleOperators = []string{"append", "insert", "replace", "trim", "union"}
+
+// userMappingRulesUpdateAttempts is the number of times the rules are read and written back
+// before giving up when they keep being changed outside of the provider.
+const userMappingRulesUpdateAttempts = 5
+
+// UserMappingRuleKey returns the identifier of a user mapping rule within its zone,
+// of the form operator:target_user[:source_user], where the users are written as [domain\]user.
+func UserMappingRuleKey(rule powerscale.V1MappingUsersRulesRule) string {
+	key := rule.GetOperator() + ":" + userMappingRuleUserKey(rule.User1)
+	if rule.User2 != nil && rule.User2.User != "" {
+		key += ":" + userMappingRuleUserKey(*rule.User2)
+	}
+	return key
+}
+
+// userMappingRuleUserKey returns the [domain\]user form of a user mapping rule user.
+func userMappingRuleUserKey(user powerscale.V1MappingUsersRulesRuleUser2) string {
+	if user.Domain != nil && *user.Domain != "" {
+		return *user.Domain + `\` + user.User
+	}
+	return user.User
+}
+
+// ParseUserMappingRuleID parses the [zone:]operator:target_user[:source_user] identifier of a user mapping rule.
+func ParseUserMappingRuleID(id string) (zone string, key string, err error) {
+	parts := strings.Split(id, ":")
+	if !slices.Contains(userMappingRuleOperators, parts[0]) {
+		zone, parts = parts[0], parts[1:]
+	}
+	if len(parts) < 2 || len(parts) > 3 || !slices.Contains(userMappingRuleOperators, parts[0]) || slices.Contains(parts, "") {
+		return "", "", fmt.Errorf("invalid identifier %s, expected [zone:]operator:target_user[:source_user]", id)
+	}
+	return zone, strings.Join(parts, ":"), nil
+}
+
+// FindUserMappingRule returns the index of the rule with the given key, or -1 if there is none.
+func FindUserMappingRule(rules []powerscale.V1MappingUsersRulesRule, key string) int {
+	return slices.IndexFunc(rules, func(rule powerscale.V1MappingUsersRulesRule) bool {
+		return strings.EqualFold(UserMappingRuleKey(rule), key)
+	})
+}
+
+// ModifyUserMappingRules applies the modify function to the user mapping rules of the zone and writes them back.
+// The rules are read again right before writing, and the update starts over when they were changed in the meantime.
+func ModifyUserMappingRules(ctx context.Context, client *client.Client, zone string,
+	modify func([]powerscale.V1MappingUsersRulesRule) ([]powerscale.V1MappingUsersRulesRule, error)) error {
+	userMappingRules.Lock()
+	defer userMappingRules.Unlock()
+
+	for attempt := 0; attempt < userMappingRulesUpdateAttempts; attempt++ {
+		current, err := GetUserMappingRulesByZone(ctx, client, zone)
+		if err != nil {
+			return err
+		}
+		rules, err := modify(slices.Clone(current.Rules))
+		if err != nil {
+			return err
+		}
+		if reflect.DeepEqual(rules, current.Rules) {
+			return nil
+		}
+
+		latest, err := GetUserMappingRulesByZone(ctx, client, zone)
+		if err != nil {
+			return err
+		}
+		if !reflect.DeepEqual(latest.Rules, current.Rules) {
+			tflog.Debug(ctx, fmt.Sprintf("user mapping rules of zone %s changed during the update, retrying", zone))
+			continue
+		}
+
+		updateParam := client.PscaleOpenAPIClient.AuthApi.UpdateAuthv1MappingUsersRules(ctx)
+		if zone != "" {
+			updateParam = updateParam.Zone(zone)
+		}
+		body := powerscale.V1MappingUsersRulesRules{Parameters: latest.Parameters, Rules: rules}
+		_, err = updateParam.V1MappingUsersRules(body).Execute()
+		return err
+	}
+	return fmt.Errorf("the user mapping rules of zone %s were changed by another client during each of the %d update attempts",
+		DefaultIfEmpty(zone, "System"), userMappingRulesUpdateAttempts)
+}
+
+// buildUserMappingRule builds the user mapping rule of the resource plan.
+func buildUserMappingRule(ctx context.Context, plan models.UserMappingRuleResourceModel) (*powerscale.V1MappingUsersRulesRule, error) {
+	rule, err := buildUserMappingRuleInput(ctx, models.V1MappingUsersRulesRule{
+		Operator: plan.Operator,
+		Options:  plan.Options,
+		User1:    plan.User1,
+		User2:    plan.User2,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if plan.User2.IsNull() {
+		rule.User2 = nil
+	}
+	return rule, nil
+}
+
+// userMappingRuleAnchor returns the key of the rule the plan is anchored to, and whether the rule goes after it.
+func userMappingRuleAnchor(plan models.UserMappingRuleResourceModel) (string, bool, error) {
+	anchor, after := plan.Before.ValueString(), false
+	if !plan.After.IsNull() {
+		anchor, after = plan.After.ValueString(), true
+	}
+	if anchor == "" {
+		return "", false, nil
+	}
+	zone, key, err := ParseUserMappingRuleID(anchor)
+	if err != nil {
+		return "", false, err
+	}
+	if zone != "" && !strings.EqualFold(zone, DefaultIfEmpty(plan.Zone.ValueString(), "System")) {
+		return "", false, fmt.Errorf("the anchor rule %s is not in zone %s", anchor, DefaultIfEmpty(plan.Zone.ValueString(), "System"))
+	}
+	return key, after, nil
+}
+
+// placeUserMappingRule inserts the rule at the position or next to the anchor rule declared by the plan.
+// The rule is appended when the plan declares no placement.
+func placeUserMappingRule(rules []powerscale.V1MappingUsersRulesRule, rule powerscale.V1MappingUsersRulesRule,
+	plan models.UserMappingRuleResourceModel) ([]powerscale.V1MappingUsersRulesRule, error) {
+	index := len(rules)
+	if !plan.Position.IsNull() {
+		index = min(int(plan.Position.ValueInt64()), len(rules))
+	}
+	anchor, after, err := userMappingRuleAnchor(plan)
+	if err != nil {
+		return nil, err
+	}
+	if anchor != "" {
+		if strings.EqualFold(anchor, UserMappingRuleKey(rule)) {
+			return nil, fmt.Errorf("user mapping rule %s cannot be placed relative to itself", anchor)
+		}
+		if index = FindUserMappingRule(rules, anchor); index < 0 {
+			return nil, fmt.Errorf("the anchor rule %s was not found", anchor)
+		}
+		if after {
+			index++
+		}
+	}
+	return slices.Insert(rules, index, rule), nil
+}
+
+// CreateUserMappingRule adds the rule of the plan to the user mapping rules of its zone, and returns the rule key.
+func CreateUserMappingRule(ctx context.Context, client *client.Client, plan models.UserMappingRuleResourceModel) (string, error) {
+	rule, err := buildUserMappingRule(ctx, plan)
+	if err != nil {
+		return "", err
+	}
+	key := UserMappingRuleKey(*rule)
+	return key, ModifyUserMappingRules(ctx, client, plan.Zone.ValueString(), func(rules []powerscale.V1MappingUsersRulesRule) ([]powerscale.V1MappingUsersRulesRule, error) {
+		if FindUserMappingRule(rules, key) >= 0 {
+			return nil, fmt.Errorf("user mapping rule %s already exists, import it to manage it", key)
+		}
+		return placeUserMappingRule(rules, *rule, plan)
+	})
+}
+
+// UpdateUserMappingRule updates the options of the rule, and moves it when its declared placement changed.
+func UpdateUserMappingRule(ctx context.Context, client *client.Client, state, plan models.UserMappingRuleResourceModel) error {
+	_, key, err := ParseUserMappingRuleID(state.ID.ValueString())
+	if err != nil {
+		return err
+	}
+	rule, err := buildUserMappingRule(ctx, plan)
+	if err != nil {
+		return err
+	}
+	placementChanged := !plan.Position.Equal(state.Position) || !plan.Before.Equal(state.Before) || !plan.After.Equal(state.After)
+	hasPlacement := !plan.Position.IsNull() || !plan.Before.IsNull() || !plan.After.IsNull()
+	return ModifyUserMappingRules(ctx, client, plan.Zone.ValueString(), func(rules []powerscale.V1MappingUsersRulesRule) ([]powerscale.V1MappingUsersRulesRule, error) {
+		index := FindUserMappingRule(rules, key)
+		if index < 0 {
+			return nil, fmt.Errorf("user mapping rule %s was not found", key)
+		}
+		if !placementChanged || !hasPlacement {
+			rules[index] = *rule
+			return rules, nil
+		}
+		return placeUserMappingRule(slices.Delete(rules, index, index+1), *rule, plan)
+	})
+}
+
+// DeleteUserMappingRule removes the rule of the state from the user mapping rules of its zone, keeping the other rules.
+func DeleteUserMappingRule(ctx context.Context, client *client.Client, state models.UserMappingRuleResourceModel) error {
+	_, key, err := ParseUserMappingRuleID(state.ID.ValueString())
+	if err != nil {
+		return err
+	}
+	return ModifyUserMappingRules(ctx, client, state.Zone.ValueString(), func(rules []powerscale.V1MappingUsersRulesRule) ([]powerscale.V1MappingUsersRulesRule, error) {
+		if index := FindUserMappingRule(rules, key); index >= 0 {
+			return slices.Delete(rules, index, index+1), nil
+		}
+		return rules, nil
+	})
+}
+
+// UpdateUserMappingRuleState updates the resource state from the rule found at the index of the rules of the zone.
+// The placement attributes are only changed when the rule is no longer at its declared place, so that it is moved back.
+func UpdateUserMappingRuleState(ctx context.Context, state *models.UserMappingRuleResourceModel, rules []powerscale.V1MappingUsersRulesRule, index int) (diags diag.Diagnostics) {
+	var rulesState models.UserMappingRulesResourceModel
+	if diags = UpdateUserMappingRulesState(ctx, &rulesState, &powerscale.V1MappingUsersRulesRules{Rules: rules[index : index+1]}); diags.HasError() {
+		return
+	}
+	var ruleState []models.V1MappingUsersRulesRule
+	if diags = rulesState.Rules.ElementsAs(ctx, &ruleState, false); diags.HasError() {
+		return
+	}
+	state.Operator = ruleState[0].Operator
+	state.Options = ruleState[0].Options
+	state.User1 = ruleState[0].User1
+	state.User2 = ruleState[0].User2
+	state.ID = types.StringValue(DefaultIfEmpty(state.Zone.ValueString(), "System") + ":" + UserMappingRuleKey(rules[index]))
+
+	if !state.Position.IsNull() {
+		// a position past the end of the rules places the rule last
+		position := int(state.Position.ValueInt64())
+		if position != index && (position < len(rules) || index != len(rules)-1) {
+			state.Position = types.Int64Value(int64(index))
+		}
+	}
+	if anchor, after, err := userMappingRuleAnchor(*state); err == nil && anchor != "" {
+		anchorIndex := FindUserMappingRule(rules, anchor)
+		if anchorIndex >= 0 && (after && index < anchorIndex || !after && index > anchorIndex) {
+			state.Before = types.StringNull()
+			state.After = types.StringNull()
+		}
+	}
+	return
+}
@@ -0,0 +1,143 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"terraform-provider-powerscale/powerscale/models"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func testUserMappingRule(operator, target, source string) powerscale.V1MappingUsersRulesRule {
+	rule := powerscale.V1MappingUsersRulesRule{
+		Operator: powerscale.PtrString(operator),
+		User1:    powerscale.V1MappingUsersRulesRuleUser2{User: target},
+	}
+	if source != "" {
+		rule.User2 = &powerscale.V1MappingUsersRulesRuleUser2{User: source}
+	}
+	return rule
+}
+
+func testUserMappingRulePlan(position *int64, before, after string) models.UserMappingRuleResourceModel {
+	plan := models.UserMappingRuleResourceModel{
+		Zone:     types.StringNull(),
+		Position: types.Int64PointerValue(position),
+		Before:   types.StringNull(),
+		After:    types.StringNull(),
+	}
+	if before != "" {
+		plan.Before = types.StringValue(before)
+	}
+	if after != "" {
+		plan.After = types.StringValue(after)
+	}
+	return plan
+}
+
+func testUserMappingRuleKeys(rules []powerscale.V1MappingUsersRulesRule) []string {
+	keys := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		keys = append(keys, UserMappingRuleKey(rule))
+	}
+	return keys
+}
+
+func Test_ParseUserMappingRuleID(t *testing.T) {
+	zone, key, err := ParseUserMappingRuleID(`System:append:domain\user:admin`)
+	assert.Nil(t, err)
+	assert.Equal(t, "System", zone)
+	assert.Equal(t, `append:domain\user:admin`, key)
+
+	zone, key, err = ParseUserMappingRuleID("trim:user")
+	assert.Nil(t, err)
+	assert.Equal(t, "", zone)
+	assert.Equal(t, "trim:user", key)
+
+	for _, id := range []string{"System:user", "System:join:user:admin", "System:union::admin", "System:union:a:b:c"} {
+		_, _, err = ParseUserMappingRuleID(id)
+		assert.NotNil(t, err, id)
+	}
+}
+
+func Test_PlaceUserMappingRule(t *testing.T) {
+	rules := []powerscale.V1MappingUsersRulesRule{
+		testUserMappingRule("append", "a", "b"),
+		testUserMappingRule("trim", "c", ""),
+	}
+	rule := testUserMappingRule("union", "d", "e")
+	position := int64(1)
+	beyond := int64(10)
+
+	tests := []struct {
+		name string
+		plan models.UserMappingRuleResourceModel
+		want []string
+	}{
+		{"append", testUserMappingRulePlan(nil, "", ""), []string{"append:a:b", "trim:c", "union:d:e"}},
+		{"position", testUserMappingRulePlan(&position, "", ""), []string{"append:a:b", "union:d:e", "trim:c"}},
+		{"position past the end", testUserMappingRulePlan(&beyond, "", ""), []string{"append:a:b", "trim:c", "union:d:e"}},
+		{"before", testUserMappingRulePlan(nil, "System:append:a:b", ""), []string{"union:d:e", "append:a:b", "trim:c"}},
+		{"after", testUserMappingRulePlan(nil, "", "append:a:b"), []string{"append:a:b", "union:d:e", "trim:c"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			placed, err := placeUserMappingRule(append([]powerscale.V1MappingUsersRulesRule{}, rules...), rule, tt.plan)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, testUserMappingRuleKeys(placed))
+		})
+	}
+
+	_, err := placeUserMappingRule(rules, rule, testUserMappingRulePlan(nil, "append:x:y", ""))
+	assert.ErrorContains(t, err, "was not found")
+	_, err = placeUserMappingRule(rules, rule, testUserMappingRulePlan(nil, "other:append:a:b", ""))
+	assert.ErrorContains(t, err, "is not in zone")
+	_, err = placeUserMappingRule(rules, rule, testUserMappingRulePlan(nil, "union:d:e", ""))
+	assert.ErrorContains(t, err, "relative to itself")
+}
+
+func Test_UpdateUserMappingRuleState(t *testing.T) {
+	rules := []powerscale.V1MappingUsersRulesRule{
+		testUserMappingRule("append", "a", "b"),
+		testUserMappingRule("trim", "c", ""),
+	}
+	position := int64(0)
+	beyond := int64(10)
+
+	state := testUserMappingRulePlan(&position, "", "")
+	assert.False(t, UpdateUserMappingRuleState(context.Background(), &state, rules, 1).HasError())
+	assert.Equal(t, "System:trim:c", state.ID.ValueString())
+	assert.Equal(t, int64(1), state.Position.ValueInt64())
+	assert.True(t, state.User2.IsNull())
+
+	state = testUserMappingRulePlan(&beyond, "", "")
+	assert.False(t, UpdateUserMappingRuleState(context.Background(), &state, rules, 1).HasError())
+	assert.Equal(t, int64(10), state.Position.ValueInt64())
+
+	state = testUserMappingRulePlan(nil, "", "append:a:b")
+	assert.False(t, UpdateUserMappingRuleState(context.Background(), &state, rules, 1).HasError())
+	assert.Equal(t, "append:a:b", state.After.ValueString())
+
+	state = testUserMappingRulePlan(nil, "append:a:b", "")
+	assert.False(t, UpdateUserMappingRuleState(context.Background(), &state, rules, 1).HasError())
+	assert.True(t, state.Before.IsNull())
+}
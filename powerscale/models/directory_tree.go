@@ -0,0 +1,47 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// DirectoryTreeResourceModel describes the directory tree resource data model.
+type DirectoryTreeResourceModel struct {
+	ID          types.String              `tfsdk:"id"`
+	RootPath    types.String              `tfsdk:"root_path"`
+	DeleteMode  types.String              `tfsdk:"delete_mode"`
+	Directories []DirectoryTreeEntryModel `tfsdk:"directories"`
+}
+
+// DirectoryTreeEntryModel describes a directory of the directory tree.
+type DirectoryTreeEntryModel struct {
+	Path          types.String             `tfsdk:"path"`
+	FullPath      types.String             `tfsdk:"full_path"`
+	Owner         types.String             `tfsdk:"owner"`
+	Group         types.String             `tfsdk:"group"`
+	AccessControl types.String             `tfsdk:"access_control"`
+	Quota         *DirectoryTreeQuotaModel `tfsdk:"quota"`
+}
+
+// DirectoryTreeQuotaModel describes the directory quota of a directory of the directory tree.
+type DirectoryTreeQuotaModel struct {
+	ID        types.String `tfsdk:"id"`
+	Hard      types.Int64  `tfsdk:"hard"`
+	Soft      types.Int64  `tfsdk:"soft"`
+	SoftGrace types.Int64  `tfsdk:"soft_grace"`
+	Advisory  types.Int64  `tfsdk:"advisory"`
+}
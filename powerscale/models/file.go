@@ -0,0 +1,49 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// FileResourceModel describes the resource data model of a file in the namespace.
+type FileResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	FullPath      types.String `tfsdk:"full_path"`
+	Name          types.String `tfsdk:"name"`
+	DirectoryPath types.String `tfsdk:"directory_path"`
+	// The content of the file.
+	Content types.String `tfsdk:"content"`
+	// The path of a local file whose content is written to the file.
+	Source types.String `tfsdk:"source"`
+	// The SHA-256 hash of the content of the file.
+	ContentSha256 types.String `tfsdk:"content_sha256"`
+	Owner         MemberObject `tfsdk:"owner"`
+	Group         MemberObject `tfsdk:"group"`
+	Type          types.String `tfsdk:"type"`
+	CreationTime  types.String `tfsdk:"creation_time"`
+	// If the user wants to filter on a partcular access zone they can set that here, otherwise will just default to the default access zone.
+	QueryZone types.String `tfsdk:"query_zone"`
+	// The ACL value for the file, either access rights such as 'private_read' or permissions in POSIX format such as '0600'.
+	AccessControl types.String `tfsdk:"access_control"`
+	// If the file has access rights set, then this field should be acl. Otherwise this field should be mode.
+	Authoritative types.String `tfsdk:"authoritative"`
+	Mode          types.String `tfsdk:"mode"`
+	// Replaces an existing file with the same name if set to true.
+	Overwrite types.Bool `tfsdk:"overwrite"`
+	// The user-defined metadata attributes managed on the file.
+	UserMetadata types.Map `tfsdk:"user_metadata"`
+}
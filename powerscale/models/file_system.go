@@ -21,9 +21,10 @@ import "github.com/hashicorp/terraform-plugin-framework/types"
 
 // FileSystemDataSourceModel describes the data source data model.
 type FileSystemDataSourceModel struct {
-	ID            types.String           `tfsdk:"id"`
-	FileSystem    *FileSystemDetailModel `tfsdk:"file_systems_details"`
-	DirectoryPath types.String           `tfsdk:"directory_path"`
+	ID                     types.String           `tfsdk:"id"`
+	FileSystem             *FileSystemDetailModel `tfsdk:"file_systems_details"`
+	DirectoryPath          types.String           `tfsdk:"directory_path"`
+	IncludeParentSnapshots types.Bool             `tfsdk:"include_parent_snapshots"`
 }
 
 // FileSystemDetailModel details of the Filesystem.
@@ -183,4 +184,8 @@ type FileSystemResource struct {
 	Recursive types.Bool `tfsdk:"recursive"`
 	// Deletes and replaces the existing user attributes and ACLs of the directory with user-specified attributes if set to true.
 	Overwrite types.Bool `tfsdk:"overwrite"`
+	// How the directory is deleted: fail_if_not_empty, recursive or tree_delete_job.
+	DeleteMode types.String `tfsdk:"delete_mode"`
+	// The user-defined metadata attributes managed on the directory.
+	UserMetadata types.Map `tfsdk:"user_metadata"`
 }
@@ -0,0 +1,83 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// FtpSettingsModel Specifies the FTP service settings.
+type FtpSettingsModel struct {
+	ID types.String `tfsdk:"id"`
+	// Controls the time in seconds to wait for a remote client to establish a PASV style data connection.
+	AcceptTimeout types.Int64 `tfsdk:"accept_timeout"`
+	// Controls whether anonymous logins are permitted or not.
+	AllowAnonAccess types.Bool `tfsdk:"allow_anon_access"`
+	// Controls whether anonymous users will be allowed to upload files.
+	AllowAnonUpload types.Bool `tfsdk:"allow_anon_upload"`
+	// If set to false, all directory list commands will return a permission denied error.
+	AllowDirlists types.Bool `tfsdk:"allow_dirlists"`
+	// If set to false, all downloads requests will return a permission denied error.
+	AllowDownloads types.Bool `tfsdk:"allow_downloads"`
+	// Controls whether local logins are permitted or not.
+	AllowLocalAccess types.Bool `tfsdk:"allow_local_access"`
+	// This controls whether any FTP commands which change the filesystem are allowed or not.
+	AllowWrites types.Bool `tfsdk:"allow_writes"`
+	// This controls whether FTP will always initially change directories to the home directory of the user.
+	AlwaysChdirHomedir types.Bool `tfsdk:"always_chdir_homedir"`
+	// This is the name of the user who is given ownership of anonymously uploaded files.
+	AnonChownUsername types.String `tfsdk:"anon_chown_username"`
+	// A list of passwords for anonymous users.
+	AnonPasswordList types.List `tfsdk:"anon_password_list"`
+	// This option represents a directory in /ifs which vsftpd will try to change into after an anonymous login.
+	AnonRootPath types.String `tfsdk:"anon_root_path"`
+	// The value that the umask for file creation is set to for anonymous users.
+	AnonUmask types.Int64 `tfsdk:"anon_umask"`
+	// Controls whether ascii mode data transfers are enabled.
+	ASCIIMode types.String `tfsdk:"ascii_mode"`
+	// A list of users that are not chrooted when logging in.
+	ChrootExceptionList types.List `tfsdk:"chroot_exception_list"`
+	// If set to 'all', all local users will be (by default) placed in a chroot() jail in their home directory after login.
+	ChrootLocalMode types.String `tfsdk:"chroot_local_mode"`
+	// The timeout, in seconds, for a remote client to respond to our PORT style data connection.
+	ConnectTimeout types.Int64 `tfsdk:"connect_timeout"`
+	// The timeout, in seconds, which is roughly the maximum time we permit data transfers to stall for with no progress.
+	DataTimeout types.Int64 `tfsdk:"data_timeout"`
+	// A list of users that will be denied access.
+	DeniedUserList types.List `tfsdk:"denied_user_list"`
+	// If enabled, display directory listings with the time in your local time zone.
+	DirlistLocaltime types.Bool `tfsdk:"dirlist_localtime"`
+	// When set to 'hide', all user and group information in directory listings will be displayed as 'ftp'.
+	DirlistNames types.String `tfsdk:"dirlist_names"`
+	// The permissions with which uploaded files are created.
+	FileCreatePerm types.Int64 `tfsdk:"file_create_perm"`
+	// This field determines whether the anon_password_list is used.
+	LimitAnonPasswords types.Bool `tfsdk:"limit_anon_passwords"`
+	// This option represents a directory in /ifs which vsftpd will try to change into after a local login.
+	LocalRootPath types.String `tfsdk:"local_root_path"`
+	// The value that the umask for file creation is set to for local users.
+	LocalUmask types.Int64 `tfsdk:"local_umask"`
+	// If enabled, allow server-to-server (FXP) transfers.
+	ServerToServer types.Bool `tfsdk:"server_to_server"`
+	// This field controls whether the FTP daemon is running.
+	Service types.Bool `tfsdk:"service"`
+	// If enabled, maintain login sessions for each user through Pluggable Authentication Modules (PAM).
+	SessionSupport types.Bool `tfsdk:"session_support"`
+	// The timeout, in seconds, for an idle session. If the idle time expires the client will be disconnected.
+	SessionTimeout types.Int64 `tfsdk:"session_timeout"`
+	// Specifies the directory where per-user config overrides can be found.
+	UserConfigDir types.String `tfsdk:"user_config_dir"`
+}
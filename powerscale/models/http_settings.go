@@ -0,0 +1,45 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// HTTPSettingsModel Specifies the HTTP service settings.
+type HTTPSettingsModel struct {
+	ID types.String `tfsdk:"id"`
+	// Enable Access Control Authentication.
+	AccessControl types.Bool `tfsdk:"access_control"`
+	// Enable Basic Authentication.
+	BasicAuthentication types.Bool `tfsdk:"basic_authentication"`
+	// Enable WebDAV.
+	Dav types.Bool `tfsdk:"dav"`
+	// Enable Apache Access Log.
+	EnableAccessLog types.Bool `tfsdk:"enable_access_log"`
+	// Enable HTTPS.
+	HTTPS types.Bool `tfsdk:"https"`
+	// Enable Integrated Authentication.
+	IntegratedAuthentication types.Bool `tfsdk:"integrated_authentication"`
+	// Document root directory. Must be within /ifs.
+	ServerRoot types.String `tfsdk:"server_root"`
+	// Enable/disable the HTTP Service or redirect to WebUI.
+	Service types.String `tfsdk:"service"`
+	// Timeout in seconds for HTTP requests.
+	ServiceTimeout types.Int64 `tfsdk:"service_timeout"`
+	// Minimum TLS version accepted by the HTTPS service.
+	TLSMinVersion types.String `tfsdk:"tls_min_version"`
+}
@@ -0,0 +1,57 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// NamespaceQueryDataSourceModel describes the namespace query data source data model.
+type NamespaceQueryDataSourceModel struct {
+	ID                  types.String                   `tfsdk:"id"`
+	Path                types.String                   `tfsdk:"path"`
+	Result              types.List                     `tfsdk:"result"`
+	Logic               types.String                   `tfsdk:"logic"`
+	Conditions          []NamespaceQueryConditionModel `tfsdk:"conditions"`
+	MaxDepth            types.Int64                    `tfsdk:"max_depth"`
+	Limit               types.Int64                    `tfsdk:"limit"`
+	IncludeUserMetadata types.Bool                     `tfsdk:"include_user_metadata"`
+	IncludeACL          types.Bool                     `tfsdk:"include_acl"`
+	Matches             []NamespaceQueryMatchModel     `tfsdk:"matches"`
+}
+
+// NamespaceQueryConditionModel describes a condition of the namespace query.
+type NamespaceQueryConditionModel struct {
+	Attribute types.String `tfsdk:"attribute"`
+	Operator  types.String `tfsdk:"operator"`
+	Value     types.String `tfsdk:"value"`
+	Namespace types.String `tfsdk:"namespace"`
+}
+
+// NamespaceQueryMatchModel describes a path matching the namespace query.
+type NamespaceQueryMatchModel struct {
+	Path         types.String   `tfsdk:"path"`
+	Name         types.String   `tfsdk:"name"`
+	Type         types.String   `tfsdk:"type"`
+	Size         types.Int64    `tfsdk:"size"`
+	Owner        types.String   `tfsdk:"owner"`
+	Group        types.String   `tfsdk:"group"`
+	Mode         types.String   `tfsdk:"mode"`
+	LastModified types.String   `tfsdk:"last_modified"`
+	Attributes   types.Map      `tfsdk:"attributes"`
+	UserMetadata types.Map      `tfsdk:"user_metadata"`
+	ACL          *FileSystemACL `tfsdk:"acl"`
+}
@@ -0,0 +1,119 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// NdmpSettingsModel Specifies the global NDMP configuration settings.
+type NdmpSettingsModel struct {
+	ID types.String `tfsdk:"id"`
+	// The maximum number of restartable backup contexts.
+	BreMaxNumContexts types.Int64 `tfsdk:"bre_max_num_contexts"`
+	// The name of the data management application.
+	Dma types.String `tfsdk:"dma"`
+	// Enable or disable the NDMP redirector.
+	EnableRedirector types.Bool `tfsdk:"enable_redirector"`
+	// Enable or disable the NDMP throttler.
+	EnableThrottler types.Bool `tfsdk:"enable_throttler"`
+	// The number of seconds a multi-stream backup context is retained.
+	MsbContextRetentionDuration types.Int64 `tfsdk:"msb_context_retention_duration"`
+	// The number of seconds a multi-stream restore context is retained.
+	MsrContextRetentionDuration types.Int64 `tfsdk:"msr_context_retention_duration"`
+	// The port the NDMP service listens on.
+	Port types.Int64 `tfsdk:"port"`
+	// True if the NDMP service is enabled.
+	Service types.Bool `tfsdk:"service"`
+	// The threshold of CPU usage, in percent, above which the NDMP throttler slows down backups.
+	ThrottlerCPUThreshold types.Int64 `tfsdk:"throttler_cpu_threshold"`
+}
+
+// NdmpUserResourceModel describes the resource data model.
+type NdmpUserResourceModel struct {
+	ID types.String `tfsdk:"id"`
+	// The name of the NDMP user.
+	Name types.String `tfsdk:"name"`
+	// The password of the NDMP user, never persisted.
+	Password types.String `tfsdk:"password"`
+	// Bumped to push a new password.
+	PasswordVersion types.Int64 `tfsdk:"password_version"`
+}
+
+// NdmpContextsDataSourceModel describes the data source data model.
+type NdmpContextsDataSourceModel struct {
+	ID       types.String            `tfsdk:"id"`
+	Contexts []NdmpContextModel      `tfsdk:"ndmp_contexts"`
+	Filter   *NdmpContextsFilterType `tfsdk:"filter"`
+}
+
+// NdmpContextsFilterType describes the filter data model.
+type NdmpContextsFilterType struct {
+	Type types.String `tfsdk:"type"`
+}
+
+// NdmpRestartableBackupContextsDataSourceModel describes the data source data model.
+type NdmpRestartableBackupContextsDataSourceModel struct {
+	ID       types.String       `tfsdk:"id"`
+	Contexts []NdmpContextModel `tfsdk:"ndmp_restartable_backup_contexts"`
+}
+
+// NdmpContextModel describes an NDMP backup, restore or restartable backup context.
+type NdmpContextModel struct {
+	// The identifier of the context.
+	ID types.String `tfsdk:"id"`
+	// The type of the context, one of backup, restore or bre.
+	Type types.String `tfsdk:"type"`
+	// The path the context operates on.
+	Path types.String `tfsdk:"path"`
+	// The status of the context.
+	Status types.String `tfsdk:"status"`
+	// The time the context was started, in seconds since the epoch.
+	StartTime types.Int64 `tfsdk:"start_time"`
+	// The time the context was last used, in seconds since the epoch.
+	LastUsedTime types.Int64 `tfsdk:"last_used_time"`
+}
+
+// NdmpSessionsDataSourceModel describes the data source data model.
+type NdmpSessionsDataSourceModel struct {
+	ID       types.String            `tfsdk:"id"`
+	Sessions []NdmpSessionModel      `tfsdk:"ndmp_sessions"`
+	Filter   *NdmpSessionsFilterType `tfsdk:"filter"`
+}
+
+// NdmpSessionsFilterType describes the filter data model.
+type NdmpSessionsFilterType struct {
+	Lnn     types.String `tfsdk:"lnn"`
+	Session types.String `tfsdk:"session"`
+}
+
+// NdmpSessionModel describes an NDMP session.
+type NdmpSessionModel struct {
+	// The identifier of the session, in the <lnn>.<session> format.
+	ID types.String `tfsdk:"id"`
+	// The state of the data server of the session.
+	DataState types.String `tfsdk:"data_state"`
+	// The operation of the data server of the session, ex. backup or restore.
+	DataOperation types.String `tfsdk:"data_operation"`
+	// The number of bytes processed by the data server of the session.
+	DataBytesMoved types.Int64 `tfsdk:"data_bytes_moved"`
+	// The state of the mover of the session.
+	MoverState types.String `tfsdk:"mover_state"`
+	// The mode of the mover of the session.
+	MoverMode types.String `tfsdk:"mover_mode"`
+	// The address of the data management application of the session.
+	ClientAddress types.String `tfsdk:"client_address"`
+}
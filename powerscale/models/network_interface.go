@@ -0,0 +1,62 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// NetworkInterfacesDataSourceModel describes the data source data model.
+type NetworkInterfacesDataSourceModel struct {
+	ID         types.String                `tfsdk:"id"`
+	Interfaces []NetworkInterfaceModel     `tfsdk:"network_interfaces"`
+	Filter     *NetworkInterfaceFilterType `tfsdk:"filter"`
+}
+
+// NetworkInterfaceFilterType describes the network interface filter data model.
+type NetworkInterfaceFilterType struct {
+	Lnns     types.Set `tfsdk:"lnns"`
+	Types    types.Set `tfsdk:"types"`
+	Statuses types.Set `tfsdk:"statuses"`
+}
+
+// NetworkInterfaceModel describes a network interface of a node.
+type NetworkInterfaceModel struct {
+	// Unique interface ID, in the <lnn>:<name> format.
+	ID types.String `tfsdk:"id"`
+	// Logical Node Number (LNN) of the node of the interface.
+	Lnn types.Int64 `tfsdk:"lnn"`
+	// Name of the interface, as referenced by the ifaces of a network pool.
+	Name types.String `tfsdk:"name"`
+	// Name of the interface in the operating system of the node.
+	NicName types.String `tfsdk:"nic_name"`
+	// Type of the interface.
+	Type types.String `tfsdk:"type"`
+	// Link state of the interface.
+	Status types.String `tfsdk:"status"`
+	// Link speed of the interface in Mbps.
+	Speed types.Int64 `tfsdk:"speed"`
+	// Maximum transmission unit of the interface.
+	Mtu types.Int64 `tfsdk:"mtu"`
+	// MAC address of the interface.
+	Macaddr types.String `tfsdk:"macaddr"`
+	// IP addresses assigned to the interface.
+	IPAddrs []types.String `tfsdk:"ip_addrs"`
+	// Name of the aggregated interface this interface is a member of.
+	Aggregate types.String `tfsdk:"aggregate"`
+	// Names of the interfaces aggregated by this interface.
+	AggregationMembers []types.String `tfsdk:"aggregation_members"`
+}
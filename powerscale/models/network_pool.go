@@ -76,6 +76,18 @@ type NetworkPoolDetailModel struct {
 	StaticRoutes []V12SubnetsSubnetPoolStaticRoute `tfsdk:"static_routes"`
 	// The name of the subnet.
 	Subnet types.String `tfsdk:"subnet"`
+	// IP addresses of the pool assigned to each member interface.
+	IPAssignments []NetworkPoolIPAssignmentModel `tfsdk:"ip_assignments"`
+}
+
+// NetworkPoolIPAssignmentModel describes the IP addresses of a pool assigned to a node interface.
+type NetworkPoolIPAssignmentModel struct {
+	// Logical Node Number (LNN) of the node.
+	Lnn types.Int64 `tfsdk:"lnn"`
+	// Name of the interface.
+	Iface types.String `tfsdk:"iface"`
+	// IP addresses of the pool assigned to the interface.
+	IPAddrs []types.String `tfsdk:"ip_addrs"`
 }
 
 // V12SubnetsSubnetPoolIface struct for pool interface.
@@ -154,3 +166,20 @@ type NetworkPoolResourceModel struct {
 	// The name of the subnet.
 	Subnet types.String `tfsdk:"subnet"`
 }
+
+// NetworkPoolRebalanceResourceModel describes the resource data model.
+type NetworkPoolRebalanceResourceModel struct {
+	ID types.String `tfsdk:"id"`
+	// Name of the groupnet of the pool.
+	Groupnet types.String `tfsdk:"groupnet"`
+	// Name of the subnet of the pool.
+	Subnet types.String `tfsdk:"subnet"`
+	// Name of the pool.
+	Pool types.String `tfsdk:"pool"`
+	// Arbitrary values that trigger a new rebalance when changed.
+	Triggers types.Map `tfsdk:"triggers"`
+	// Time in seconds to wait for the IP assignments to stabilize.
+	WaitTimeout types.Int64 `tfsdk:"wait_timeout"`
+	// IP assignments of the pool once the rebalance settled.
+	IPAssignments types.List `tfsdk:"ip_assignments"`
+}
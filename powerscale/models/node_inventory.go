@@ -0,0 +1,134 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// NodeDataSourceModel describes the node datasource data model.
+type NodeDataSourceModel struct {
+	ID     types.String         `tfsdk:"id"`
+	Nodes  []NodeInventoryModel `tfsdk:"nodes"`
+	Filter *NodeFilterType      `tfsdk:"filter"`
+}
+
+// NodeFilterType describes the node filter data model.
+type NodeFilterType struct {
+	Lnns      types.Set `tfsdk:"lnns"`
+	NodePools types.Set `tfsdk:"node_pools"`
+	Health    types.Set `tfsdk:"health"`
+}
+
+// NodeInventoryModel describes the inventory of a single node.
+type NodeInventoryModel struct {
+	// Node ID (Device Number) of the node.
+	ID types.Int64 `tfsdk:"id"`
+	// Logical Node Number (LNN) of the node.
+	Lnn types.Int64 `tfsdk:"lnn"`
+	// Name of the node pool the node belongs to.
+	NodePool types.String `tfsdk:"node_pool"`
+	// Serial number of the node.
+	SerialNumber types.String `tfsdk:"serial_number"`
+	// PowerScale product name.
+	Product types.String `tfsdk:"product"`
+	// Class of the node.
+	Class types.String `tfsdk:"class"`
+	// PowerScale hardware generation name.
+	Hwgen types.String `tfsdk:"hwgen"`
+	// OneFS release of the node.
+	Release types.String `tfsdk:"release"`
+	// OneFS version of the node.
+	Version types.String `tfsdk:"version"`
+	// Seconds the node has been online.
+	Uptime types.Int64 `tfsdk:"uptime"`
+	// Size of RAM in bytes.
+	RAM types.Int64 `tfsdk:"ram"`
+	// Total device storage bytes of the node.
+	CapacityBytes types.Int64 `tfsdk:"capacity_bytes"`
+	// Number of drives in the node.
+	DriveCount types.Int64 `tfsdk:"drive_count"`
+	// Whether the node is smartfailed.
+	Smartfailed types.Bool `tfsdk:"smartfailed"`
+	// Whether the node is in read-only mode.
+	Readonly types.Bool `tfsdk:"readonly"`
+	// Health of the node, one of ok, smartfailed, readonly or down.
+	Health types.String `tfsdk:"health"`
+	// Error message, if the node did not respond.
+	Error types.String `tfsdk:"error"`
+}
+
+// DriveDataSourceModel describes the drive datasource data model.
+type DriveDataSourceModel struct {
+	ID     types.String          `tfsdk:"id"`
+	Drives []DriveInventoryModel `tfsdk:"drives"`
+	Filter *DriveFilterType      `tfsdk:"filter"`
+}
+
+// DriveFilterType describes the drive filter data model.
+type DriveFilterType struct {
+	Lnns      types.Set `tfsdk:"lnns"`
+	NodePools types.Set `tfsdk:"node_pools"`
+	States    types.Set `tfsdk:"states"`
+	Purposes  types.Set `tfsdk:"purposes"`
+	Health    types.Set `tfsdk:"health"`
+}
+
+// DriveInventoryModel describes the inventory of a single drive.
+type DriveInventoryModel struct {
+	// Logical Node Number (LNN) of the node the drive is in.
+	Lnn types.Int64 `tfsdk:"lnn"`
+	// Name of the node pool the drive belongs to.
+	NodePool types.String `tfsdk:"node_pool"`
+	// Serial number of the node the drive is in.
+	NodeSerialNumber types.String `tfsdk:"node_serial_number"`
+	// Numerical representation of the drive's bay.
+	Baynum types.Int64 `tfsdk:"baynum"`
+	// The logical drive number of the drive in IFS.
+	Lnum types.Int64 `tfsdk:"lnum"`
+	// The device name of the drive.
+	Devname types.String `tfsdk:"devname"`
+	// String representation of the drive's physical location.
+	Locnstr types.String `tfsdk:"locnstr"`
+	// Serial number of the drive.
+	Serial types.String `tfsdk:"serial"`
+	// Manufacturer and model of the drive.
+	Model types.String `tfsdk:"model"`
+	// The 'worldwide name' of the drive.
+	Wwn types.String `tfsdk:"wwn"`
+	// Current firmware revision of the drive.
+	Firmware types.String `tfsdk:"firmware"`
+	// Desired firmware revision of the drive.
+	DesiredFirmware types.String `tfsdk:"desired_firmware"`
+	// Media type of the drive.
+	MediaType types.String `tfsdk:"media_type"`
+	// Interface type of the drive.
+	InterfaceType types.String `tfsdk:"interface_type"`
+	// Capacity of the drive in bytes.
+	CapacityBytes types.Int64 `tfsdk:"capacity_bytes"`
+	// Purpose of the drive in the DRV state machine.
+	Purpose types.String `tfsdk:"purpose"`
+	// Description of the drive's purpose.
+	PurposeDescription types.String `tfsdk:"purpose_description"`
+	// State of the drive as presented to the UI.
+	State types.String `tfsdk:"state"`
+	// Whether the drive is physically present in the node.
+	Present types.Bool `tfsdk:"present"`
+	// Whether the drive is being or has been smartfailed.
+	Smartfail types.Bool `tfsdk:"smartfail"`
+	// Health of the drive, one of ok, smartfail, empty or unhealthy.
+	Health types.String `tfsdk:"health"`
+}
@@ -0,0 +1,143 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// SmbSessionsDataSourceModel describes the SMB sessions datasource data model.
+type SmbSessionsDataSourceModel struct {
+	ID       types.String           `tfsdk:"id"`
+	Sessions []SmbSessionModel      `tfsdk:"smb_sessions"`
+	Filter   *SmbSessionsFilterType `tfsdk:"filter"`
+}
+
+// SmbSessionsFilterType describes the SMB sessions filter data model.
+type SmbSessionsFilterType struct {
+	Nodes    types.Set    `tfsdk:"nodes"`
+	Zone     types.String `tfsdk:"zone"`
+	User     types.String `tfsdk:"user"`
+	Computer types.String `tfsdk:"computer"`
+}
+
+// SmbSessionModel describes an SMB session open on the cluster.
+type SmbSessionModel struct {
+	// The number of seconds the session has been active.
+	ActiveTime types.Int64 `tfsdk:"active_time"`
+	// The SMB client version of the session.
+	ClientType types.String `tfsdk:"client_type"`
+	// The host name or IP address of the client computer.
+	Computer types.String `tfsdk:"computer"`
+	// Whether the session is encrypted.
+	Encryption types.Bool `tfsdk:"encryption"`
+	// Whether the session is a guest session.
+	GuestLogin types.Bool `tfsdk:"guest_login"`
+	// The number of seconds the session has been idle.
+	IdleTime types.Int64 `tfsdk:"idle_time"`
+	// The number of files open in the session.
+	Openfiles types.Int64 `tfsdk:"openfiles"`
+	// The user of the session.
+	User types.String `tfsdk:"user"`
+}
+
+// SmbOpenfilesDataSourceModel describes the SMB open files datasource data model.
+type SmbOpenfilesDataSourceModel struct {
+	ID        types.String            `tfsdk:"id"`
+	Openfiles []SmbOpenfileModel      `tfsdk:"smb_openfiles"`
+	Filter    *SmbOpenfilesFilterType `tfsdk:"filter"`
+}
+
+// SmbOpenfilesFilterType describes the SMB open files filter data model.
+type SmbOpenfilesFilterType struct {
+	Zone types.String `tfsdk:"zone"`
+	User types.String `tfsdk:"user"`
+	Path types.String `tfsdk:"path"`
+}
+
+// SmbOpenfileModel describes a file open over SMB on the cluster.
+type SmbOpenfileModel struct {
+	// The ID of the open file.
+	ID types.Int64 `tfsdk:"id"`
+	// The file as reported by the cluster.
+	File types.String `tfsdk:"file"`
+	// The path of the file in the /ifs namespace.
+	Path types.String `tfsdk:"path"`
+	// The number of locks held on the file.
+	Locks types.Int64 `tfsdk:"locks"`
+	// The permissions the file is opened with.
+	Permissions types.List `tfsdk:"permissions"`
+	// The user that opened the file.
+	User types.String `tfsdk:"user"`
+}
+
+// NfsClientsDataSourceModel describes the NFS clients datasource data model.
+type NfsClientsDataSourceModel struct {
+	ID      types.String          `tfsdk:"id"`
+	Clients []NfsClientModel      `tfsdk:"nfs_clients"`
+	Filter  *NfsClientsFilterType `tfsdk:"filter"`
+}
+
+// NfsClientsFilterType describes the NFS clients filter data model.
+type NfsClientsFilterType struct {
+	Nodes         types.Set    `tfsdk:"nodes"`
+	Zone          types.String `tfsdk:"zone"`
+	User          types.String `tfsdk:"user"`
+	ClientAddress types.String `tfsdk:"client_address"`
+}
+
+// NfsClientModel describes an NFS client active on a node of the cluster.
+type NfsClientModel struct {
+	// The logical node number the client is connected to.
+	Node types.Int64 `tfsdk:"node"`
+	// The NFS protocol version used by the client.
+	Protocol types.String `tfsdk:"protocol"`
+	// The IP address of the client.
+	RemoteAddress types.String `tfsdk:"remote_address"`
+	// The host name of the client.
+	RemoteName types.String `tfsdk:"remote_name"`
+	// The IP address of the node the client is connected to.
+	LocalAddress types.String `tfsdk:"local_address"`
+	// The host name of the node the client is connected to.
+	LocalName types.String `tfsdk:"local_name"`
+	// The user of the client.
+	User types.String `tfsdk:"user"`
+	// The rate of operations of the client, per second.
+	Ops types.Float64 `tfsdk:"ops"`
+	// The time of the statistics, in seconds since the epoch.
+	Time types.Int64 `tfsdk:"time"`
+}
+
+// SmbSessionCloseResourceModel describes the SMB session close resource data model.
+type SmbSessionCloseResourceModel struct {
+	ID types.String `tfsdk:"id"`
+	// The access zone of the sessions.
+	Zone types.String `tfsdk:"zone"`
+	// The user of the sessions and open files to close.
+	User types.String `tfsdk:"user"`
+	// The client computer of the sessions to close.
+	Computer types.String `tfsdk:"computer"`
+	// The path under which the open files are closed.
+	Path types.String `tfsdk:"path"`
+	// Whether to close the matching open files.
+	CloseOpenfiles types.Bool `tfsdk:"close_openfiles"`
+	// Whether to close the matching sessions.
+	CloseSessions types.Bool `tfsdk:"close_sessions"`
+	// The paths of the open files that were closed.
+	ClosedOpenfiles types.List `tfsdk:"closed_openfiles"`
+	// The sessions that were closed, as computer:user.
+	ClosedSessions types.List `tfsdk:"closed_sessions"`
+}
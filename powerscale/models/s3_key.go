@@ -30,4 +30,7 @@ type S3KeyResourceData struct {
 	OldSecretKey          types.String `tfsdk:"old_secret_key"`
 	OldKeyExpiry          types.Int64  `tfsdk:"old_key_expiry"`
 	OldKeyTimestamp       types.Int64  `tfsdk:"old_key_timestamp"`
+	RotationDays          types.Int64  `tfsdk:"rotation_days"`
+	RotateBeforeExpiry    types.Int64  `tfsdk:"rotate_before_expiry"`
+	Keepers               types.Map    `tfsdk:"keepers"`
 }
@@ -0,0 +1,30 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// SmbSharePermissionResourceModel describes a single permission entry of an SMB share.
+type SmbSharePermissionResourceModel struct {
+	ID             types.String                    `tfsdk:"id"`
+	ShareName      types.String                    `tfsdk:"share_name"`
+	Zone           types.String                    `tfsdk:"zone"`
+	Permission     types.String                    `tfsdk:"permission"`
+	PermissionType types.String                    `tfsdk:"permission_type"`
+	Trustee        V1AuthAccessAccessItemFileGroup `tfsdk:"trustee"`
+}
@@ -0,0 +1,91 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// SnapshotChangelistResourceModel describes the snapshot changelist resource data model.
+type SnapshotChangelistResourceModel struct {
+	// The changelist name, in the form <older_snapshot_id>_<newer_snapshot_id>.
+	ID types.String `tfsdk:"id"`
+	// The ID of the older snapshot of the pair.
+	OlderSnapshotID types.Int64 `tfsdk:"older_snapshot_id"`
+	// The ID of the newer snapshot of the pair.
+	NewerSnapshotID types.Int64 `tfsdk:"newer_snapshot_id"`
+	// Whether to retain the repstate of the snapshots after the changelist is created.
+	RetainRepstate types.Bool `tfsdk:"retain_repstate"`
+	// Whether or not to queue the job if one of the same type is already running or queued.
+	AllowDup types.Bool `tfsdk:"allow_dup"`
+	// The ID of the ChangelistCreate job.
+	JobID types.Int64 `tfsdk:"job_id"`
+	// The number of entries in the changelist.
+	NumEntries types.Int64 `tfsdk:"num_entries"`
+	// The root path of the snapshots the changelist was computed for.
+	RootPath types.String `tfsdk:"root_path"`
+	// The status of the changelist.
+	Status types.String `tfsdk:"status"`
+}
+
+// SnapshotChangelistDetail maps the changelist returned by the array.
+type SnapshotChangelistDetail struct {
+	JobID      types.Int64  `tfsdk:"job_id"`
+	NumEntries types.Int64  `tfsdk:"num_entries"`
+	RootPath   types.String `tfsdk:"root_path"`
+	Status     types.String `tfsdk:"status"`
+}
+
+// SnapshotChangelistDataSourceModel describes the snapshot changelist data source data model.
+type SnapshotChangelistDataSourceModel struct {
+	ID types.String `tfsdk:"id"`
+	// The changelist name to read the entries of.
+	ChangelistID types.String                  `tfsdk:"changelist_id"`
+	Entries      []SnapshotChangelistEntry     `tfsdk:"entries"`
+	Filter       *SnapshotChangelistFilterType `tfsdk:"filter"`
+}
+
+// SnapshotChangelistFilterType describes the filter data model.
+type SnapshotChangelistFilterType struct {
+	PathPrefix  types.String `tfsdk:"path_prefix"`
+	ChangeTypes types.Set    `tfsdk:"change_types"`
+	Limit       types.Int64  `tfsdk:"limit"`
+	PageSize    types.Int32  `tfsdk:"page_size"`
+}
+
+// SnapshotChangelistEntry describes a single entry of a changelist.
+type SnapshotChangelistEntry struct {
+	// The LIN of the entry.
+	Lin types.Int64 `tfsdk:"lin"`
+	// The LIN of the parent directory of the entry.
+	ParentLin types.Int64 `tfsdk:"parent_lin"`
+	// The path of the entry.
+	Path types.String `tfsdk:"path"`
+	// The file type of the entry.
+	Type types.String `tfsdk:"type"`
+	// The decoded change types of the entry.
+	ChangeTypes types.List `tfsdk:"change_types"`
+	// The logical size of the entry in bytes.
+	Size types.Int64 `tfsdk:"size"`
+	// The physical size of the entry in bytes.
+	PhysSize types.Int64 `tfsdk:"phys_size"`
+	// The Unix Epoch time the entry was last accessed.
+	Atime types.Int64 `tfsdk:"atime"`
+	// The Unix Epoch time the entry metadata was last changed.
+	Ctime types.Int64 `tfsdk:"ctime"`
+	// The Unix Epoch time the entry data was last modified.
+	Mtime types.Int64 `tfsdk:"mtime"`
+}
@@ -0,0 +1,96 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// StatisticsDataSourceModel describes the statistics datasource data model.
+type StatisticsDataSourceModel struct {
+	ID types.String `tfsdk:"id"`
+	// The statistics keys to query.
+	Keys types.List `tfsdk:"keys"`
+	// The node device IDs to query, 0 being the cluster.
+	Nodes types.Set `tfsdk:"nodes"`
+	// The number of seconds of history to query, current values are queried when not set.
+	HistoryWindow types.Int64 `tfsdk:"history_window"`
+	// The minimum interval in seconds between the historical values.
+	Resolution types.Int64      `tfsdk:"resolution"`
+	Statistics []StatisticModel `tfsdk:"statistics"`
+}
+
+// StatisticModel describes the value of a statistics key on a node.
+type StatisticModel struct {
+	// The statistics key.
+	Key types.String `tfsdk:"key"`
+	// The node device ID, 0 being the cluster.
+	Devid types.Int64 `tfsdk:"devid"`
+	// The time of the latest value, in Unix epoch seconds.
+	Time types.Int64 `tfsdk:"time"`
+	// The latest value, as returned by the cluster.
+	Value types.String `tfsdk:"value"`
+	// The latest value, if it is numeric.
+	ValueNumber types.Float64 `tfsdk:"value_number"`
+	// The historical values, only set when a history window is queried.
+	Values []StatisticValueModel `tfsdk:"values"`
+	// The error message, if the value could not be queried.
+	Error types.String `tfsdk:"error"`
+	// The error code, if the value could not be queried.
+	ErrorCode types.Int64 `tfsdk:"error_code"`
+}
+
+// StatisticValueModel describes a historical value of a statistics key.
+type StatisticValueModel struct {
+	// The time of the value, in Unix epoch seconds.
+	Time types.Int64 `tfsdk:"time"`
+	// The value, as returned by the cluster.
+	Value types.String `tfsdk:"value"`
+	// The value, if it is numeric.
+	ValueNumber types.Float64 `tfsdk:"value_number"`
+}
+
+// StatisticsKeysDataSourceModel describes the statistics keys datasource data model.
+type StatisticsKeysDataSourceModel struct {
+	ID     types.String              `tfsdk:"id"`
+	Keys   []StatisticsKeyModel      `tfsdk:"statistics_keys"`
+	Filter *StatisticsKeysFilterType `tfsdk:"filter"`
+}
+
+// StatisticsKeysFilterType describes the filter data model.
+type StatisticsKeysFilterType struct {
+	Keys      types.Set    `tfsdk:"keys"`
+	Substr    types.String `tfsdk:"substr"`
+	Queryable types.Bool   `tfsdk:"queryable"`
+}
+
+// StatisticsKeyModel describes a statistics key.
+type StatisticsKeyModel struct {
+	// The name of the key.
+	Key types.String `tfsdk:"key"`
+	// The description of the key.
+	Description types.String `tfsdk:"description"`
+	// The type of the value of the key.
+	Type types.String `tfsdk:"type"`
+	// The units of the value of the key.
+	Units types.String `tfsdk:"units"`
+	// The scope of the key, ex. node or cluster.
+	Scope types.String `tfsdk:"scope"`
+	// The aggregation type of the key.
+	AggregationType types.String `tfsdk:"aggregation_type"`
+	// The default cache time of the key in seconds.
+	DefaultCacheTime types.Int64 `tfsdk:"default_cache_time"`
+}
@@ -0,0 +1,54 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// SyncIQFailoverResourceModel describes the SyncIQ failover resource data model.
+type SyncIQFailoverResourceModel struct {
+	// Name of the SyncIQ policy on the source cluster.
+	ID types.String `tfsdk:"id"`
+	// Name of the SyncIQ policy on the source cluster.
+	PolicyName types.String `tfsdk:"policy_name"`
+	// Name of the mirror policy created on the target cluster by resync_prep.
+	MirrorPolicyName types.String `tfsdk:"mirror_policy_name"`
+	// The workflow to drive, failover or failback.
+	Direction types.String `tfsdk:"direction"`
+	// Whether to run the policy once more before allowing writes on the target.
+	SyncBeforeFailover types.Bool `tfsdk:"sync_before_failover"`
+	// Time in seconds to wait for each job of the workflow.
+	JobTimeout types.Int64 `tfsdk:"job_timeout"`
+	// Connection details of the target cluster.
+	TargetCluster types.Object `tfsdk:"target_cluster"`
+	// Steps of the current workflow that have completed.
+	CompletedSteps types.List `tfsdk:"completed_steps"`
+	// Last step of the current workflow that has completed.
+	LastCompletedStep types.String `tfsdk:"last_completed_step"`
+	// Status of the current workflow, complete or incomplete.
+	Status types.String `tfsdk:"status"`
+}
+
+// SyncIQFailoverClusterModel describes the connection details of a cluster.
+type SyncIQFailoverClusterModel struct {
+	Endpoint types.String `tfsdk:"endpoint"`
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+	Insecure types.Bool   `tfsdk:"insecure"`
+	AuthType types.Int64  `tfsdk:"auth_type"`
+	Timeout  types.Int64  `tfsdk:"timeout"`
+}
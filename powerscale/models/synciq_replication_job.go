@@ -23,10 +23,43 @@ import (
 
 // SyncIQReplicationJobResourceModel describes the SyncIQ Replication Job resource data model.
 type SyncIQReplicationJobResourceModel struct {
-	Id       types.String `tfsdk:"id"`
-	Action   types.String `tfsdk:"action"`
-	IsPaused types.Bool   `tfsdk:"is_paused"`
-	WaitTime types.Int64  `tfsdk:"wait_time"`
+	Id               types.String `tfsdk:"id"`
+	Action           types.String `tfsdk:"action"`
+	IsPaused         types.Bool   `tfsdk:"is_paused"`
+	WaitTime         types.Int64  `tfsdk:"wait_time"`
+	Assessment       types.Object `tfsdk:"assessment"`
+	AssessmentReport types.Object `tfsdk:"assessment_report"`
+}
+
+// SyncIQAssessmentModel describes the assessment mode of the SyncIQ Replication Job resource.
+type SyncIQAssessmentModel struct {
+	// Time in seconds to wait for the assessment job to end.
+	Timeout types.Int64 `tfsdk:"timeout"`
+	// Maximum number of files the assessment may select.
+	MaxFilesSelected types.Int64 `tfsdk:"max_files_selected"`
+	// Maximum number of bytes the assessment may select.
+	MaxBytesSelected types.Int64 `tfsdk:"max_bytes_selected"`
+	// Maximum number of errors the assessment may report.
+	MaxErrors types.Int64 `tfsdk:"max_errors"`
+}
+
+// SyncIQAssessmentReportModel describes the report of a SyncIQ assessment job.
+type SyncIQAssessmentReportModel struct {
+	ID                       types.String `tfsdk:"id"`
+	State                    types.String `tfsdk:"state"`
+	StartTime                types.Int64  `tfsdk:"start_time"`
+	EndTime                  types.Int64  `tfsdk:"end_time"`
+	Duration                 types.Int64  `tfsdk:"duration"`
+	FilesSelected            types.Int64  `tfsdk:"files_selected"`
+	TotalFiles               types.Int64  `tfsdk:"total_files"`
+	TotalDataBytes           types.Int64  `tfsdk:"total_data_bytes"`
+	FileDataBytes            types.Int64  `tfsdk:"file_data_bytes"`
+	DirsNew                  types.Int64  `tfsdk:"dirs_new"`
+	DirsChanged              types.Int64  `tfsdk:"dirs_changed"`
+	DirsDeleted              types.Int64  `tfsdk:"dirs_deleted"`
+	SourceDirectoriesVisited types.Int64  `tfsdk:"source_directories_visited"`
+	Errors                   types.List   `tfsdk:"errors"`
+	Warnings                 types.List   `tfsdk:"warnings"`
 }
 
 // SyncIQReplicationJobDataSourceModel describes the SyncIQ Replication Job datasource data model.
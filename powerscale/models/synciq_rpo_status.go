@@ -0,0 +1,59 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// SyncIQRpoStatusDataSourceModel describes the SyncIQ RPO status datasource data model.
+type SyncIQRpoStatusDataSourceModel struct {
+	ID       types.String               `tfsdk:"id"`
+	Statuses []SyncIQRpoStatusModel     `tfsdk:"synciq_rpo_status"`
+	Filter   *SyncIQRpoStatusFilterType `tfsdk:"filter"`
+}
+
+// SyncIQRpoStatusFilterType describes the filter data model.
+type SyncIQRpoStatusFilterType struct {
+	Names    types.Set  `tfsdk:"names"`
+	Breached types.Bool `tfsdk:"breached"`
+}
+
+// SyncIQRpoStatusModel describes the RPO compliance of a SyncIQ policy.
+type SyncIQRpoStatusModel struct {
+	// The system ID given to the policy.
+	PolicyID types.String `tfsdk:"policy_id"`
+	// The name of the policy.
+	PolicyName types.String `tfsdk:"policy_name"`
+	// Whether the policy is enabled.
+	Enabled types.Bool `tfsdk:"enabled"`
+	// The schedule of the policy.
+	Schedule types.String `tfsdk:"schedule"`
+	// The RPO alert threshold of the policy in seconds, 0 when disabled.
+	RpoAlert types.Int64 `tfsdk:"rpo_alert"`
+	// The end time of the last successful job of the policy, in seconds since the epoch.
+	LastSuccess types.Int64 `tfsdk:"last_success"`
+	// The number of seconds elapsed since the last successful job of the policy.
+	Lag types.Int64 `tfsdk:"lag"`
+	// Whether the policy missed its RPO.
+	Breached types.Bool `tfsdk:"breached"`
+	// The number of failed jobs of the policy since its last successful job.
+	ConsecutiveFailures types.Int64 `tfsdk:"consecutive_failures"`
+	// The errors of the last failed job of the policy since its last successful job.
+	LastError types.String `tfsdk:"last_error"`
+	// The state of the last job of the policy.
+	LastJobState types.String `tfsdk:"last_job_state"`
+}
@@ -0,0 +1,80 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// SyncIQTargetPolicyDataSourceModel describes the SyncIQ target policy datasource data model.
+type SyncIQTargetPolicyDataSourceModel struct {
+	ID       types.String                  `tfsdk:"id"`
+	Policies []SyncIQTargetPolicyModel     `tfsdk:"synciq_target_policies"`
+	Filter   *SyncIQTargetPolicyFilterType `tfsdk:"filter"`
+}
+
+// SyncIQTargetPolicyFilterType describes the filter data model.
+type SyncIQTargetPolicyFilterType struct {
+	Names                 types.Set    `tfsdk:"names"`
+	TargetPath            types.String `tfsdk:"target_path"`
+	FailoverFailbackState types.String `tfsdk:"failover_failback_state"`
+}
+
+// SyncIQTargetPolicyModel describes a SyncIQ policy that targets this cluster.
+type SyncIQTargetPolicyModel struct {
+	// The state of the policy cancellation on the target cluster.
+	CancelState types.String `tfsdk:"cancel_state"`
+	// The failover or failback state of the target directory.
+	FailoverFailbackState types.String `tfsdk:"failover_failback_state"`
+	// The system ID given to the policy.
+	ID types.String `tfsdk:"id"`
+	// The state of the last job of the policy.
+	LastJobState types.String `tfsdk:"last_job_state"`
+	// The IP address of the coordinator of the last job on the source cluster.
+	LastSourceCoordinatorIP types.String `tfsdk:"last_source_coordinator_ip"`
+	// The time of the last update from the source cluster.
+	LastUpdateFromSource types.Int64 `tfsdk:"last_update_from_source"`
+	// Whether the policy was created by a legacy version of SyncIQ.
+	LegacyPolicy types.Bool `tfsdk:"legacy_policy"`
+	// The name of the policy.
+	Name types.String `tfsdk:"name"`
+	// The GUID of the source cluster.
+	SourceClusterGUID types.String `tfsdk:"source_cluster_guid"`
+	// The host name or IP address of the source cluster.
+	SourceHost types.String `tfsdk:"source_host"`
+	// The target directory of the policy.
+	TargetPath types.String `tfsdk:"target_path"`
+}
+
+// SyncIQTargetPolicyBreakResourceModel describes the SyncIQ target policy break resource data model.
+type SyncIQTargetPolicyBreakResourceModel struct {
+	ID types.String `tfsdk:"id"`
+	// The name or ID of the target policy to break.
+	PolicyName types.String `tfsdk:"policy_name"`
+	// Whether to break the association even if the source cluster cannot be reached.
+	Force types.Bool `tfsdk:"force"`
+	// The target directory of the policy that was broken.
+	TargetPath types.String `tfsdk:"target_path"`
+	// The host name or IP address of the source cluster of the policy that was broken.
+	SourceHost types.String `tfsdk:"source_host"`
+}
+
+// SyncIQTargetReportsDatasourceModel describes the SyncIQ target reports datasource data model.
+type SyncIQTargetReportsDatasourceModel struct {
+	ID      types.String                 `tfsdk:"id"`
+	Reports []ReplicationReportsDetail   `tfsdk:"target_reports"`
+	Filter  *ReplicationReportFilterType `tfsdk:"filter"`
+}
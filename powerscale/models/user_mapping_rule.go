@@ -0,0 +1,34 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// UserMappingRuleResourceModel holds the attributes of a single user mapping rule resource.
+type UserMappingRuleResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Zone     types.String `tfsdk:"zone"`
+	Operator types.String `tfsdk:"operator"`
+	Options  types.Object `tfsdk:"options"`
+	User1    types.Object `tfsdk:"target_user"`
+	User2    types.Object `tfsdk:"source_user"`
+	// placement of the rule in the rule list of the zone
+	Position types.Int64  `tfsdk:"position"`
+	Before   types.String `tfsdk:"before"`
+	After    types.String `tfsdk:"after"`
+}
@@ -0,0 +1,55 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// UserMappingRulesEvaluationDataSourceModel describes the user mapping rules evaluation data source model.
+type UserMappingRulesEvaluationDataSourceModel struct {
+	ID types.String `tfsdk:"id"`
+	// Specifies the candidate list of user mapping rules.
+	Rules      types.List                 `tfsdk:"rules"`
+	Identities []UserMappingIdentityModel `tfsdk:"identities"`
+	Users      []types.String             `tfsdk:"users"`
+	Results    []UserMappingResultModel   `tfsdk:"results"`
+}
+
+// UserMappingIdentityModel describes a user identity, and the access token it maps to.
+type UserMappingIdentityModel struct {
+	Domain       types.String            `tfsdk:"domain"`
+	Name         types.String            `tfsdk:"name"`
+	UID          types.String            `tfsdk:"uid"`
+	SID          types.String            `tfsdk:"sid"`
+	PrimaryGroup *UserMappingGroupModel  `tfsdk:"primary_group"`
+	Groups       []UserMappingGroupModel `tfsdk:"groups"`
+}
+
+// UserMappingGroupModel describes a group of a user identity.
+type UserMappingGroupModel struct {
+	Name types.String `tfsdk:"name"`
+	GID  types.String `tfsdk:"gid"`
+	SID  types.String `tfsdk:"sid"`
+}
+
+// UserMappingResultModel describes the access token a user maps to.
+type UserMappingResultModel struct {
+	User         types.String              `tfsdk:"user"`
+	Denied       types.Bool                `tfsdk:"denied"`
+	Token        *UserMappingIdentityModel `tfsdk:"token"`
+	AppliedRules []types.Int64             `tfsdk:"applied_rules"`
+}
@@ -0,0 +1,316 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DirectoryTreeResource{}
+var _ resource.ResourceWithConfigure = &DirectoryTreeResource{}
+var _ resource.ResourceWithValidateConfig = &DirectoryTreeResource{}
+
+// NewDirectoryTreeResource creates a new resource.
+func NewDirectoryTreeResource() resource.Resource {
+	return &DirectoryTreeResource{}
+}
+
+// DirectoryTreeResource defines the resource implementation.
+type DirectoryTreeResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *DirectoryTreeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_directory_tree"
+}
+
+// Schema describes the resource arguments.
+func (r *DirectoryTreeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage a hierarchy of directories below a root directory of PowerScale Array, with the owner, group, access control and directory quota of each directory." +
+			" The directories are created parents first and deleted children first, and the directories created are deleted again if a later step fails." +
+			" The changes made to the directories outside of Terraform are reported as warnings and reverted on the next apply.",
+		Description: "This resource is used to manage a hierarchy of directories below a root directory of PowerScale Array, with the owner, group, access control and directory quota of each directory." +
+			" The directories are created parents first and deleted children first, and the directories created are deleted again if a later step fails." +
+			" The changes made to the directories outside of Terraform are reported as warnings and reverted on the next apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Directory tree identifier, the root path.",
+				MarkdownDescription: "Directory tree identifier, the root path.",
+				Computed:            true,
+			},
+			"root_path": schema.StringAttribute{
+				Description:         "Path of the existing directory the directories are created in, ex. /ifs/projects/alpha.",
+				MarkdownDescription: "Path of the existing directory the directories are created in, ex. `/ifs/projects/alpha`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^/ifs($|/)`), "must start with '/ifs'"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"delete_mode": schema.StringAttribute{
+				Description: "How the directories are deleted. fail_if_not_empty fails if a directory contains data not managed by the tree, recursive deletes the directories and their content through the namespace API," +
+					" tree_delete_job deletes the directories and their content with a TreeDelete job. The Default value is fail_if_not_empty.",
+				MarkdownDescription: "How the directories are deleted. `fail_if_not_empty` fails if a directory contains data not managed by the tree, `recursive` deletes the directories and their content through the namespace API," +
+					" `tree_delete_job` deletes the directories and their content with a TreeDelete job. The Default value is `fail_if_not_empty`.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(helper.FileSystemDeleteModeFailIfNotEmpty),
+				Validators: []validator.String{
+					stringvalidator.OneOf(helper.GetFileSystemDeleteModes()...),
+				},
+			},
+			"directories": schema.ListNestedAttribute{
+				Description:         "Directories of the tree. The parent of each directory must be the root path or another directory of the tree.(Update Supported)",
+				MarkdownDescription: "Directories of the tree. The parent of each directory must be the root path or another directory of the tree.(Update Supported)",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							Description:         "Path of the directory relative to the root path, ex. data/archive.",
+							MarkdownDescription: "Path of the directory relative to the root path, ex. `data/archive`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"full_path": schema.StringAttribute{
+							Description:         "The full path of the directory.",
+							MarkdownDescription: "The full path of the directory.",
+							Computed:            true,
+						},
+						"owner": schema.StringAttribute{
+							Description:         "Name of the user owning the directory. The owner is not managed when not set.",
+							MarkdownDescription: "Name of the user owning the directory. The owner is not managed when not set.",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"group": schema.StringAttribute{
+							Description:         "Name of the group owning the directory. The group is not managed when not set.",
+							MarkdownDescription: "Name of the group owning the directory. The group is not managed when not set.",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"access_control": schema.StringAttribute{
+							Description: "The ACL value for the directory. Users can either provide access rights input such as 'private_read' , 'private' ," +
+								" 'public_read', 'public_read_write', 'public' or permissions in POSIX format as '0550', '0770', '0775','0777' or 0700." +
+								" Only the POSIX permissions can be updated. The access control is not managed when not set.",
+							MarkdownDescription: "The ACL value for the directory. Users can either provide access rights input such as 'private_read' , 'private' ," +
+								" 'public_read', 'public_read_write', 'public' or permissions in POSIX format as '0550', '0770', '0775','0777' or 0700." +
+								" Only the POSIX permissions can be updated. The access control is not managed when not set.",
+							Optional: true,
+						},
+						"quota": schema.SingleNestedAttribute{
+							Description:         "Directory quota of the directory. No quota is managed when not set.",
+							MarkdownDescription: "Directory quota of the directory. No quota is managed when not set.",
+							Optional:            true,
+							Attributes: map[string]schema.Attribute{
+								"id": schema.StringAttribute{
+									Description:         "The system ID given to the quota.",
+									MarkdownDescription: "The system ID given to the quota.",
+									Computed:            true,
+								},
+								"hard": schema.Int64Attribute{
+									Description:         "Usage bytes at which further writes will be denied.",
+									MarkdownDescription: "Usage bytes at which further writes will be denied.",
+									Optional:            true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(1),
+									},
+								},
+								"soft": schema.Int64Attribute{
+									Description:         "Usage bytes at which notifications will be sent and writes will be denied after the grace time.",
+									MarkdownDescription: "Usage bytes at which notifications will be sent and writes will be denied after the grace time.",
+									Optional:            true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(1),
+										int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("soft_grace")),
+									},
+								},
+								"soft_grace": schema.Int64Attribute{
+									Description:         "Time in seconds after which the soft threshold has been hit before writes will be denied.",
+									MarkdownDescription: "Time in seconds after which the soft threshold has been hit before writes will be denied.",
+									Optional:            true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(1),
+										int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("soft")),
+									},
+								},
+								"advisory": schema.Int64Attribute{
+									Description:         "Usage bytes at which notifications will be sent but writes will not be denied.",
+									MarkdownDescription: "Usage bytes at which notifications will be sent but writes will not be denied.",
+									Optional:            true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(1),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *DirectoryTreeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	r.client = pscaleClient
+}
+
+// ValidateConfig validates the paths of the directories of the tree.
+func (r *DirectoryTreeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cfg models.DirectoryTreeResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.ValidateDirectoryTreeEntries(cfg.Directories); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("directories"), "Invalid directory tree", err.Error())
+	}
+}
+
+// Create allocates the resource.
+func (r *DirectoryTreeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating Directory Tree resource..")
+	var plan models.DirectoryTreeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	directories, err := helper.ApplyDirectoryTree(ctx, r.client, plan.RootPath.ValueString(), plan.DeleteMode.ValueString(), plan.Directories, nil)
+	if err != nil {
+		errStr := constants.CreateDirectoryTreeErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating the directory tree", message)
+		return
+	}
+
+	plan.Directories = directories
+	plan.ID = plan.RootPath
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with Create Directory Tree resource")
+}
+
+// Read reads data from the resource.
+func (r *DirectoryTreeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading Directory Tree resource..")
+	var state models.DirectoryTreeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	directories, diags := helper.ReadDirectoryTree(ctx, r.client, state.RootPath.ValueString(), state.Directories)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Directories = directories
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Read Directory Tree resource")
+}
+
+// Update updates the resource state.
+func (r *DirectoryTreeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating Directory Tree resource..")
+	var plan, state models.DirectoryTreeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	directories, err := helper.ApplyDirectoryTree(ctx, r.client, plan.RootPath.ValueString(), plan.DeleteMode.ValueString(), plan.Directories, state.Directories)
+	if err != nil {
+		errStr := constants.UpdateDirectoryTreeErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error updating the directory tree", message)
+		return
+	}
+
+	plan.Directories = directories
+	plan.ID = plan.RootPath
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with Update Directory Tree resource")
+}
+
+// Delete deletes the resource.
+func (r *DirectoryTreeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting Directory Tree resource..")
+	var state models.DirectoryTreeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.DeleteDirectoryTree(ctx, r.client, state.RootPath.ValueString(), state.DeleteMode.ValueString(), state.Directories); err != nil {
+		errStr := constants.DeleteDirectoryTreeErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error deleting the directory tree", message)
+		return
+	}
+	tflog.Info(ctx, "Done with Delete Directory Tree resource")
+}
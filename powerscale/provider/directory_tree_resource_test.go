@@ -0,0 +1,302 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDirectoryTreeResource(t *testing.T) {
+	var directoryTreeResourceName = "powerscale_directory_tree.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// create
+			{
+				Config: ProviderConfig + DirectoryTreeResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(directoryTreeResourceName, "id", "/ifs/tfacc_directory_tree"),
+					resource.TestCheckResourceAttr(directoryTreeResourceName, "delete_mode", "fail_if_not_empty"),
+					resource.TestCheckResourceAttr(directoryTreeResourceName, "directories.#", "3"),
+					resource.TestCheckResourceAttr(directoryTreeResourceName, "directories.0.full_path", "/ifs/tfacc_directory_tree/data"),
+					resource.TestCheckResourceAttr(directoryTreeResourceName, "directories.0.owner", "root"),
+					resource.TestCheckResourceAttr(directoryTreeResourceName, "directories.1.full_path", "/ifs/tfacc_directory_tree/data/archive"),
+					resource.TestCheckResourceAttr(directoryTreeResourceName, "directories.1.access_control", "0750"),
+					resource.TestCheckResourceAttrSet(directoryTreeResourceName, "directories.1.quota.id"),
+					resource.TestCheckResourceAttr(directoryTreeResourceName, "directories.1.quota.hard", "10737418240"),
+					resource.TestCheckResourceAttr(directoryTreeResourceName, "directories.2.full_path", "/ifs/tfacc_directory_tree/logs"),
+				),
+			},
+			// update the quota and the access control, add and remove directories
+			{
+				Config: ProviderConfig + DirectoryTreeResourceUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(directoryTreeResourceName, "directories.#", "3"),
+					resource.TestCheckResourceAttr(directoryTreeResourceName, "directories.1.access_control", "0770"),
+					resource.TestCheckResourceAttr(directoryTreeResourceName, "directories.1.quota.hard", "21474836480"),
+					resource.TestCheckResourceAttr(directoryTreeResourceName, "directories.1.quota.advisory", "10737418240"),
+					resource.TestCheckResourceAttr(directoryTreeResourceName, "directories.2.full_path", "/ifs/tfacc_directory_tree/data/archive/2025"),
+					resource.TestCheckResourceAttr(directoryTreeResourceName, "directories.2.group", "wheel"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDirectoryTreeResourceRollback(t *testing.T) {
+	var directoryTreeResourceName = "powerscale_directory_tree.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// the quota creation of data/archive fails, the directories created before it are deleted again
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.CreateQuota).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + DirectoryTreeResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			// the creation succeeds since no directory was left behind
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + DirectoryTreeResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(directoryTreeResourceName, "directories.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDirectoryTreeResourceDeleteResume(t *testing.T) {
+	var referencesMocker *mockey.Mocker
+	scans := 0
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + DirectoryTreeResourceConfig,
+			},
+			// the references are checked once, then the deletion of data fails after its children were deleted
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					referencesMocker = mockey.Mock(helper.GetFileSystemReferences).To(func(_ context.Context, _ *client.Client, _ []string, _ []string) ([]string, error) {
+						scans++
+						return nil, nil
+					}).Build()
+					FunctionMocker = mockey.Mock(helper.DeleteFileSystemByMode).To(func(ctx context.Context, client *client.Client, dirPath string, _ string) error {
+						if dirPath == "ifs/tfacc_directory_tree/data" {
+							return fmt.Errorf("mock error")
+						}
+						return helper.DeleteFileSystem(ctx, client, dirPath)
+					}).Build()
+				},
+				Config:      ProviderConfig + DirectoryTreeResourceConfig,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			// the directories already deleted are skipped
+			{
+				PreConfig: func() {
+					if scans != 1 {
+						t.Errorf("expected the references to be checked once, got %d", scans)
+					}
+					referencesMocker.Release()
+					FunctionMocker.Release()
+				},
+				Config:  ProviderConfig + DirectoryTreeResourceConfig,
+				Destroy: true,
+			},
+		},
+	})
+}
+
+func TestAccDirectoryTreeResourceErr(t *testing.T) {
+	diags := diag.Diagnostics{}
+	diags.AddError("mock error", "mock error")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + DirectoryTreeResourceMissingParentConfig,
+				ExpectError: regexp.MustCompile(`.*must be a directory of the tree*.`),
+			},
+			{
+				Config:      ProviderConfig + DirectoryTreeResourceInvalidPathConfig,
+				ExpectError: regexp.MustCompile(`.*it must be a path relative to the root path*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.ApplyDirectoryTree).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + DirectoryTreeResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + DirectoryTreeResourceConfig,
+			},
+			// read error
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.ReadDirectoryTree).Return(nil, diags).Build()
+				},
+				Config:      ProviderConfig + DirectoryTreeResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			// update error
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.ApplyDirectoryTree).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + DirectoryTreeResourceUpdateConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			// delete error
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.DeleteDirectoryTree).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + DirectoryTreeResourceConfig,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + DirectoryTreeResourceConfig,
+			},
+		},
+	})
+}
+
+var DirectoryTreeRootConfig = `
+resource "powerscale_filesystem" "directory_tree_root" {
+	directory_path = "/ifs"
+	name           = "tfacc_directory_tree"
+	group = {
+		id   = "GID:0"
+		name = "wheel"
+		type = "group"
+	}
+	owner = {
+		id   = "UID:0",
+		name = "root",
+		type = "user"
+	}
+}
+`
+
+var DirectoryTreeResourceConfig = DirectoryTreeRootConfig + `
+resource "powerscale_directory_tree" "test" {
+	root_path = powerscale_filesystem.directory_tree_root.full_path
+	directories = [
+		{
+			path  = "data"
+			owner = "root"
+			group = "wheel"
+		},
+		{
+			path           = "data/archive"
+			access_control = "0750"
+			quota = {
+				hard = 10737418240
+			}
+		},
+		{
+			path = "logs"
+		},
+	]
+}
+`
+
+var DirectoryTreeResourceUpdateConfig = DirectoryTreeRootConfig + `
+resource "powerscale_directory_tree" "test" {
+	root_path = powerscale_filesystem.directory_tree_root.full_path
+	directories = [
+		{
+			path  = "data"
+			owner = "root"
+			group = "wheel"
+		},
+		{
+			path           = "data/archive"
+			access_control = "0770"
+			quota = {
+				hard     = 21474836480
+				advisory = 10737418240
+			}
+		},
+		{
+			path  = "data/archive/2025"
+			group = "wheel"
+		},
+	]
+}
+`
+
+var DirectoryTreeResourceMissingParentConfig = `
+resource "powerscale_directory_tree" "test" {
+	root_path = "/ifs/tfacc_directory_tree"
+	directories = [
+		{
+			path = "data/archive"
+		},
+	]
+}
+`
+
+var DirectoryTreeResourceInvalidPathConfig = `
+resource "powerscale_directory_tree" "test" {
+	root_path = "/ifs/tfacc_directory_tree"
+	directories = [
+		{
+			path = "../data"
+		},
+	]
+}
+`
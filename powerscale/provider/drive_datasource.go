@@ -0,0 +1,292 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DriveDataSource{}
+
+// NewDriveDataSource creates a new data source.
+func NewDriveDataSource() datasource.DataSource {
+	return &DriveDataSource{}
+}
+
+// DriveDataSource defines the data source implementation.
+type DriveDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *DriveDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_drive"
+}
+
+// Schema describes the data source arguments.
+func (d *DriveDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This datasource is used to query the inventory of the drives of the PowerScale cluster." +
+			" The drives can be filtered by LNN, node pool, state, purpose and health, which can be used to write checks such as `no drive in SMARTFAIL` as Terraform preconditions.",
+		Description: "This datasource is used to query the inventory of the drives of the PowerScale cluster." +
+			" The drives can be filtered by LNN, node pool, state, purpose and health, which can be used to write checks such as no drive in SMARTFAIL as Terraform preconditions.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier",
+				MarkdownDescription: "Identifier",
+				Computed:            true,
+			},
+			"drives": schema.ListNestedAttribute{
+				Description:         "List of drives.",
+				MarkdownDescription: "List of drives.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"lnn": schema.Int64Attribute{
+							Description:         "Logical Node Number (LNN) of the node the drive is in.",
+							MarkdownDescription: "Logical Node Number (LNN) of the node the drive is in.",
+							Computed:            true,
+						},
+						"node_pool": schema.StringAttribute{
+							Description:         "Name of the node pool the drive belongs to.",
+							MarkdownDescription: "Name of the node pool the drive belongs to.",
+							Computed:            true,
+						},
+						"node_serial_number": schema.StringAttribute{
+							Description:         "Serial number of the node the drive is in.",
+							MarkdownDescription: "Serial number of the node the drive is in.",
+							Computed:            true,
+						},
+						"baynum": schema.Int64Attribute{
+							Description:         "Numerical representation of the drive's bay.",
+							MarkdownDescription: "Numerical representation of the drive's bay.",
+							Computed:            true,
+						},
+						"lnum": schema.Int64Attribute{
+							Description:         "The logical drive number of the drive in IFS.",
+							MarkdownDescription: "The logical drive number of the drive in IFS.",
+							Computed:            true,
+						},
+						"devname": schema.StringAttribute{
+							Description:         "The device name of the drive.",
+							MarkdownDescription: "The device name of the drive.",
+							Computed:            true,
+						},
+						"locnstr": schema.StringAttribute{
+							Description:         "String representation of the drive's physical location.",
+							MarkdownDescription: "String representation of the drive's physical location.",
+							Computed:            true,
+						},
+						"serial": schema.StringAttribute{
+							Description:         "Serial number of the drive.",
+							MarkdownDescription: "Serial number of the drive.",
+							Computed:            true,
+						},
+						"model": schema.StringAttribute{
+							Description:         "Manufacturer and model of the drive.",
+							MarkdownDescription: "Manufacturer and model of the drive.",
+							Computed:            true,
+						},
+						"wwn": schema.StringAttribute{
+							Description:         "The 'worldwide name' of the drive from its NAA identifiers.",
+							MarkdownDescription: "The 'worldwide name' of the drive from its NAA identifiers.",
+							Computed:            true,
+						},
+						"firmware": schema.StringAttribute{
+							Description:         "Current firmware revision of the drive.",
+							MarkdownDescription: "Current firmware revision of the drive.",
+							Computed:            true,
+						},
+						"desired_firmware": schema.StringAttribute{
+							Description:         "Desired firmware revision of the drive.",
+							MarkdownDescription: "Desired firmware revision of the drive.",
+							Computed:            true,
+						},
+						"media_type": schema.StringAttribute{
+							Description:         "Media type of the drive.",
+							MarkdownDescription: "Media type of the drive.",
+							Computed:            true,
+						},
+						"interface_type": schema.StringAttribute{
+							Description:         "Interface type of the drive.",
+							MarkdownDescription: "Interface type of the drive.",
+							Computed:            true,
+						},
+						"capacity_bytes": schema.Int64Attribute{
+							Description:         "Capacity of the drive in bytes.",
+							MarkdownDescription: "Capacity of the drive in bytes.",
+							Computed:            true,
+						},
+						"purpose": schema.StringAttribute{
+							Description:         "Purpose of the drive in the DRV state machine.",
+							MarkdownDescription: "Purpose of the drive in the DRV state machine.",
+							Computed:            true,
+						},
+						"purpose_description": schema.StringAttribute{
+							Description:         "Description of the drive's purpose.",
+							MarkdownDescription: "Description of the drive's purpose.",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							Description:         "State of the drive as presented to the UI, ex. HEALTHY, SMARTFAIL, EMPTY or REPLACE.",
+							MarkdownDescription: "State of the drive as presented to the UI, ex. `HEALTHY`, `SMARTFAIL`, `EMPTY` or `REPLACE`.",
+							Computed:            true,
+						},
+						"present": schema.BoolAttribute{
+							Description:         "Whether the drive is physically present in the node.",
+							MarkdownDescription: "Whether the drive is physically present in the node.",
+							Computed:            true,
+						},
+						"smartfail": schema.BoolAttribute{
+							Description:         "Whether the drive is being or has been smartfailed.",
+							MarkdownDescription: "Whether the drive is being or has been smartfailed.",
+							Computed:            true,
+						},
+						"health": schema.StringAttribute{
+							Description:         "Health of the drive, one of ok, smartfail, empty or unhealthy.",
+							MarkdownDescription: "Health of the drive, one of `ok`, `smartfail`, `empty` or `unhealthy`.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"lnns": schema.SetAttribute{
+						Optional:            true,
+						ElementType:         types.Int64Type,
+						Description:         "Filter the drives by the Logical Node Number (LNN) of their node.",
+						MarkdownDescription: "Filter the drives by the Logical Node Number (LNN) of their node.",
+					},
+					"node_pools": schema.SetAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						Description:         "Filter the drives by node pool name.",
+						MarkdownDescription: "Filter the drives by node pool name.",
+					},
+					"states": schema.SetAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						Description:         "Filter the drives by state, ex. HEALTHY or SMARTFAIL.",
+						MarkdownDescription: "Filter the drives by state, ex. `HEALTHY` or `SMARTFAIL`.",
+					},
+					"purposes": schema.SetAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						Description:         "Filter the drives by purpose, ex. STORAGE or JOURNAL.",
+						MarkdownDescription: "Filter the drives by purpose, ex. `STORAGE` or `JOURNAL`.",
+					},
+					"health": schema.SetAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						Description:         "Filter the drives by health. Supported values are ok, smartfail, empty and unhealthy.",
+						MarkdownDescription: "Filter the drives by health. Supported values are `ok`, `smartfail`, `empty` and `unhealthy`.",
+						Validators: []validator.Set{
+							setvalidator.ValueStringsAre(stringvalidator.OneOf(helper.GetDriveHealthValues()...)),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *DriveDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *DriveDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading drive data source")
+
+	var state models.DriveDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nodes, err := helper.GetNodeInventory(ctx, d.client)
+	if err != nil {
+		errStr := constants.ReadNodeInventoryErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading drives", message)
+		return
+	}
+
+	pools, err := helper.GetNodePoolNames(ctx, d.client)
+	if err != nil {
+		errStr := constants.ReadNodePoolsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading node pools", message)
+		return
+	}
+
+	var inventory []models.DriveInventoryModel
+	for _, node := range nodes {
+		for _, drive := range node.Drives {
+			inventory = append(inventory, helper.DriveInventoryMapper(node, drive, pools))
+		}
+	}
+
+	state.Drives, err = helper.FilterDriveInventory(ctx, inventory, state.Filter)
+	if err != nil {
+		resp.Diagnostics.AddError("Error filtering drives", err.Error())
+		return
+	}
+	if state.Drives == nil {
+		state.Drives = []models.DriveInventoryModel{}
+	}
+
+	state.ID = types.StringValue("drive_datasource")
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading drive data source")
+}
@@ -0,0 +1,151 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDriveDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// read all
+			{
+				Config: ProviderConfig + DriveDataSourceAllConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerscale_drive.all", "drives.#"),
+					resource.TestCheckResourceAttrSet("data.powerscale_drive.all", "drives.0.lnn"),
+					resource.TestCheckResourceAttrSet("data.powerscale_drive.all", "drives.0.state"),
+					resource.TestCheckResourceAttrSet("data.powerscale_drive.all", "drives.0.health"),
+				),
+			},
+			// read with filter
+			{
+				Config: ProviderConfig + DriveDataSourceFilterConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerscale_drive.filtering", "drives.#"),
+					resource.TestCheckResourceAttr("data.powerscale_drive.filtering", "drives.0.lnn", "1"),
+					resource.TestCheckResourceAttr("data.powerscale_drive.filtering", "drives.0.state", "HEALTHY"),
+					resource.TestCheckResourceAttr("data.powerscale_drive.filtering", "drives.0.health", "ok"),
+					resource.TestCheckResourceAttr("data.powerscale_drive.filtering", "drives.0.smartfail", "false"),
+				),
+			},
+			// filter with no match
+			{
+				Config: ProviderConfig + DriveDataSourceSmartfailConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerscale_drive.smartfail", "drives.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDriveDataSourceFilterErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + DriveDataSourceFilterConfigErr,
+				ExpectError: regexp.MustCompile(`.*Attribute filter.health.* value must be one of*.`),
+			},
+		},
+	})
+}
+
+func TestAccDriveDataSourceGettingErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.GetNodeInventory).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + DriveDataSourceAllConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetNodePoolNames).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + DriveDataSourceAllConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.FilterDriveInventory).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + DriveDataSourceAllConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + DriveDataSourceAllConfig,
+			},
+		},
+	})
+}
+
+var DriveDataSourceAllConfig = `
+data "powerscale_drive" "all" {
+}
+`
+
+var DriveDataSourceFilterConfig = `
+data "powerscale_drive" "filtering" {
+	filter {
+		lnns     = [1]
+		states   = ["HEALTHY"]
+		purposes = ["STORAGE"]
+		health   = ["ok"]
+	}
+}
+`
+
+var DriveDataSourceSmartfailConfig = `
+data "powerscale_drive" "smartfail" {
+	filter {
+		health = ["smartfail"]
+	}
+}
+`
+
+var DriveDataSourceFilterConfigErr = `
+data "powerscale_drive" "test" {
+	filter {
+		health = ["invalid"]
+	}
+}
+`
@@ -0,0 +1,540 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FileResource{}
+var _ resource.ResourceWithConfigure = &FileResource{}
+var _ resource.ResourceWithImportState = &FileResource{}
+var _ resource.ResourceWithModifyPlan = &FileResource{}
+
+// NewFileResource creates a new resource.
+func NewFileResource() resource.Resource {
+	return &FileResource{}
+}
+
+// FileResource defines the resource implementation.
+type FileResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *FileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file"
+}
+
+// Schema describes the resource arguments.
+func (r *FileResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage a file in the namespace of PowerScale Array. We can Create, Update and Delete the file using this resource. We can also import an existing file from PowerScale array." +
+			" The content of the file is tracked by its SHA-256 hash, so that changes made to the file outside of Terraform are detected and overwritten on the next apply.",
+		Description: "This resource is used to manage a file in the namespace of PowerScale Array. We can Create, Update and Delete the file using this resource. We can also import an existing file from PowerScale array." +
+			" The content of the file is tracked by its SHA-256 hash, so that changes made to the file outside of Terraform are detected and overwritten on the next apply.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "File identifier. Unique identifier for the file",
+				MarkdownDescription: "File identifier. Unique identifier for the file",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				Description:         "File name.",
+				MarkdownDescription: "File name.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[^/]+$`), "must not contain '/'"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"directory_path": schema.StringAttribute{
+				Description:         "Path of the directory the file is in, ex. /ifs/home/user. The directory must exist.",
+				MarkdownDescription: "Path of the directory the file is in, ex. `/ifs/home/user`. The directory must exist.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^/ifs($|/)`), "must start with '/ifs'"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"full_path": schema.StringAttribute{
+				Description:         "The full path of the file.",
+				MarkdownDescription: "The full path of the file.",
+				Computed:            true,
+			},
+			"content": schema.StringAttribute{
+				Description:         "Content of the file. Conflicts with source.(Update Supported)",
+				MarkdownDescription: "Content of the file. Conflicts with `source`.(Update Supported)",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("source")),
+				},
+			},
+			"source": schema.StringAttribute{
+				Description:         "Path of a local file whose content is written to the file. Conflicts with content.(Update Supported)",
+				MarkdownDescription: "Path of a local file whose content is written to the file. Conflicts with `content`.(Update Supported)",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"content_sha256": schema.StringAttribute{
+				Description:         "SHA-256 hash of the content of the file, used to detect changes made outside of Terraform.",
+				MarkdownDescription: "SHA-256 hash of the content of the file, used to detect changes made outside of Terraform.",
+				Computed:            true,
+			},
+			"query_zone": schema.StringAttribute{
+				Description:         "Specifies the zone that the object belongs to. Optional and will default to the default access zone if one is not set.",
+				MarkdownDescription: "Specifies the zone that the object belongs to. Optional and will default to the default access zone if one is not set.",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				Description:         "File Resource type",
+				MarkdownDescription: "File Resource type",
+				Computed:            true,
+			},
+			"creation_time": schema.StringAttribute{
+				Description:         "File Resource Creation time",
+				MarkdownDescription: "File Resource Creation time",
+				Computed:            true,
+			},
+			"owner": schema.SingleNestedAttribute{
+				Description:         "The owner of the file.(Update Supported)",
+				MarkdownDescription: "The owner of the file.(Update Supported)",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description:         "Owner identifier",
+						MarkdownDescription: "Owner identifier",
+						Optional:            true,
+						Computed:            true, Validators: []validator.String{
+							stringvalidator.RegexMatches(
+								regexp.MustCompile(`^(UID|SID):`), "must start with 'UID:' or 'SID:'",
+							),
+						},
+					},
+					"name": schema.StringAttribute{
+						Description:         "Owner name",
+						MarkdownDescription: "Owner name",
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("id")),
+						},
+					},
+					"type": schema.StringAttribute{
+						Description:         "Owner type",
+						MarkdownDescription: "Owner type",
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("user"),
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+				},
+			},
+			"group": schema.SingleNestedAttribute{
+				Description:         "The group of the file.(Update Supported)",
+				MarkdownDescription: "The group of the file.(Update Supported)",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description:         "group identifier",
+						MarkdownDescription: "group identifier",
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(
+								regexp.MustCompile(`^(GID|SID):`), "must start with 'GID:' or 'SID:'",
+							),
+						},
+					},
+					"name": schema.StringAttribute{
+						Description:         "group name",
+						MarkdownDescription: "group name",
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("id")),
+						},
+					},
+					"type": schema.StringAttribute{
+						Description:         "group type",
+						MarkdownDescription: "group type",
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("group"),
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+				},
+			},
+			"access_control": schema.StringAttribute{
+				Description: "The ACL value for the file. Users can either provide access rights input such as 'private_read' , 'private' ," +
+					" 'public_read', 'public_read_write', 'public' or permissions in POSIX format as '0600', '0640', '0644' or '0755'." +
+					" (Update Supported but Modification of ACL is only supported from POSIX to POSIX mode)",
+				MarkdownDescription: "The ACL value for the file. Users can either provide access rights input such as 'private_read' , 'private' ," +
+					" 'public_read', 'public_read_write', 'public' or permissions in POSIX format as '0600', '0640', '0644' or '0755'." +
+					" (Update Supported but Modification of ACL is only supported from POSIX to POSIX mode)",
+				Optional: true,
+			},
+			"authoritative": schema.StringAttribute{
+				Description:         "If the file has access rights set, then this field returns acl. Otherwise it returns mode.",
+				MarkdownDescription: "If the file has access rights set, then this field returns acl. Otherwise it returns mode.",
+				Computed:            true,
+			},
+			"mode": schema.StringAttribute{
+				Description:         "Acl mode",
+				MarkdownDescription: "Acl mode",
+				Computed:            true,
+			},
+			"user_metadata": schema.MapAttribute{
+				Description: "User-defined metadata attributes of the file, ex. owner team, cost center or data classification." +
+					" Only the attributes configured here are managed, other user-defined attributes are left untouched.(Update Supported)",
+				MarkdownDescription: "User-defined metadata attributes of the file, ex. owner team, cost center or data classification." +
+					" Only the attributes configured here are managed, other user-defined attributes are left untouched.(Update Supported)",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"overwrite": schema.BoolAttribute{
+				Description:         "Replaces an existing file with the same name when creating the file, if set to true.",
+				MarkdownDescription: "Replaces an existing file with the same name when creating the file, if set to true.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *FileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	r.client = pscaleClient
+}
+
+// ModifyPlan plans the hash of the configured content, so that a change of the content or of the file on the cluster plans an update.
+func (r *FileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan models.FileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.Content.IsUnknown() || plan.Source.IsUnknown() {
+		return
+	}
+
+	content, err := helper.GetFileDesiredContent(plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading the content of the file", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_sha256"), types.StringValue(helper.GetContentSha256(content)))...)
+}
+
+// Create creates the file.
+func (r *FileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating File..")
+	var plan models.FileResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	filePath := helper.GetDirectoryPath(plan.DirectoryPath.ValueString(), plan.Name.ValueString())
+
+	content, err := helper.GetFileDesiredContent(plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading the content of the file", err.Error())
+		return
+	}
+	if err := helper.WriteFile(ctx, r.client, filePath, content, plan.AccessControl.ValueString(), plan.Overwrite.ValueBool()); err != nil {
+		errStr := constants.CreateFileErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating File", message)
+		return
+	}
+
+	if err := helper.UpdateFileSystemOwnerAndGroup(ctx, r.client, filePath, helper.FileAsFileSystem(plan), &models.FileSystemResource{}); err != nil {
+		resp.Diagnostics.AddWarning(fmt.Sprintf("Error setting the owner and group of the File - %s", filePath), err.Error())
+	}
+
+	if err := helper.SetUserMetadata(ctx, r.client, filePath, plan.UserMetadata, types.MapNull(types.StringType)); err != nil {
+		resp.Diagnostics.AddError("Error setting the user metadata of the File", err.Error())
+		// if err, revert create
+		if err = helper.DeleteFile(ctx, r.client, filePath); err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Error deleting file when reverting creation - %s", err.Error()))
+		}
+		return
+	}
+
+	diags := r.readFile(ctx, filePath, &plan)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		// if err, revert create
+		if err = helper.DeleteFile(ctx, r.client, filePath); err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Error deleting file when reverting creation - %s", err.Error()))
+		}
+		return
+	}
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with Create File resource")
+}
+
+// Read reads the file.
+func (r *FileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Read File Resource..")
+	var state models.FileResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	filePath := helper.GetDirectoryPath(state.DirectoryPath.ValueString(), state.Name.ValueString())
+
+	resp.Diagnostics.Append(r.readFile(ctx, filePath, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Read File Resource Complete.")
+}
+
+// Update updates the content, the owner, the group and the access control of the file.
+func (r *FileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating File.")
+	var plan models.FileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.FileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	filePath := helper.GetDirectoryPath(plan.DirectoryPath.ValueString(), plan.Name.ValueString())
+
+	planFileSystem := helper.FileAsFileSystem(plan)
+	stateFileSystem := helper.FileAsFileSystem(state)
+	if !plan.ContentSha256.Equal(state.ContentSha256) {
+		content, err := helper.GetFileDesiredContent(plan)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading the content of the file", err.Error())
+			return
+		}
+		if err := helper.WriteFile(ctx, r.client, filePath, content, plan.AccessControl.ValueString(), true); err != nil {
+			errStr := constants.UpdateFileErrorMsg + "with error: "
+			message := helper.GetErrorString(err, errStr)
+			resp.Diagnostics.AddError(fmt.Sprintf("Error updating the content of the File - %s", filePath), message)
+			return
+		}
+		// the file was replaced along with its owner, group and access control
+		stateFileSystem = &models.FileSystemResource{AccessControl: plan.AccessControl}
+	}
+
+	if err := helper.UpdateFileSystemOwnerAndGroup(ctx, r.client, filePath, planFileSystem, stateFileSystem); err != nil {
+		resp.Diagnostics.AddWarning(fmt.Sprintf("Error updating the owner and group of the File - %s", filePath), err.Error())
+	}
+
+	if err := helper.UpdateFileSystemAccessControl(ctx, r.client, filePath, planFileSystem, stateFileSystem); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error updating the File - %s", filePath), err.Error())
+		return
+	}
+
+	if err := helper.SetUserMetadata(ctx, r.client, filePath, plan.UserMetadata, state.UserMetadata); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error updating the File - %s", filePath), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(r.readFile(ctx, filePath, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Updating File complete.")
+}
+
+// Delete deletes the file.
+func (r *FileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting File Resource..")
+	var state models.FileResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	filePath := helper.GetDirectoryPath(state.DirectoryPath.ValueString(), state.Name.ValueString())
+	if err := helper.DeleteFile(ctx, r.client, filePath); err != nil {
+		errStr := constants.DeleteFileErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error Deleting File", message)
+		return
+	}
+	tflog.Info(ctx, "Delete File complete")
+}
+
+// ImportState imports the file by its path, ex. ifs/home/user/README.
+func (r *FileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Importing File resource")
+	id := req.ID
+
+	meta, err := helper.GetDirectoryMetadata(ctx, r.client, id)
+	if err != nil {
+		errStr := constants.ReadFileErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error getting the metadata for the file", message)
+		return
+	}
+
+	// use empty zone for import as the zone is not known at import time
+	acl, err := helper.GetDirectoryACL(ctx, r.client, id, "")
+	if err != nil {
+		errStr := constants.ReadFileErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error getting the acl for the file", message)
+		return
+	}
+
+	contentSha256, err := helper.GetFileContentSha256(ctx, r.client, id)
+	if err != nil {
+		errStr := constants.ReadFileErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error getting the content of the file", message)
+		return
+	}
+
+	fileSystem := &models.FileSystemResource{}
+	helper.UpdateFileSystemResourceImportState(ctx, id, fileSystem, acl, meta)
+	state := models.FileResourceModel{
+		ID:            fileSystem.ID,
+		FullPath:      types.StringValue("/" + helper.GetDirectoryPath(fileSystem.DirectoryPath.ValueString(), fileSystem.Name.ValueString())),
+		Name:          fileSystem.Name,
+		DirectoryPath: fileSystem.DirectoryPath,
+		Content:       types.StringNull(),
+		Source:        types.StringNull(),
+		ContentSha256: types.StringValue(contentSha256),
+		Owner:         fileSystem.Owner,
+		Group:         fileSystem.Group,
+		Type:          fileSystem.Type,
+		CreationTime:  fileSystem.CreationTime,
+		QueryZone:     types.StringNull(),
+		AccessControl: fileSystem.AccessControl,
+		Authoritative: fileSystem.Authoritative,
+		Mode:          fileSystem.Mode,
+		Overwrite:     types.BoolValue(false),
+		UserMetadata:  fileSystem.UserMetadata,
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Import File resource")
+}
+
+// readFile reads the metadata, the ACL and the content hash of the file into the model.
+func (r *FileResource) readFile(ctx context.Context, filePath string, file *models.FileResourceModel) (diags diag.Diagnostics) {
+	meta, err := helper.GetDirectoryMetadata(ctx, r.client, filePath)
+	if err != nil {
+		errStr := constants.ReadFileErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		diags.AddError("Error getting the metadata for the file", message)
+		return
+	}
+
+	acl, err := helper.GetDirectoryACL(ctx, r.client, filePath, file.QueryZone.ValueString())
+	if err != nil {
+		errStr := constants.ReadFileErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		diags.AddError("Error getting the acl for the file", message)
+		return
+	}
+
+	contentSha256, err := helper.GetFileContentSha256(ctx, r.client, filePath)
+	if err != nil {
+		errStr := constants.ReadFileErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		diags.AddError("Error getting the content of the file", message)
+		return
+	}
+
+	resolveUID, err := helper.ResolveOwnerGroupIdentity(ctx, r.client, file.Owner.ID.ValueString(),
+		file.Owner.Name.ValueString(), file.QueryZone.ValueString(), helper.DefaultIfEmpty(file.Owner.Type.ValueString(), "user"))
+	if err != nil {
+		diags.AddError("Error resolving owner identity for file", helper.GetErrorString(err, ""))
+		return
+	}
+	resolveGID, err := helper.ResolveOwnerGroupIdentity(ctx, r.client, file.Group.ID.ValueString(),
+		file.Group.Name.ValueString(), file.QueryZone.ValueString(), helper.DefaultIfEmpty(file.Group.Type.ValueString(), "group"))
+	if err != nil {
+		diags.AddError("Error resolving group identity for file", helper.GetErrorString(err, ""))
+		return
+	}
+	diags.Append(helper.UpdateFileResourceState(ctx, file, acl, meta, resolveUID, resolveGID, contentSha256)...)
+	return
+}
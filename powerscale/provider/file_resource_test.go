@@ -0,0 +1,236 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccFileResource(t *testing.T) {
+	var fileResourceName = "powerscale_file.file_test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + FileResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(fileResourceName, "id", "ifs/tfacc_file_system_test/README"),
+					resource.TestCheckResourceAttr(fileResourceName, "full_path", "/ifs/tfacc_file_system_test/README"),
+					resource.TestCheckResourceAttr(fileResourceName, "content_sha256", helper.GetContentSha256([]byte("Managed by Terraform\n"))),
+					resource.TestCheckResourceAttr(fileResourceName, "owner.name", "root"),
+					resource.TestCheckResourceAttr(fileResourceName, "mode", "0644"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:  fileResourceName,
+				ImportState:   true,
+				ImportStateId: "ifs/tfacc_file_system_test/README",
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					assert.Equal(t, "README", states[0].Attributes["name"])
+					assert.Equal(t, "/ifs/tfacc_file_system_test", states[0].Attributes["directory_path"])
+					assert.Equal(t, helper.GetContentSha256([]byte("Managed by Terraform\n")), states[0].Attributes["content_sha256"])
+					return nil
+				},
+			},
+			// Update testing
+			{
+				Config: ProviderConfig + FileResourceUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(fileResourceName, "content_sha256", helper.GetContentSha256([]byte("Updated by Terraform\n"))),
+					resource.TestCheckResourceAttr(fileResourceName, "mode", "0600"),
+					resource.TestCheckResourceAttr(fileResourceName, "user_metadata.team", "storage"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFileResourceSource(t *testing.T) {
+	source := filepath.Join(t.TempDir(), "authorized_keys")
+	if err := os.WriteFile(source, []byte("ssh-ed25519 AAAA tfacc\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + FileResourceSourceConfig(source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_file.file_test", "content_sha256", helper.GetContentSha256([]byte("ssh-ed25519 AAAA tfacc\n"))),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFileResourceErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// content and source
+			{
+				Config:      ProviderConfig + FileResourceConflictConfig,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Combination*.`),
+			},
+			// missing source file
+			{
+				Config:      ProviderConfig + FileResourceSourceConfig("/tmp/tfacc_missing_file"),
+				ExpectError: regexp.MustCompile(`.*could not read the source file*.`),
+			},
+			// create error
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.WriteFile).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + FileResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			// read error
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetFileContentSha256).Return("", fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + FileResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + FileResourceConfig,
+			},
+			// update error
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.WriteFile).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + FileResourceUpdateConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			// delete error
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.DeleteFile).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + FileResourceConfig,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + FileResourceConfig,
+			},
+		},
+	})
+}
+
+var FileResourceConfig = FileSystemResourceConfigCommon + `
+resource "powerscale_file" "file_test" {
+	directory_path = powerscale_filesystem.file_system_test.full_path
+	name           = "README"
+	content        = "Managed by Terraform\n"
+	access_control = "0644"
+	group = {
+		id   = "GID:0"
+		name = "wheel"
+		type = "group"
+	}
+	owner = {
+		id   = "UID:0",
+		name = "root",
+		type = "user"
+	}
+}
+`
+
+var FileResourceUpdateConfig = FileSystemResourceConfigCommon + `
+resource "powerscale_file" "file_test" {
+	directory_path = powerscale_filesystem.file_system_test.full_path
+	name           = "README"
+	content        = "Updated by Terraform\n"
+	access_control = "0600"
+	user_metadata = {
+		team = "storage"
+	}
+	group = {
+		id   = "GID:0"
+		name = "wheel"
+		type = "group"
+	}
+	owner = {
+		id   = "UID:0",
+		name = "root",
+		type = "user"
+	}
+}
+`
+
+var FileResourceConflictConfig = `
+resource "powerscale_file" "file_test" {
+	directory_path = "/ifs"
+	name           = "README"
+	content        = "Managed by Terraform\n"
+	source         = "README.md"
+	group = {
+		name = "wheel"
+	}
+	owner = {
+		name = "root"
+	}
+}
+`
+
+// FileResourceSourceConfig returns the file config with the given local source file.
+func FileResourceSourceConfig(source string) string {
+	return FileSystemResourceConfigCommon + fmt.Sprintf(`
+resource "powerscale_file" "file_test" {
+	directory_path = powerscale_filesystem.file_system_test.full_path
+	name           = "authorized_keys"
+	source         = "%s"
+	access_control = "0600"
+	group = {
+		name = "wheel"
+	}
+	owner = {
+		name = "root"
+	}
+}
+`, source)
+}
@@ -20,6 +20,8 @@ package provider
 import (
 	"context"
 	"fmt"
+	"path"
+	"strings"
 	"terraform-provider-powerscale/client"
 	"terraform-provider-powerscale/powerscale/constants"
 	"terraform-provider-powerscale/powerscale/helper"
@@ -69,6 +71,11 @@ func (d *FileSystemDataSource) Schema(ctx context.Context, req datasource.Schema
 				Optional:            true,
 				Computed:            true,
 			},
+			"include_parent_snapshots": schema.BoolAttribute{
+				MarkdownDescription: "Whether to also return the snapshots of the parent directories, which cover the directory too. By default only the snapshots of the directory itself are returned.",
+				Description:         "Whether to also return the snapshots of the parent directories, which cover the directory too. By default only the snapshots of the directory itself are returned.",
+				Optional:            true,
+			},
 			"file_systems_details": schema.SingleNestedAttribute{
 				Description:         "Details of the Filesystem",
 				MarkdownDescription: "Details of the Filesystem",
@@ -525,8 +532,8 @@ func (d *FileSystemDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		usablePath = "ifs"
 		data.DirectoryPath = types.StringValue("/ifs")
 	} else {
-		// Remove the "/" infront of the beginning of the directory path for the API calls
-		usablePath = usablePath[1:]
+		// Normalize the directory path and remove the "/" infront of the beginning of it for the API calls
+		usablePath = strings.TrimPrefix(path.Clean("/"+strings.TrimPrefix(usablePath, "/")), "/")
 	}
 	meta, err := helper.GetDirectoryMetadata(ctx, d.client, usablePath)
 
@@ -562,7 +569,7 @@ func (d *FileSystemDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	snapshots, err := helper.GetDirectorySnapshots(ctx, d.client)
+	filteredSnapshots, err := helper.GetDirectorySnapshots(ctx, d.client, usablePath, data.IncludeParentSnapshots.ValueBool())
 	if err != nil {
 		errStr := constants.ReadFileSystemErrorMsg + "with error: "
 		message := helper.GetErrorString(err, errStr)
@@ -573,8 +580,6 @@ func (d *FileSystemDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	filteredSnapshots := helper.FilterPowerScaleSnapshots(snapshots, usablePath)
-
 	err = helper.BuildFilesystemDatasource(ctx, &data, filteredSnapshots, quota, acl, meta)
 	if err != nil {
 		resp.Diagnostics.AddError(
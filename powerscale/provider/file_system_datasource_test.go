@@ -69,6 +69,36 @@ func TestAccFileSystemDataSourceFilterDefault(t *testing.T) {
 	})
 }
 
+func TestAccFileSystemDataSourceSnapshots(t *testing.T) {
+	var fsTerraform = "data.powerscale_filesystem.system"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// only the snapshots of the directory itself
+			{
+				Config: ProviderConfig + FileSystemDataSourceSnapshotsConfig(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(fsTerraform, "file_systems_details.file_system_snapshots.#", "1"),
+					resource.TestCheckResourceAttr(fsTerraform, "file_systems_details.file_system_snapshots.0.path", "/ifs/tfacc_file_system_test/child"),
+				),
+			},
+			// the snapshots of the parent directories too
+			{
+				Config: ProviderConfig + FileSystemDataSourceSnapshotsConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(fsTerraform, "file_systems_details.file_system_snapshots.*", map[string]string{
+						"path": "/ifs/tfacc_file_system_test",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(fsTerraform, "file_systems_details.file_system_snapshots.*", map[string]string{
+						"path": "/ifs/tfacc_file_system_test/child",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccFileSystemDataSourceGetAclErr(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -183,3 +213,40 @@ data "powerscale_filesystem" "system" {
 	# No Directory_path should use default
   }
 `
+
+// FileSystemDataSourceSnapshotsConfig returns the config of a directory and its parent directory snapshotted.
+func FileSystemDataSourceSnapshotsConfig(includeParentSnapshots bool) string {
+	return FileSystemResourceConfigCommon + fmt.Sprintf(`
+resource "powerscale_filesystem" "child" {
+	directory_path = powerscale_filesystem.file_system_test.full_path
+	name           = "child"
+	group = {
+		id   = "GID:0"
+		name = "wheel"
+		type = "group"
+	}
+	owner = {
+		id   = "UID:0",
+		name = "root",
+		type = "user"
+	}
+}
+
+resource "powerscale_snapshot" "parent" {
+	path       = powerscale_filesystem.file_system_test.full_path
+	name       = "tfacc_file_system_parent"
+	depends_on = [powerscale_filesystem.child]
+}
+
+resource "powerscale_snapshot" "child" {
+	path = powerscale_filesystem.child.full_path
+	name = "tfacc_file_system_child"
+}
+
+data "powerscale_filesystem" "system" {
+	depends_on               = [powerscale_snapshot.parent, powerscale_snapshot.child]
+	directory_path           = "/ifs/tfacc_file_system_test/child/"
+	include_parent_snapshots = %t
+}
+`, includeParentSnapshots)
+}
@@ -27,6 +27,7 @@ import (
 	"terraform-provider-powerscale/powerscale/helper"
 	"terraform-provider-powerscale/powerscale/models"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -34,12 +35,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &FileSystemResource{}
 var _ resource.ResourceWithImportState = &FileSystemResource{}
+var _ resource.ResourceWithModifyPlan = &FileSystemResource{}
 
 // NewFileSystemResource creates a new data source.
 func NewFileSystemResource() resource.Resource {
@@ -71,8 +74,8 @@ func (r *FileSystemResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Optional:            true,
 			},
 			"name": schema.StringAttribute{
-				Description:         "FileSystem directory name",
-				MarkdownDescription: "FileSystem directory name",
+				Description:         "FileSystem directory name. Changing it renames the directory in place.(Update Supported)",
+				MarkdownDescription: "FileSystem directory name. Changing it renames the directory in place.(Update Supported)",
 				Required:            true,
 			},
 			"full_path": schema.StringAttribute{
@@ -82,8 +85,8 @@ func (r *FileSystemResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Optional:            true,
 			},
 			"directory_path": schema.StringAttribute{
-				Description:         "FileSystem directory path.This specifies the path to the FileSystem(Namespace directory) which we are trying to manage. If no directory path is specified, [/ifs] would be taken by default.",
-				MarkdownDescription: "FileSystem directory path.This specifies the path to the FileSystem(Namespace directory) which we are trying to manage. If no directory path is specified, [/ifs] would be taken by default.",
+				Description:         "FileSystem directory path.This specifies the path to the FileSystem(Namespace directory) which we are trying to manage. If no directory path is specified, [/ifs] would be taken by default. Changing it moves the directory in place.(Update Supported)",
+				MarkdownDescription: "FileSystem directory path.This specifies the path to the FileSystem(Namespace directory) which we are trying to manage. If no directory path is specified, [/ifs] would be taken by default. Changing it moves the directory in place.(Update Supported)",
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString("/ifs"),
@@ -211,6 +214,31 @@ func (r *FileSystemResource) Schema(ctx context.Context, req resource.SchemaRequ
 				MarkdownDescription: "Acl mode",
 				Computed:            true,
 			},
+			"user_metadata": schema.MapAttribute{
+				Description: "User-defined metadata attributes of the directory, ex. owner team, cost center or data classification." +
+					" Only the attributes configured here are managed, other user-defined attributes are left untouched.(Update Supported)",
+				MarkdownDescription: "User-defined metadata attributes of the directory, ex. owner team, cost center or data classification." +
+					" Only the attributes configured here are managed, other user-defined attributes are left untouched.(Update Supported)",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"delete_mode": schema.StringAttribute{
+				Description: "How the directory is deleted. fail_if_not_empty fails if the directory is not empty, recursive deletes the directory and its content through the namespace API," +
+					" tree_delete_job deletes the directory and its content with a TreeDelete job and waits for its completion, which is faster for large trees." +
+					" In all the modes the deletion is refused while NFS exports, SMB shares, S3 buckets or quotas point at the directory or below it. The Default value is fail_if_not_empty.",
+				MarkdownDescription: "How the directory is deleted. `fail_if_not_empty` fails if the directory is not empty, `recursive` deletes the directory and its content through the namespace API," +
+					" `tree_delete_job` deletes the directory and its content with a TreeDelete job and waits for its completion, which is faster for large trees." +
+					" In all the modes the deletion is refused while NFS exports, SMB shares, S3 buckets or quotas point at the directory or below it. The Default value is `fail_if_not_empty`.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(helper.FileSystemDeleteModeFailIfNotEmpty),
+				Validators: []validator.String{
+					stringvalidator.OneOf(helper.GetFileSystemDeleteModes()...),
+				},
+			},
 		},
 	}
 }
@@ -234,6 +262,35 @@ func (r *FileSystemResource) Configure(ctx context.Context, req resource.Configu
 	r.client = pscaleClient
 }
 
+// ModifyPlan warns when the name or the directory path changes, as the directory is then moved in place.
+func (r *FileSystemResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing is moved on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state models.FileSystemResource
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.Name.IsUnknown() || plan.DirectoryPath.IsUnknown() {
+		return
+	}
+
+	planDirName := helper.GetDirectoryPath(plan.DirectoryPath.ValueString(), plan.Name.ValueString())
+	stateDirName := helper.GetDirectoryPath(state.DirectoryPath.ValueString(), state.Name.ValueString())
+	if planDirName == stateDirName {
+		return
+	}
+	resp.Diagnostics.AddWarning("File System will be moved",
+		fmt.Sprintf("The directory will be moved in place from /%s to /%s, keeping its content, ACL and quotas.", stateDirName, planDirName))
+	if plan.ID.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringValue(planDirName))...)
+	}
+	if plan.FullPath.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("full_path"), types.StringValue("/"+planDirName))...)
+	}
+}
+
 // Create creates the File system resource.
 func (r *FileSystemResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	tflog.Info(ctx, "Creating File System..")
@@ -270,6 +327,15 @@ func (r *FileSystemResource) Create(ctx context.Context, req resource.CreateRequ
 		resp.Diagnostics.AddWarning(fmt.Sprintf("Error setting the File system Resource - %s", dirPath), err.Error())
 	}
 
+	if err := helper.SetUserMetadata(ctx, r.client, dirPath, plan.UserMetadata, types.MapNull(types.StringType)); err != nil {
+		resp.Diagnostics.AddError("Error setting the user metadata of the filesystem", err.Error())
+		// if err, revert create
+		if err = helper.DeleteFileSystem(ctx, r.client, dirPath); err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Error deleting filesystem when reverting creation - %s", err.Error()))
+		}
+		return
+	}
+
 	// Get File system metadata
 	meta, err := helper.GetDirectoryMetadata(ctx, r.client, dirPath)
 	if err != nil {
@@ -379,7 +445,7 @@ func (r *FileSystemResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 	dirPath := helper.GetDirectoryPath(plan.DirectoryPath.ValueString(), plan.Name.ValueString())
-	if err := helper.DeleteFileSystem(ctx, r.client, dirPath); err != nil {
+	if err := helper.DeleteFileSystemWithMode(ctx, r.client, dirPath, plan.DeleteMode.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Error Deleting filesystem", err.Error())
 		return
 	}
@@ -406,8 +472,19 @@ func (r *FileSystemResource) Update(ctx context.Context, req resource.UpdateRequ
 	planDirName := helper.GetDirectoryPath(plan.DirectoryPath.ValueString(), plan.Name.ValueString())
 	stateDirName := helper.GetDirectoryPath(state.DirectoryPath.ValueString(), state.Name.ValueString())
 	if planDirName != stateDirName {
-		resp.Diagnostics.AddError(constants.UpdateFileSystemErrorMsg, "Renaming Directory is not supported")
-		return
+		if err := helper.MoveFileSystem(ctx, r.client, stateDirName, planDirName); err != nil {
+			resp.Diagnostics.AddError(constants.UpdateFileSystemErrorMsg, err.Error())
+			return
+		}
+		// record the new location right away, so a failure below does not leave the state pointing at the old one
+		state.Name = plan.Name
+		state.DirectoryPath = plan.DirectoryPath
+		state.ID = types.StringValue(planDirName)
+		state.FullPath = types.StringValue("/" + planDirName)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	if err := helper.UpdateFileSystemOwnerAndGroup(ctx, r.client, planDirName, &plan, &state); err != nil {
@@ -419,6 +496,11 @@ func (r *FileSystemResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	if err := helper.SetUserMetadata(ctx, r.client, planDirName, plan.UserMetadata, state.UserMetadata); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error updating the File system Resource - %s", planDirName), err.Error())
+		return
+	}
+
 	// Get metadata
 	meta, err := helper.GetDirectoryMetadata(ctx, r.client, planDirName)
 	if err != nil {
@@ -26,6 +26,7 @@ import (
 
 	"github.com/bytedance/mockey"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/stretchr/testify/assert"
 )
@@ -385,10 +386,65 @@ func TestAccFileSystemResourceUpdateFail(t *testing.T) {
 				},
 				Config: ProviderConfig + FileSystemResourceUpdConfig,
 			},
-			//Update owner/group/accessControl, then Read testing
+			// Rename error
 			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.MoveFileSystem).Return(fmt.Errorf("mock error")).Build()
+				},
 				Config:      ProviderConfig + FileSystemUpdateResourceConfigErr,
-				ExpectError: regexp.MustCompile(`.*Renaming Directory is not supported*.`),
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + FileSystemResourceUpdConfig,
+			},
+		},
+	})
+}
+
+func TestAccFileSystemResourceMove(t *testing.T) {
+	var fileSystemResourceName = "powerscale_filesystem.file_system_test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + FileSystemResourceMoveConfig("/ifs", "tfaccDirTf"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(fileSystemResourceName, "id", "ifs/tfaccDirTf"),
+				),
+			},
+			// rename in place
+			{
+				Config: ProviderConfig + FileSystemResourceMoveConfig("/ifs", "tfaccDirTfRenamed"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(fileSystemResourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(fileSystemResourceName, "id", "ifs/tfaccDirTfRenamed"),
+					resource.TestCheckResourceAttr(fileSystemResourceName, "full_path", "/ifs/tfaccDirTfRenamed"),
+				),
+			},
+			// move in place
+			{
+				Config: ProviderConfig + FileSystemResourceMoveConfig("/ifs/tfacc_file_system_test", "tfaccDirTfRenamed"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(fileSystemResourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(fileSystemResourceName, "id", "ifs/tfacc_file_system_test/tfaccDirTfRenamed"),
+					resource.TestCheckResourceAttr(fileSystemResourceName, "full_path", "/ifs/tfacc_file_system_test/tfaccDirTfRenamed"),
+					resource.TestCheckResourceAttr(fileSystemResourceName, "owner.name", "root"),
+				),
 			},
 		},
 	})
@@ -499,6 +555,149 @@ func TestAccFileSystemResourceDeleteMockErr(t *testing.T) {
 	})
 }
 
+func TestAccFileSystemResourceDeleteMode(t *testing.T) {
+	var fileSystemResourceName = "powerscale_filesystem.file_system_test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// invalid delete mode
+			{
+				Config:      ProviderConfig + FileSystemResourceDeleteModeConfig("invalid"),
+				ExpectError: regexp.MustCompile(`.*Attribute delete_mode value must be one of*.`),
+			},
+			// default delete mode
+			{
+				Config: ProviderConfig + FileSystemResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(fileSystemResourceName, "delete_mode", "fail_if_not_empty"),
+				),
+			},
+			// update delete mode
+			{
+				Config: ProviderConfig + FileSystemResourceDeleteModeConfig("recursive"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(fileSystemResourceName, "delete_mode", "recursive"),
+				),
+			},
+			// delete with TreeDelete job
+			{
+				Config: ProviderConfig + FileSystemResourceDeleteModeConfig("tree_delete_job"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(fileSystemResourceName, "delete_mode", "tree_delete_job"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFileSystemResourceDeleteModeErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + FileSystemResourceDeleteModeConfig("tree_delete_job"),
+			},
+			// referenced directory
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.GetFileSystemReferences).Return([]string{"NFS export 1 in zone System"}, nil).Build()
+				},
+				Config:      ProviderConfig + FileSystemResourceDeleteModeConfig("tree_delete_job"),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`.*still referenced by NFS export 1 in zone System*.`),
+			},
+			// error reading the references
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetFileSystemReferences).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + FileSystemResourceDeleteModeConfig("tree_delete_job"),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			// TreeDelete job error
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.RunTreeDeleteJob).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + FileSystemResourceDeleteModeConfig("tree_delete_job"),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + FileSystemResourceDeleteModeConfig("tree_delete_job"),
+			},
+		},
+	})
+}
+
+func TestAccFileSystemResourceUserMetadata(t *testing.T) {
+	var fileSystemResourceName = "powerscale_filesystem.file_system_test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + FileSystemResourceUserMetadataConfig(`{
+					team           = "storage"
+					cost_center    = "cc-42"
+				}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(fileSystemResourceName, "user_metadata.%", "2"),
+					resource.TestCheckResourceAttr(fileSystemResourceName, "user_metadata.team", "storage"),
+					resource.TestCheckResourceAttr(fileSystemResourceName, "user_metadata.cost_center", "cc-42"),
+				),
+			},
+			// update one attribute and remove the other
+			{
+				Config: ProviderConfig + FileSystemResourceUserMetadataConfig(`{
+					team                = "analytics"
+					data_classification = "confidential"
+				}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(fileSystemResourceName, "user_metadata.%", "2"),
+					resource.TestCheckResourceAttr(fileSystemResourceName, "user_metadata.team", "analytics"),
+					resource.TestCheckResourceAttr(fileSystemResourceName, "user_metadata.data_classification", "confidential"),
+				),
+			},
+			// update error
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.SetUserMetadata).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config: ProviderConfig + FileSystemResourceUserMetadataConfig(`{
+					team = "storage"
+				}`),
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + FileSystemResourceUserMetadataConfig(`{
+					team = "storage"
+				}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(fileSystemResourceName, "user_metadata.%", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccFileSystemResourceCreateAclSID(t *testing.T) {
 	var fileSystemResourceName = "powerscale_filesystem.file_system_test"
 	resource.Test(t, resource.TestCase{
@@ -781,3 +980,125 @@ resource "powerscale_filesystem" "file_system_test" {
 	}
   }
 `
+
+// FileSystemResourceDeleteModeConfig returns the filesystem config with the given delete mode.
+func FileSystemResourceDeleteModeConfig(deleteMode string) string {
+	return fmt.Sprintf(`
+resource "powerscale_filesystem" "file_system_test" {
+	name      = "tfaccDirTf"
+	recursive = true
+	overwrite = true
+	group = {
+		id   = "GID:0"
+		name = "wheel"
+		type = "group"
+	}
+	owner = {
+		id   = "UID:0",
+		name = "root",
+		type = "user"
+	}
+	delete_mode = "%s"
+}
+`, deleteMode)
+}
+
+func TestAccFileSystemResourceMoveError(t *testing.T) {
+	var fileSystemResourceName = "powerscale_filesystem.file_system_test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + FileSystemResourceMoveConfig("/ifs", "tfaccDirTf"),
+			},
+			// the move succeeds but the update fails afterwards
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.UpdateFileSystemAccessControl).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + FileSystemResourceMoveConfig("/ifs", "tfaccDirTfRenamed"),
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			// the state already points at the new location, nothing is left to move
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.MoveFileSystem).Return(fmt.Errorf("mock move error")).Build()
+				},
+				Config: ProviderConfig + FileSystemResourceMoveConfig("/ifs", "tfaccDirTfRenamed"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(fileSystemResourceName, "id", "ifs/tfaccDirTfRenamed"),
+					resource.TestCheckResourceAttr(fileSystemResourceName, "name", "tfaccDirTfRenamed"),
+					resource.TestCheckResourceAttr(fileSystemResourceName, "full_path", "/ifs/tfaccDirTfRenamed"),
+				),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + FileSystemResourceMoveConfig("/ifs", "tfaccDirTfRenamed"),
+			},
+		},
+	})
+}
+
+// FileSystemResourceMoveConfig returns the filesystem config with the given directory path and name,
+// along with the directory the filesystem can be moved into.
+func FileSystemResourceMoveConfig(directoryPath, name string) string {
+	return fmt.Sprintf(`
+resource "powerscale_filesystem" "file_system_parent" {
+	directory_path = "/ifs"
+	name           = "tfacc_file_system_test"
+	group = {
+		id   = "GID:0"
+		name = "wheel"
+		type = "group"
+	}
+	owner = {
+		id   = "UID:0",
+		name = "root",
+		type = "user"
+	}
+}
+
+resource "powerscale_filesystem" "file_system_test" {
+	directory_path = "%s"
+	name           = "%s"
+	group = {
+		id   = "GID:0"
+		name = "wheel"
+		type = "group"
+	}
+	owner = {
+		id   = "UID:0",
+		name = "root",
+		type = "user"
+	}
+	depends_on = [powerscale_filesystem.file_system_parent]
+}
+`, directoryPath, name)
+}
+
+// FileSystemResourceUserMetadataConfig returns the filesystem config with the given user metadata.
+func FileSystemResourceUserMetadataConfig(userMetadata string) string {
+	return fmt.Sprintf(`
+resource "powerscale_filesystem" "file_system_test" {
+	name = "tfaccDirTf"
+	group = {
+		id   = "GID:0"
+		name = "wheel"
+		type = "group"
+	}
+	owner = {
+		id   = "UID:0",
+		name = "root",
+		type = "user"
+	}
+	user_metadata = %s
+}
+`, userMetadata)
+}
@@ -0,0 +1,272 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &FtpSettingsDataSource{}
+	_ datasource.DataSourceWithConfigure = &FtpSettingsDataSource{}
+)
+
+// NewFtpSettingsDataSource creates a new ftp settings data source.
+func NewFtpSettingsDataSource() datasource.DataSource {
+	return &FtpSettingsDataSource{}
+}
+
+// FtpSettingsDataSource defines the data source implementation.
+type FtpSettingsDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *FtpSettingsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ftp_settings"
+}
+
+// Schema describes the data source arguments.
+func (d *FtpSettingsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This datasource is used to query the FTP Settings from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Description:         "This datasource is used to query the FTP Settings from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Id of FTP Settings. Readonly. ",
+				MarkdownDescription: "Id of FTP Settings. Readonly. ",
+			},
+			"accept_timeout": schema.Int64Attribute{
+				Description:         "Controls the time in seconds to wait for a remote client to establish a PASV style data connection.",
+				MarkdownDescription: "Controls the time in seconds to wait for a remote client to establish a PASV style data connection.",
+				Computed:            true,
+			},
+			"allow_anon_access": schema.BoolAttribute{
+				Description:         "Controls whether anonymous logins are permitted or not.",
+				MarkdownDescription: "Controls whether anonymous logins are permitted or not.",
+				Computed:            true,
+			},
+			"allow_anon_upload": schema.BoolAttribute{
+				Description:         "Controls whether anonymous users will be allowed to upload files.",
+				MarkdownDescription: "Controls whether anonymous users will be allowed to upload files.",
+				Computed:            true,
+			},
+			"allow_dirlists": schema.BoolAttribute{
+				Description:         "If set to false, all directory list commands will return a permission denied error.",
+				MarkdownDescription: "If set to false, all directory list commands will return a permission denied error.",
+				Computed:            true,
+			},
+			"allow_downloads": schema.BoolAttribute{
+				Description:         "If set to false, all downloads requests will return a permission denied error.",
+				MarkdownDescription: "If set to false, all downloads requests will return a permission denied error.",
+				Computed:            true,
+			},
+			"allow_local_access": schema.BoolAttribute{
+				Description:         "Controls whether local logins are permitted or not.",
+				MarkdownDescription: "Controls whether local logins are permitted or not.",
+				Computed:            true,
+			},
+			"allow_writes": schema.BoolAttribute{
+				Description:         "This controls whether any FTP commands which change the filesystem are allowed or not.",
+				MarkdownDescription: "This controls whether any FTP commands which change the filesystem are allowed or not.",
+				Computed:            true,
+			},
+			"always_chdir_homedir": schema.BoolAttribute{
+				Description:         "This controls whether FTP will always initially change directories to the home directory of the user, regardless of whether it is chroot-ing.",
+				MarkdownDescription: "This controls whether FTP will always initially change directories to the home directory of the user, regardless of whether it is chroot-ing.",
+				Computed:            true,
+			},
+			"anon_chown_username": schema.StringAttribute{
+				Description:         "This is the name of the user who is given ownership of anonymously uploaded files.",
+				MarkdownDescription: "This is the name of the user who is given ownership of anonymously uploaded files.",
+				Computed:            true,
+			},
+			"anon_password_list": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Description:         "A list of passwords for anonymous users.",
+				MarkdownDescription: "A list of passwords for anonymous users.",
+				Computed:            true,
+			},
+			"anon_root_path": schema.StringAttribute{
+				Description:         "This option represents a directory in /ifs which vsftpd will try to change into after an anonymous login.",
+				MarkdownDescription: "This option represents a directory in /ifs which vsftpd will try to change into after an anonymous login.",
+				Computed:            true,
+			},
+			"anon_umask": schema.Int64Attribute{
+				Description:         "The value that the umask for file creation is set to for anonymous users.",
+				MarkdownDescription: "The value that the umask for file creation is set to for anonymous users.",
+				Computed:            true,
+			},
+			"ascii_mode": schema.StringAttribute{
+				Description:         "Controls whether ascii mode data transfers are enabled. Acceptable values: off, client, server, both.",
+				MarkdownDescription: "Controls whether ascii mode data transfers are enabled. Acceptable values: off, client, server, both.",
+				Computed:            true,
+			},
+			"chroot_exception_list": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Description:         "A list of users that are not chrooted when logging in.",
+				MarkdownDescription: "A list of users that are not chrooted when logging in.",
+				Computed:            true,
+			},
+			"chroot_local_mode": schema.StringAttribute{
+				Description:         "If set to 'all', all local users will be (by default) placed in a chroot() jail in their home directory after login. If set to 'all-with-exceptions', all local users except those listed in the chroot exception list will be placed in a chroot() jail. If set to 'none', no local users will be chrooted by default. If set to 'none-with-exceptions', only the local users listed in the chroot exception list will be placed in a chroot() jail.",
+				MarkdownDescription: "If set to 'all', all local users will be (by default) placed in a chroot() jail in their home directory after login. If set to 'all-with-exceptions', all local users except those listed in the chroot exception list will be placed in a chroot() jail. If set to 'none', no local users will be chrooted by default. If set to 'none-with-exceptions', only the local users listed in the chroot exception list will be placed in a chroot() jail.",
+				Computed:            true,
+			},
+			"connect_timeout": schema.Int64Attribute{
+				Description:         "The timeout, in seconds, for a remote client to respond to our PORT style data connection.",
+				MarkdownDescription: "The timeout, in seconds, for a remote client to respond to our PORT style data connection.",
+				Computed:            true,
+			},
+			"data_timeout": schema.Int64Attribute{
+				Description:         "The timeout, in seconds, which is roughly the maximum time we permit data transfers to stall for with no progress. If the timeout triggers, the remote client is kicked off.",
+				MarkdownDescription: "The timeout, in seconds, which is roughly the maximum time we permit data transfers to stall for with no progress. If the timeout triggers, the remote client is kicked off.",
+				Computed:            true,
+			},
+			"denied_user_list": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Description:         "A list of users that will be denied access.",
+				MarkdownDescription: "A list of users that will be denied access.",
+				Computed:            true,
+			},
+			"dirlist_localtime": schema.BoolAttribute{
+				Description:         "If enabled, display directory listings with the time in your local time zone. The default is to display GMT.",
+				MarkdownDescription: "If enabled, display directory listings with the time in your local time zone. The default is to display GMT.",
+				Computed:            true,
+			},
+			"dirlist_names": schema.StringAttribute{
+				Description:         "When set to 'hide', all user and group information in directory listings will be displayed as 'ftp'. When set to 'textual', textual names are shown in the user and group fields of directory listings. When set to 'numeric', numeric IDs are show in the user and group fields of directory listings.",
+				MarkdownDescription: "When set to 'hide', all user and group information in directory listings will be displayed as 'ftp'. When set to 'textual', textual names are shown in the user and group fields of directory listings. When set to 'numeric', numeric IDs are show in the user and group fields of directory listings.",
+				Computed:            true,
+			},
+			"file_create_perm": schema.Int64Attribute{
+				Description:         "The permissions with which uploaded files are created. Umasks are applied on top of this value.",
+				MarkdownDescription: "The permissions with which uploaded files are created. Umasks are applied on top of this value.",
+				Computed:            true,
+			},
+			"limit_anon_passwords": schema.BoolAttribute{
+				Description:         "This field determines whether the anon_password_list is used.",
+				MarkdownDescription: "This field determines whether the anon_password_list is used.",
+				Computed:            true,
+			},
+			"local_root_path": schema.StringAttribute{
+				Description:         "This option represents a directory in /ifs which vsftpd will try to change into after a local login.",
+				MarkdownDescription: "This option represents a directory in /ifs which vsftpd will try to change into after a local login.",
+				Computed:            true,
+			},
+			"local_umask": schema.Int64Attribute{
+				Description:         "The value that the umask for file creation is set to for local users.",
+				MarkdownDescription: "The value that the umask for file creation is set to for local users.",
+				Computed:            true,
+			},
+			"server_to_server": schema.BoolAttribute{
+				Description:         "If enabled, allow server-to-server (FXP) transfers.",
+				MarkdownDescription: "If enabled, allow server-to-server (FXP) transfers.",
+				Computed:            true,
+			},
+			"service": schema.BoolAttribute{
+				Description:         "This field controls whether the FTP daemon is running.",
+				MarkdownDescription: "This field controls whether the FTP daemon is running.",
+				Computed:            true,
+			},
+			"session_support": schema.BoolAttribute{
+				Description:         "If enabled, maintain login sessions for each user through Pluggable Authentication Modules (PAM).",
+				MarkdownDescription: "If enabled, maintain login sessions for each user through Pluggable Authentication Modules (PAM).",
+				Computed:            true,
+			},
+			"session_timeout": schema.Int64Attribute{
+				Description:         "The timeout, in seconds, for an idle session. If the idle time expires the client will be disconnected.",
+				MarkdownDescription: "The timeout, in seconds, for an idle session. If the idle time expires the client will be disconnected.",
+				Computed:            true,
+			},
+			"user_config_dir": schema.StringAttribute{
+				Description:         "Specifies the directory where per-user config overrides can be found.",
+				MarkdownDescription: "Specifies the directory where per-user config overrides can be found.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *FtpSettingsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *FtpSettingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading FTP Settings data source ")
+
+	var settingsState models.FtpSettingsModel
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &settingsState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ftpSettings, err := helper.GetFtpSettings(ctx, d.client)
+
+	if err != nil {
+		errStr := constants.ReadFtpSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error reading ftp settings",
+			message,
+		)
+		return
+	}
+
+	err = helper.CopyFields(ctx, ftpSettings.GetSettings(), &settingsState)
+	if err != nil {
+		resp.Diagnostics.AddError("Error copying fields of ftp settings datasource", err.Error())
+		return
+	}
+
+	settingsState.ID = types.StringValue("ftp_settings")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &settingsState)...)
+	tflog.Info(ctx, "Done with Read FTP Settings data source ")
+}
@@ -0,0 +1,72 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFtpSettingsDataSource(t *testing.T) {
+	var ftpSettings = "data.powerscale_ftp_settings.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// read all testing
+			{
+				Config: ProviderConfig + ftpSettingsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(ftpSettings, "id"),
+					resource.TestCheckResourceAttrSet(ftpSettings, "accept_timeout"),
+					resource.TestCheckResourceAttrSet(ftpSettings, "allow_anon_access"),
+					resource.TestCheckResourceAttrSet(ftpSettings, "allow_local_access"),
+					resource.TestCheckResourceAttrSet(ftpSettings, "chroot_local_mode"),
+					resource.TestCheckResourceAttrSet(ftpSettings, "server_to_server"),
+					resource.TestCheckResourceAttrSet(ftpSettings, "session_timeout"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFtpSettingsDataSourceErrorGetAll(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock(helper.GetFtpSettings).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + ftpSettingsDataSourceConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+		},
+	})
+}
+
+var ftpSettingsDataSourceConfig = `
+data "powerscale_ftp_settings" "test" {
+}
+`
@@ -0,0 +1,510 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource              = &FtpSettingsResource{}
+	_ resource.ResourceWithConfigure = &FtpSettingsResource{}
+)
+
+// NewFtpSettingsResource creates a new resource.
+func NewFtpSettingsResource() resource.Resource {
+	return &FtpSettingsResource{}
+}
+
+// FtpSettingsResource defines the resource implementation.
+type FtpSettingsResource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (r *FtpSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ftp_settings"
+}
+
+// Schema describes the data source arguments.
+func (r *FtpSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `This resource is used to manage the FTP Settings of PowerScale Array. We can Create, Update and Delete the FTP Settings using this resource.  
+Note that, FTP Settings is the native functionality of PowerScale. When creating the resource, we actually load FTP Settings from PowerScale to the resource.`,
+		Description: `This resource is used to manage the FTP Settings of PowerScale Array. We can Create, Update and Delete the FTP Settings using this resource.  
+Note that, FTP Settings is the native functionality of PowerScale. When creating the resource, we actually load FTP Settings from PowerScale to the resource.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Id of FTP Settings. Readonly. ",
+				MarkdownDescription: "Id of FTP Settings. Readonly. ",
+			},
+			"accept_timeout": schema.Int64Attribute{
+				Description:         "Controls the time in seconds to wait for a remote client to establish a PASV style data connection.",
+				MarkdownDescription: "Controls the time in seconds to wait for a remote client to establish a PASV style data connection.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(30, 600),
+				},
+			},
+			"allow_anon_access": schema.BoolAttribute{
+				Description:         "Controls whether anonymous logins are permitted or not.",
+				MarkdownDescription: "Controls whether anonymous logins are permitted or not.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"allow_anon_upload": schema.BoolAttribute{
+				Description:         "Controls whether anonymous users will be allowed to upload files.",
+				MarkdownDescription: "Controls whether anonymous users will be allowed to upload files.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"allow_dirlists": schema.BoolAttribute{
+				Description:         "If set to false, all directory list commands will return a permission denied error.",
+				MarkdownDescription: "If set to false, all directory list commands will return a permission denied error.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"allow_downloads": schema.BoolAttribute{
+				Description:         "If set to false, all downloads requests will return a permission denied error.",
+				MarkdownDescription: "If set to false, all downloads requests will return a permission denied error.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"allow_local_access": schema.BoolAttribute{
+				Description:         "Controls whether local logins are permitted or not.",
+				MarkdownDescription: "Controls whether local logins are permitted or not.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"allow_writes": schema.BoolAttribute{
+				Description:         "This controls whether any FTP commands which change the filesystem are allowed or not.",
+				MarkdownDescription: "This controls whether any FTP commands which change the filesystem are allowed or not.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"always_chdir_homedir": schema.BoolAttribute{
+				Description:         "This controls whether FTP will always initially change directories to the home directory of the user, regardless of whether it is chroot-ing.",
+				MarkdownDescription: "This controls whether FTP will always initially change directories to the home directory of the user, regardless of whether it is chroot-ing.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"anon_chown_username": schema.StringAttribute{
+				Description:         "This is the name of the user who is given ownership of anonymously uploaded files.",
+				MarkdownDescription: "This is the name of the user who is given ownership of anonymously uploaded files.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"anon_password_list": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Description:         "A list of passwords for anonymous users.",
+				MarkdownDescription: "A list of passwords for anonymous users.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"anon_root_path": schema.StringAttribute{
+				Description:         "This option represents a directory in /ifs which vsftpd will try to change into after an anonymous login.",
+				MarkdownDescription: "This option represents a directory in /ifs which vsftpd will try to change into after an anonymous login.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^/ifs($|/)`), "must start with '/ifs'"),
+				},
+			},
+			"anon_umask": schema.Int64Attribute{
+				Description:         "The value that the umask for file creation is set to for anonymous users.",
+				MarkdownDescription: "The value that the umask for file creation is set to for anonymous users.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 511),
+				},
+			},
+			"ascii_mode": schema.StringAttribute{
+				Description:         "Controls whether ascii mode data transfers are enabled. Acceptable values: off, client, server, both.",
+				MarkdownDescription: "Controls whether ascii mode data transfers are enabled. Acceptable values: off, client, server, both.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"off",
+						"client",
+						"server",
+						"both",
+					),
+				},
+			},
+			"chroot_exception_list": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Description:         "A list of users that are not chrooted when logging in.",
+				MarkdownDescription: "A list of users that are not chrooted when logging in.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"chroot_local_mode": schema.StringAttribute{
+				Description:         "If set to 'all', all local users will be (by default) placed in a chroot() jail in their home directory after login. If set to 'all-with-exceptions', all local users except those listed in the chroot exception list will be placed in a chroot() jail. If set to 'none', no local users will be chrooted by default. If set to 'none-with-exceptions', only the local users listed in the chroot exception list will be placed in a chroot() jail.",
+				MarkdownDescription: "If set to 'all', all local users will be (by default) placed in a chroot() jail in their home directory after login. If set to 'all-with-exceptions', all local users except those listed in the chroot exception list will be placed in a chroot() jail. If set to 'none', no local users will be chrooted by default. If set to 'none-with-exceptions', only the local users listed in the chroot exception list will be placed in a chroot() jail.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"all",
+						"none",
+						"all-with-exceptions",
+						"none-with-exceptions",
+					),
+				},
+			},
+			"connect_timeout": schema.Int64Attribute{
+				Description:         "The timeout, in seconds, for a remote client to respond to our PORT style data connection.",
+				MarkdownDescription: "The timeout, in seconds, for a remote client to respond to our PORT style data connection.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(30, 600),
+				},
+			},
+			"data_timeout": schema.Int64Attribute{
+				Description:         "The timeout, in seconds, which is roughly the maximum time we permit data transfers to stall for with no progress. If the timeout triggers, the remote client is kicked off.",
+				MarkdownDescription: "The timeout, in seconds, which is roughly the maximum time we permit data transfers to stall for with no progress. If the timeout triggers, the remote client is kicked off.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(30, 7200),
+				},
+			},
+			"denied_user_list": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Description:         "A list of users that will be denied access.",
+				MarkdownDescription: "A list of users that will be denied access.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"dirlist_localtime": schema.BoolAttribute{
+				Description:         "If enabled, display directory listings with the time in your local time zone. The default is to display GMT.",
+				MarkdownDescription: "If enabled, display directory listings with the time in your local time zone. The default is to display GMT.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"dirlist_names": schema.StringAttribute{
+				Description:         "When set to 'hide', all user and group information in directory listings will be displayed as 'ftp'. When set to 'textual', textual names are shown in the user and group fields of directory listings. When set to 'numeric', numeric IDs are show in the user and group fields of directory listings.",
+				MarkdownDescription: "When set to 'hide', all user and group information in directory listings will be displayed as 'ftp'. When set to 'textual', textual names are shown in the user and group fields of directory listings. When set to 'numeric', numeric IDs are show in the user and group fields of directory listings.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"numeric",
+						"textual",
+						"hide",
+					),
+				},
+			},
+			"file_create_perm": schema.Int64Attribute{
+				Description:         "The permissions with which uploaded files are created. Umasks are applied on top of this value.",
+				MarkdownDescription: "The permissions with which uploaded files are created. Umasks are applied on top of this value.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 511),
+				},
+			},
+			"limit_anon_passwords": schema.BoolAttribute{
+				Description:         "This field determines whether the anon_password_list is used.",
+				MarkdownDescription: "This field determines whether the anon_password_list is used.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"local_root_path": schema.StringAttribute{
+				Description:         "This option represents a directory in /ifs which vsftpd will try to change into after a local login.",
+				MarkdownDescription: "This option represents a directory in /ifs which vsftpd will try to change into after a local login.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^/ifs($|/)`), "must start with '/ifs'"),
+				},
+			},
+			"local_umask": schema.Int64Attribute{
+				Description:         "The value that the umask for file creation is set to for local users.",
+				MarkdownDescription: "The value that the umask for file creation is set to for local users.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 511),
+				},
+			},
+			"server_to_server": schema.BoolAttribute{
+				Description:         "If enabled, allow server-to-server (FXP) transfers.",
+				MarkdownDescription: "If enabled, allow server-to-server (FXP) transfers.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"service": schema.BoolAttribute{
+				Description:         "This field controls whether the FTP daemon is running.",
+				MarkdownDescription: "This field controls whether the FTP daemon is running.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"session_support": schema.BoolAttribute{
+				Description:         "If enabled, maintain login sessions for each user through Pluggable Authentication Modules (PAM).",
+				MarkdownDescription: "If enabled, maintain login sessions for each user through Pluggable Authentication Modules (PAM).",
+				Optional:            true,
+				Computed:            true,
+			},
+			"session_timeout": schema.Int64Attribute{
+				Description:         "The timeout, in seconds, for an idle session. If the idle time expires the client will be disconnected.",
+				MarkdownDescription: "The timeout, in seconds, for an idle session. If the idle time expires the client will be disconnected.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(30, 7200),
+				},
+			},
+			"user_config_dir": schema.StringAttribute{
+				Description:         "Specifies the directory where per-user config overrides can be found.",
+				MarkdownDescription: "Specifies the directory where per-user config overrides can be found.",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *FtpSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *FtpSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating FTP Settings resource...")
+
+	var plan models.FtpSettingsModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var toUpdate powerscale.V3FtpSettingsExtended
+	// Get param from tf input
+	err := helper.ReadFromState(ctx, &plan, &toUpdate)
+	if err != nil {
+		errStr := constants.UpdateFtpSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error updating ftp settings",
+			fmt.Sprintf("Could not read ftp settings param with error: %s", message),
+		)
+		return
+	}
+
+	err = helper.UpdateFtpSettings(ctx, r.client, toUpdate)
+	if err != nil {
+		errStr := constants.UpdateFtpSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error updating ftp settings",
+			message,
+		)
+		return
+	}
+
+	settings, err := helper.GetFtpSettings(ctx, r.client)
+	if err != nil {
+		errStr := constants.ReadFtpSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading ftp settings", message)
+		return
+	}
+
+	var state models.FtpSettingsModel
+	err = helper.CopyFieldsToNonNestedModel(ctx, settings.GetSettings(), &state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error copying fields of ftp settings resource",
+			err.Error(),
+		)
+		return
+	}
+	state.ID = types.StringValue("ftp_settings")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Create ftp settings resource")
+}
+
+// Read reads the resource state.
+func (r *FtpSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading FTP Settings resource")
+
+	var state models.FtpSettingsModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := helper.GetFtpSettings(ctx, r.client)
+	if err != nil {
+		errStr := constants.ReadFtpSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading ftp settings", message)
+		return
+	}
+
+	err = helper.CopyFieldsToNonNestedModel(ctx, settings.GetSettings(), &state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error copying fields of ftp settings resource",
+			err.Error(),
+		)
+		return
+	}
+	state.ID = types.StringValue("ftp_settings")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Read ftp settings resource")
+}
+
+// Update updates the resource state.
+func (r *FtpSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating FTP Settings resource...")
+
+	var plan models.FtpSettingsModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.FtpSettingsModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var toUpdate powerscale.V3FtpSettingsExtended
+	// Get param from tf input
+	err := helper.ReadFromState(ctx, &plan, &toUpdate)
+	if err != nil {
+		errStr := constants.UpdateFtpSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error updating ftp settings",
+			fmt.Sprintf("Could not read ftp settings param with error: %s", message),
+		)
+		return
+	}
+
+	err = helper.UpdateFtpSettings(ctx, r.client, toUpdate)
+	if err != nil {
+		errStr := constants.UpdateFtpSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error updating ftp settings",
+			message,
+		)
+		return
+	}
+
+	settings, err := helper.GetFtpSettings(ctx, r.client)
+	if err != nil {
+		errStr := constants.ReadFtpSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading ftp settings", message)
+		return
+	}
+
+	err = helper.CopyFieldsToNonNestedModel(ctx, settings.GetSettings(), &state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error copying fields of ftp settings resource",
+			err.Error(),
+		)
+		return
+	}
+	state.ID = types.StringValue("ftp_settings")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Update ftp settings resource")
+}
+
+// Delete deletes the resource.
+func (r *FtpSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting FTP Settings resource")
+	var state models.FtpSettingsModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// FTP settings are the native functionality that cannot be deleted, so just remove state
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with Delete ftp settings resource")
+}
+
+// ImportState imports the resource state.
+func (r *FtpSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Importing FTP Settings resource")
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,248 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"github.com/bytedance/mockey"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccFtpSettingsImport(t *testing.T) {
+	var ftpSettings = "powerscale_ftp_settings.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + ftpSettingsResourceConfig,
+			},
+			// Import testing
+			{
+				ResourceName: ftpSettings,
+				ImportState:  true,
+				ExpectError:  nil,
+				ImportStateCheck: func(s []*terraform.InstanceState) error {
+					resource.TestCheckResourceAttrSet(ftpSettings, "id")
+					resource.TestCheckResourceAttrSet(ftpSettings, "accept_timeout")
+					resource.TestCheckResourceAttrSet(ftpSettings, "allow_anon_access")
+					resource.TestCheckResourceAttrSet(ftpSettings, "allow_local_access")
+					resource.TestCheckResourceAttrSet(ftpSettings, "chroot_local_mode")
+					resource.TestCheckResourceAttrSet(ftpSettings, "server_to_server")
+					resource.TestCheckResourceAttrSet(ftpSettings, "session_timeout")
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccFtpSettingsUpdate(t *testing.T) {
+	var ftpSettings = "powerscale_ftp_settings.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + ftpSettingsResourceConfig,
+			},
+			// Update and Read testing
+			{
+				Config: ProviderConfig + ftpSettingsUpdateResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(ftpSettings, "allow_anon_access", "true"),
+					resource.TestCheckResourceAttr(ftpSettings, "allow_anon_upload", "false"),
+					resource.TestCheckResourceAttr(ftpSettings, "chroot_local_mode", "all-with-exceptions"),
+					resource.TestCheckResourceAttr(ftpSettings, "chroot_exception_list.#", "1"),
+					resource.TestCheckResourceAttr(ftpSettings, "denied_user_list.#", "1"),
+					resource.TestCheckResourceAttr(ftpSettings, "server_to_server", "true"),
+					resource.TestCheckResourceAttr(ftpSettings, "session_timeout", "600"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: ProviderConfig + ftpSettingsUpdateRevertResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(ftpSettings, "allow_anon_access", "false"),
+					resource.TestCheckResourceAttr(ftpSettings, "allow_anon_upload", "true"),
+					resource.TestCheckResourceAttr(ftpSettings, "chroot_local_mode", "none"),
+					resource.TestCheckResourceAttr(ftpSettings, "chroot_exception_list.#", "0"),
+					resource.TestCheckResourceAttr(ftpSettings, "denied_user_list.#", "0"),
+					resource.TestCheckResourceAttr(ftpSettings, "server_to_server", "false"),
+					resource.TestCheckResourceAttr(ftpSettings, "session_timeout", "300"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFtpSettingsCreateMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetFtpSettings).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + ftpSettingsResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.UpdateFtpSettings).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + ftpSettingsResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.ReadFromState).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + ftpSettingsResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.CopyFieldsToNonNestedModel).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + ftpSettingsResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+		},
+	})
+}
+
+func TestAccFtpSettingsUpdateMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + ftpSettingsResourceConfig,
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetFtpSettings).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + ftpSettingsUpdateResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.UpdateFtpSettings).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + ftpSettingsUpdateResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.ReadFromState).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + ftpSettingsUpdateResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.CopyFieldsToNonNestedModel).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + ftpSettingsUpdateResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+		},
+	})
+}
+
+func TestAccFtpSettingsImportMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + ftpSettingsResourceConfig,
+			},
+			// Import and read Error testing
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.GetFtpSettings).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:            ProviderConfig + ftpSettingsResourceConfig,
+				ResourceName:      "powerscale_ftp_settings.test",
+				ImportState:       true,
+				ExpectError:       regexp.MustCompile(`.*mock error*.`),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+var ftpSettingsResourceConfig = `
+resource "powerscale_ftp_settings" "test" {
+
+}
+`
+
+var ftpSettingsUpdateResourceConfig = `
+resource "powerscale_ftp_settings" "test" {
+	allow_anon_access = true
+	allow_anon_upload = false
+	chroot_local_mode = "all-with-exceptions"
+	chroot_exception_list = ["tfacc_ftp_user"]
+	denied_user_list = ["tfacc_ftp_denied"]
+	server_to_server = true
+	session_timeout = 600
+}
+`
+
+var ftpSettingsUpdateRevertResourceConfig = `
+resource "powerscale_ftp_settings" "test" {
+	allow_anon_access = false
+	allow_anon_upload = true
+	chroot_local_mode = "none"
+	chroot_exception_list = []
+	denied_user_list = []
+	server_to_server = false
+	session_timeout = 300
+}
+`
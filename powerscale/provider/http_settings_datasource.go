@@ -0,0 +1,174 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &HTTPSettingsDataSource{}
+	_ datasource.DataSourceWithConfigure = &HTTPSettingsDataSource{}
+)
+
+// NewHTTPSettingsDataSource creates a new http settings data source.
+func NewHTTPSettingsDataSource() datasource.DataSource {
+	return &HTTPSettingsDataSource{}
+}
+
+// HTTPSettingsDataSource defines the data source implementation.
+type HTTPSettingsDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *HTTPSettingsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_http_settings"
+}
+
+// Schema describes the data source arguments.
+func (d *HTTPSettingsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This datasource is used to query the HTTP Settings from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Description:         "This datasource is used to query the HTTP Settings from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Id of HTTP Settings. Readonly. ",
+				MarkdownDescription: "Id of HTTP Settings. Readonly. ",
+			},
+			"access_control": schema.BoolAttribute{
+				Description:         "Enable Access Control Authentication.",
+				MarkdownDescription: "Enable Access Control Authentication.",
+				Computed:            true,
+			},
+			"basic_authentication": schema.BoolAttribute{
+				Description:         "Enable Basic Authentication.",
+				MarkdownDescription: "Enable Basic Authentication.",
+				Computed:            true,
+			},
+			"dav": schema.BoolAttribute{
+				Description:         "Enable WebDAV.",
+				MarkdownDescription: "Enable WebDAV.",
+				Computed:            true,
+			},
+			"enable_access_log": schema.BoolAttribute{
+				Description:         "Enable Apache Access Log.",
+				MarkdownDescription: "Enable Apache Access Log.",
+				Computed:            true,
+			},
+			"https": schema.BoolAttribute{
+				Description:         "Enable HTTPS.",
+				MarkdownDescription: "Enable HTTPS.",
+				Computed:            true,
+			},
+			"integrated_authentication": schema.BoolAttribute{
+				Description:         "Enable Integrated Authentication.",
+				MarkdownDescription: "Enable Integrated Authentication.",
+				Computed:            true,
+			},
+			"server_root": schema.StringAttribute{
+				Description:         "Document root directory. Must be within /ifs.",
+				MarkdownDescription: "Document root directory. Must be within /ifs.",
+				Computed:            true,
+			},
+			"service": schema.StringAttribute{
+				Description:         "Enable/disable the HTTP Service or redirect to WebUI. Acceptable values: enabled, disabled, redirect.",
+				MarkdownDescription: "Enable/disable the HTTP Service or redirect to WebUI. Acceptable values: enabled, disabled, redirect.",
+				Computed:            true,
+			},
+			"service_timeout": schema.Int64Attribute{
+				Description:         "Timeout in seconds for HTTP requests.",
+				MarkdownDescription: "Timeout in seconds for HTTP requests.",
+				Computed:            true,
+			},
+			"tls_min_version": schema.StringAttribute{
+				Description:         "Minimum TLS version accepted by the HTTPS service. Acceptable values: 1.2, 1.3.",
+				MarkdownDescription: "Minimum TLS version accepted by the HTTPS service. Acceptable values: 1.2, 1.3.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *HTTPSettingsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *HTTPSettingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading HTTP Settings data source ")
+
+	var settingsState models.HTTPSettingsModel
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &settingsState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpSettings, err := helper.GetHTTPSettings(ctx, d.client)
+
+	if err != nil {
+		errStr := constants.ReadHTTPSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error reading http settings",
+			message,
+		)
+		return
+	}
+
+	err = helper.CopyFields(ctx, httpSettings.GetSettings(), &settingsState)
+	if err != nil {
+		resp.Diagnostics.AddError("Error copying fields of http settings datasource", err.Error())
+		return
+	}
+
+	settingsState.ID = types.StringValue("http_settings")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &settingsState)...)
+	tflog.Info(ctx, "Done with Read HTTP Settings data source ")
+}
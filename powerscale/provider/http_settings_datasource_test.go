@@ -0,0 +1,72 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccHTTPSettingsDataSource(t *testing.T) {
+	var httpSettings = "data.powerscale_http_settings.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// read all testing
+			{
+				Config: ProviderConfig + httpSettingsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(httpSettings, "id"),
+					resource.TestCheckResourceAttrSet(httpSettings, "access_control"),
+					resource.TestCheckResourceAttrSet(httpSettings, "basic_authentication"),
+					resource.TestCheckResourceAttrSet(httpSettings, "dav"),
+					resource.TestCheckResourceAttrSet(httpSettings, "integrated_authentication"),
+					resource.TestCheckResourceAttrSet(httpSettings, "server_root"),
+					resource.TestCheckResourceAttrSet(httpSettings, "service"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccHTTPSettingsDataSourceErrorGetAll(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock(helper.GetHTTPSettings).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + httpSettingsDataSourceConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+		},
+	})
+}
+
+var httpSettingsDataSourceConfig = `
+data "powerscale_http_settings" "test" {
+}
+`
@@ -0,0 +1,359 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource              = &HTTPSettingsResource{}
+	_ resource.ResourceWithConfigure = &HTTPSettingsResource{}
+)
+
+// NewHTTPSettingsResource creates a new resource.
+func NewHTTPSettingsResource() resource.Resource {
+	return &HTTPSettingsResource{}
+}
+
+// HTTPSettingsResource defines the resource implementation.
+type HTTPSettingsResource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (r *HTTPSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_http_settings"
+}
+
+// Schema describes the data source arguments.
+func (r *HTTPSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `This resource is used to manage the HTTP Settings of PowerScale Array. We can Create, Update and Delete the HTTP Settings using this resource.  
+Note that, HTTP Settings is the native functionality of PowerScale. When creating the resource, we actually load HTTP Settings from PowerScale to the resource.`,
+		Description: `This resource is used to manage the HTTP Settings of PowerScale Array. We can Create, Update and Delete the HTTP Settings using this resource.  
+Note that, HTTP Settings is the native functionality of PowerScale. When creating the resource, we actually load HTTP Settings from PowerScale to the resource.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Id of HTTP Settings. Readonly. ",
+				MarkdownDescription: "Id of HTTP Settings. Readonly. ",
+			},
+			"access_control": schema.BoolAttribute{
+				Description:         "Enable Access Control Authentication.",
+				MarkdownDescription: "Enable Access Control Authentication.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"basic_authentication": schema.BoolAttribute{
+				Description:         "Enable Basic Authentication.",
+				MarkdownDescription: "Enable Basic Authentication.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"dav": schema.BoolAttribute{
+				Description:         "Enable WebDAV.",
+				MarkdownDescription: "Enable WebDAV.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"enable_access_log": schema.BoolAttribute{
+				Description:         "Enable Apache Access Log.",
+				MarkdownDescription: "Enable Apache Access Log.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"https": schema.BoolAttribute{
+				Description:         "Enable HTTPS.",
+				MarkdownDescription: "Enable HTTPS.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"integrated_authentication": schema.BoolAttribute{
+				Description:         "Enable Integrated Authentication.",
+				MarkdownDescription: "Enable Integrated Authentication.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"server_root": schema.StringAttribute{
+				Description:         "Document root directory. Must be within /ifs.",
+				MarkdownDescription: "Document root directory. Must be within /ifs.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^/ifs($|/)`), "must start with '/ifs'"),
+				},
+			},
+			"service": schema.StringAttribute{
+				Description:         "Enable/disable the HTTP Service or redirect to WebUI. Acceptable values: enabled, disabled, redirect.",
+				MarkdownDescription: "Enable/disable the HTTP Service or redirect to WebUI. Acceptable values: enabled, disabled, redirect.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"enabled",
+						"disabled",
+						"redirect",
+					),
+				},
+			},
+			"service_timeout": schema.Int64Attribute{
+				Description:         "Timeout in seconds for HTTP requests.",
+				MarkdownDescription: "Timeout in seconds for HTTP requests.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"tls_min_version": schema.StringAttribute{
+				Description:         "Minimum TLS version accepted by the HTTPS service. Acceptable values: 1.2, 1.3.",
+				MarkdownDescription: "Minimum TLS version accepted by the HTTPS service. Acceptable values: 1.2, 1.3.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"1.2",
+						"1.3",
+					),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *HTTPSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *HTTPSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating HTTP Settings resource...")
+
+	var plan models.HTTPSettingsModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var toUpdate powerscale.V3HttpSettingsExtended
+	// Get param from tf input
+	err := helper.ReadFromState(ctx, &plan, &toUpdate)
+	if err != nil {
+		errStr := constants.UpdateHTTPSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error updating http settings",
+			fmt.Sprintf("Could not read http settings param with error: %s", message),
+		)
+		return
+	}
+
+	err = helper.UpdateHTTPSettings(ctx, r.client, toUpdate)
+	if err != nil {
+		errStr := constants.UpdateHTTPSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error updating http settings",
+			message,
+		)
+		return
+	}
+
+	settings, err := helper.GetHTTPSettings(ctx, r.client)
+	if err != nil {
+		errStr := constants.ReadHTTPSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading http settings", message)
+		return
+	}
+
+	var state models.HTTPSettingsModel
+	err = helper.CopyFieldsToNonNestedModel(ctx, settings.GetSettings(), &state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error copying fields of http settings resource",
+			err.Error(),
+		)
+		return
+	}
+	state.ID = types.StringValue("http_settings")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Create http settings resource")
+}
+
+// Read reads the resource state.
+func (r *HTTPSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading HTTP Settings resource")
+
+	var state models.HTTPSettingsModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := helper.GetHTTPSettings(ctx, r.client)
+	if err != nil {
+		errStr := constants.ReadHTTPSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading http settings", message)
+		return
+	}
+
+	err = helper.CopyFieldsToNonNestedModel(ctx, settings.GetSettings(), &state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error copying fields of http settings resource",
+			err.Error(),
+		)
+		return
+	}
+	state.ID = types.StringValue("http_settings")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Read http settings resource")
+}
+
+// Update updates the resource state.
+func (r *HTTPSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating HTTP Settings resource...")
+
+	var plan models.HTTPSettingsModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.HTTPSettingsModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var toUpdate powerscale.V3HttpSettingsExtended
+	// Get param from tf input
+	err := helper.ReadFromState(ctx, &plan, &toUpdate)
+	if err != nil {
+		errStr := constants.UpdateHTTPSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error updating http settings",
+			fmt.Sprintf("Could not read http settings param with error: %s", message),
+		)
+		return
+	}
+
+	err = helper.UpdateHTTPSettings(ctx, r.client, toUpdate)
+	if err != nil {
+		errStr := constants.UpdateHTTPSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error updating http settings",
+			message,
+		)
+		return
+	}
+
+	settings, err := helper.GetHTTPSettings(ctx, r.client)
+	if err != nil {
+		errStr := constants.ReadHTTPSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading http settings", message)
+		return
+	}
+
+	err = helper.CopyFieldsToNonNestedModel(ctx, settings.GetSettings(), &state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error copying fields of http settings resource",
+			err.Error(),
+		)
+		return
+	}
+	state.ID = types.StringValue("http_settings")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Update http settings resource")
+}
+
+// Delete deletes the resource.
+func (r *HTTPSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting HTTP Settings resource")
+	var state models.HTTPSettingsModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// HTTP settings are the native functionality that cannot be deleted, so just remove state
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with Delete http settings resource")
+}
+
+// ImportState imports the resource state.
+func (r *HTTPSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Importing HTTP Settings resource")
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,248 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"github.com/bytedance/mockey"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccHTTPSettingsImport(t *testing.T) {
+	var httpSettings = "powerscale_http_settings.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + httpSettingsResourceConfig,
+			},
+			// Import testing
+			{
+				ResourceName: httpSettings,
+				ImportState:  true,
+				ExpectError:  nil,
+				ImportStateCheck: func(s []*terraform.InstanceState) error {
+					resource.TestCheckResourceAttrSet(httpSettings, "id")
+					resource.TestCheckResourceAttrSet(httpSettings, "access_control")
+					resource.TestCheckResourceAttrSet(httpSettings, "basic_authentication")
+					resource.TestCheckResourceAttrSet(httpSettings, "dav")
+					resource.TestCheckResourceAttrSet(httpSettings, "integrated_authentication")
+					resource.TestCheckResourceAttrSet(httpSettings, "server_root")
+					resource.TestCheckResourceAttrSet(httpSettings, "service")
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccHTTPSettingsUpdate(t *testing.T) {
+	var httpSettings = "powerscale_http_settings.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + httpSettingsResourceConfig,
+			},
+			// Update and Read testing
+			{
+				Config: ProviderConfig + httpSettingsUpdateResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(httpSettings, "access_control", "true"),
+					resource.TestCheckResourceAttr(httpSettings, "basic_authentication", "true"),
+					resource.TestCheckResourceAttr(httpSettings, "dav", "true"),
+					resource.TestCheckResourceAttr(httpSettings, "integrated_authentication", "false"),
+					resource.TestCheckResourceAttr(httpSettings, "server_root", "/ifs"),
+					resource.TestCheckResourceAttr(httpSettings, "service", "enabled"),
+					resource.TestCheckResourceAttr(httpSettings, "service_timeout", "60"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: ProviderConfig + httpSettingsUpdateRevertResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(httpSettings, "access_control", "false"),
+					resource.TestCheckResourceAttr(httpSettings, "basic_authentication", "false"),
+					resource.TestCheckResourceAttr(httpSettings, "dav", "false"),
+					resource.TestCheckResourceAttr(httpSettings, "integrated_authentication", "false"),
+					resource.TestCheckResourceAttr(httpSettings, "server_root", "/ifs"),
+					resource.TestCheckResourceAttr(httpSettings, "service", "redirect"),
+					resource.TestCheckResourceAttr(httpSettings, "service_timeout", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccHTTPSettingsCreateMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetHTTPSettings).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + httpSettingsResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.UpdateHTTPSettings).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + httpSettingsResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.ReadFromState).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + httpSettingsResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.CopyFieldsToNonNestedModel).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + httpSettingsResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+		},
+	})
+}
+
+func TestAccHTTPSettingsUpdateMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + httpSettingsResourceConfig,
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetHTTPSettings).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + httpSettingsUpdateResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.UpdateHTTPSettings).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + httpSettingsUpdateResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.ReadFromState).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + httpSettingsUpdateResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.CopyFieldsToNonNestedModel).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + httpSettingsUpdateResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+		},
+	})
+}
+
+func TestAccHTTPSettingsImportMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + httpSettingsResourceConfig,
+			},
+			// Import and read Error testing
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.GetHTTPSettings).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:            ProviderConfig + httpSettingsResourceConfig,
+				ResourceName:      "powerscale_http_settings.test",
+				ImportState:       true,
+				ExpectError:       regexp.MustCompile(`.*mock error*.`),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+var httpSettingsResourceConfig = `
+resource "powerscale_http_settings" "test" {
+
+}
+`
+
+var httpSettingsUpdateResourceConfig = `
+resource "powerscale_http_settings" "test" {
+	access_control = true
+	basic_authentication = true
+	dav = true
+	integrated_authentication = false
+	server_root = "/ifs"
+	service = "enabled"
+	service_timeout = 60
+}
+`
+
+var httpSettingsUpdateRevertResourceConfig = `
+resource "powerscale_http_settings" "test" {
+	access_control = false
+	basic_authentication = false
+	dav = false
+	integrated_authentication = false
+	server_root = "/ifs"
+	service = "redirect"
+	service_timeout = 0
+}
+`
@@ -0,0 +1,392 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NamespaceQueryDataSource{}
+
+// NewNamespaceQueryDataSource creates a new data source.
+func NewNamespaceQueryDataSource() datasource.DataSource {
+	return &NamespaceQueryDataSource{}
+}
+
+// NamespaceQueryDataSource defines the data source implementation.
+type NamespaceQueryDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *NamespaceQueryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_namespace_query"
+}
+
+// Schema describes the data source arguments.
+func (d *NamespaceQueryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This datasource is used to find the files and directories of the PowerScale namespace matching conditions on their system attributes" +
+			" (ex. size, owner or last modification time) and on their user-defined metadata attributes.",
+		Description: "This datasource is used to find the files and directories of the PowerScale namespace matching conditions on their system attributes" +
+			" (ex. size, owner or last modification time) and on their user-defined metadata attributes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier",
+				MarkdownDescription: "Identifier",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				Description:         "Path of the directory to query below, ex. /ifs/data.",
+				MarkdownDescription: "Path of the directory to query below, ex. `/ifs/data`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^/ifs($|/)`), "must start with '/ifs'"),
+				},
+			},
+			"result": schema.ListAttribute{
+				Description:         "Attributes to return for each match, ex. size, owner, last_modified or create_time. The name and the container_path are always returned. Defaults to name, container_path, type, size, owner, group, mode and last_modified.",
+				MarkdownDescription: "Attributes to return for each match, ex. `size`, `owner`, `last_modified` or `create_time`. The `name` and the `container_path` are always returned. Defaults to `name`, `container_path`, `type`, `size`, `owner`, `group`, `mode` and `last_modified`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"logic": schema.StringAttribute{
+				Description:         "How the conditions are combined, and or or. Defaults to and.",
+				MarkdownDescription: "How the conditions are combined, `and` or `or`. Defaults to `and`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("and", "or"),
+				},
+			},
+			"conditions": schema.ListNestedAttribute{
+				Description:         "Conditions the matches must satisfy. All the files and directories are returned when not set.",
+				MarkdownDescription: "Conditions the matches must satisfy. All the files and directories are returned when not set.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"attribute": schema.StringAttribute{
+							Description:         "Name of the attribute, ex. size, owner or last_modified, or name of the user-defined metadata attribute when namespace is user.",
+							MarkdownDescription: "Name of the attribute, ex. `size`, `owner` or `last_modified`, or name of the user-defined metadata attribute when `namespace` is `user`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"operator": schema.StringAttribute{
+							Description:         "Comparison operator, one of eq, ne, gt, ge, lt, le or like. The like operator accepts wildcards.",
+							MarkdownDescription: "Comparison operator, one of `eq`, `ne`, `gt`, `ge`, `lt`, `le` or `like`. The `like` operator accepts wildcards.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(helper.GetNamespaceQueryOperators()...),
+							},
+						},
+						"value": schema.StringAttribute{
+							Description:         "Value to compare the attribute with.",
+							MarkdownDescription: "Value to compare the attribute with.",
+							Required:            true,
+						},
+						"namespace": schema.StringAttribute{
+							Description:         "Namespace of the attribute, system for the system attributes or user for the user-defined metadata attributes. Defaults to system.",
+							MarkdownDescription: "Namespace of the attribute, `system` for the system attributes or `user` for the user-defined metadata attributes. Defaults to `system`.",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("system", helper.UserMetadataNamespace),
+							},
+						},
+					},
+				},
+			},
+			"max_depth": schema.Int64Attribute{
+				Description:         "Maximum depth of the directories to query below the path. All the levels are queried when not set.",
+				MarkdownDescription: "Maximum depth of the directories to query below the path. All the levels are queried when not set.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, math.MaxInt32),
+				},
+			},
+			"limit": schema.Int64Attribute{
+				Description:         "Maximum number of matches to return. All the matches are returned when not set.",
+				MarkdownDescription: "Maximum number of matches to return. All the matches are returned when not set.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"include_user_metadata": schema.BoolAttribute{
+				Description:         "Whether to return the user-defined metadata attributes of each match. This requires one more request per match.",
+				MarkdownDescription: "Whether to return the user-defined metadata attributes of each match. This requires one more request per match.",
+				Optional:            true,
+			},
+			"include_acl": schema.BoolAttribute{
+				Description:         "Whether to return the access control list of each match. This requires one more request per match.",
+				MarkdownDescription: "Whether to return the access control list of each match. This requires one more request per match.",
+				Optional:            true,
+			},
+			"matches": schema.ListNestedAttribute{
+				Description:         "Files and directories matching the conditions.",
+				MarkdownDescription: "Files and directories matching the conditions.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							Description:         "Absolute path of the match.",
+							MarkdownDescription: "Absolute path of the match.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							Description:         "Name of the match.",
+							MarkdownDescription: "Name of the match.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							Description:         "Type of the match, ex. container for directories or object for files. Null if not in the result attributes.",
+							MarkdownDescription: "Type of the match, ex. `container` for directories or `object` for files. Null if not in the result attributes.",
+							Computed:            true,
+						},
+						"size": schema.Int64Attribute{
+							Description:         "Size in bytes. Null if not in the result attributes.",
+							MarkdownDescription: "Size in bytes. Null if not in the result attributes.",
+							Computed:            true,
+						},
+						"owner": schema.StringAttribute{
+							Description:         "Owner name. Null if not in the result attributes.",
+							MarkdownDescription: "Owner name. Null if not in the result attributes.",
+							Computed:            true,
+						},
+						"group": schema.StringAttribute{
+							Description:         "Group name. Null if not in the result attributes.",
+							MarkdownDescription: "Group name. Null if not in the result attributes.",
+							Computed:            true,
+						},
+						"mode": schema.StringAttribute{
+							Description:         "POSIX mode. Null if not in the result attributes.",
+							MarkdownDescription: "POSIX mode. Null if not in the result attributes.",
+							Computed:            true,
+						},
+						"last_modified": schema.StringAttribute{
+							Description:         "Last modification time as returned by the cluster. Null if not in the result attributes.",
+							MarkdownDescription: "Last modification time as returned by the cluster. Null if not in the result attributes.",
+							Computed:            true,
+						},
+						"attributes": schema.MapAttribute{
+							Description:         "All the returned attributes as strings. Values that are not scalar are JSON encoded.",
+							MarkdownDescription: "All the returned attributes as strings. Values that are not scalar are JSON encoded.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"user_metadata": schema.MapAttribute{
+							Description:         "User-defined metadata attributes. Only set when include_user_metadata is true.",
+							MarkdownDescription: "User-defined metadata attributes. Only set when `include_user_metadata` is `true`.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"acl": schema.SingleNestedAttribute{
+							Description:         "Access control list of the match, as returned for a filesystem. Only set when include_acl is true.",
+							MarkdownDescription: "Access control list of the match, as returned for a filesystem. Only set when `include_acl` is `true`.",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"acl": schema.ListNestedAttribute{
+									Description:         "Access control entries.",
+									MarkdownDescription: "Access control entries.",
+									Computed:            true,
+									NestedObject: schema.NestedAttributeObject{
+										Attributes: map[string]schema.Attribute{
+											"access_rights": schema.ListAttribute{
+												Description:         "Access rights.",
+												MarkdownDescription: "Access rights.",
+												Computed:            true,
+												ElementType:         types.StringType,
+											},
+											"access_type": schema.StringAttribute{
+												Description:         "Access type.",
+												MarkdownDescription: "Access type.",
+												Computed:            true,
+											},
+											"inherit_flags": schema.ListAttribute{
+												Description:         "Inherit flags.",
+												MarkdownDescription: "Inherit flags.",
+												Computed:            true,
+												ElementType:         types.StringType,
+											},
+											"op": schema.StringAttribute{
+												Description:         "Operation.",
+												MarkdownDescription: "Operation.",
+												Computed:            true,
+											},
+											"trustee": schema.SingleNestedAttribute{
+												Description:         "Trustee of the entry.",
+												MarkdownDescription: "Trustee of the entry.",
+												Computed:            true,
+												Attributes: map[string]schema.Attribute{
+													"id": schema.StringAttribute{
+														Description:         "Identifier.",
+														MarkdownDescription: "Identifier.",
+														Computed:            true,
+													},
+													"name": schema.StringAttribute{
+														Description:         "Name.",
+														MarkdownDescription: "Name.",
+														Computed:            true,
+													},
+													"type": schema.StringAttribute{
+														Description:         "Type.",
+														MarkdownDescription: "Type.",
+														Computed:            true,
+													},
+												},
+											},
+										},
+									},
+								},
+								"action": schema.StringAttribute{
+									Description:         "ACL action.",
+									MarkdownDescription: "ACL action.",
+									Computed:            true,
+								},
+								"authoritative": schema.StringAttribute{
+									Description:         "Whether the access rights (acl) or the POSIX mode (mode) are authoritative.",
+									MarkdownDescription: "Whether the access rights (`acl`) or the POSIX mode (`mode`) are authoritative.",
+									Computed:            true,
+								},
+								"group": schema.SingleNestedAttribute{
+									Description:         "Group of the match.",
+									MarkdownDescription: "Group of the match.",
+									Computed:            true,
+									Attributes: map[string]schema.Attribute{
+										"id": schema.StringAttribute{
+											Description:         "Identifier.",
+											MarkdownDescription: "Identifier.",
+											Computed:            true,
+										},
+										"name": schema.StringAttribute{
+											Description:         "Name.",
+											MarkdownDescription: "Name.",
+											Computed:            true,
+										},
+										"type": schema.StringAttribute{
+											Description:         "Type.",
+											MarkdownDescription: "Type.",
+											Computed:            true,
+										},
+									},
+								},
+								"mode": schema.StringAttribute{
+									Description:         "POSIX mode.",
+									MarkdownDescription: "POSIX mode.",
+									Computed:            true,
+								},
+								"owner": schema.SingleNestedAttribute{
+									Description:         "Owner of the match.",
+									MarkdownDescription: "Owner of the match.",
+									Computed:            true,
+									Attributes: map[string]schema.Attribute{
+										"id": schema.StringAttribute{
+											Description:         "Identifier.",
+											MarkdownDescription: "Identifier.",
+											Computed:            true,
+										},
+										"name": schema.StringAttribute{
+											Description:         "Name.",
+											MarkdownDescription: "Name.",
+											Computed:            true,
+										},
+										"type": schema.StringAttribute{
+											Description:         "Type.",
+											MarkdownDescription: "Type.",
+											Computed:            true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *NamespaceQueryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *NamespaceQueryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading namespace query data source")
+
+	var state models.NamespaceQueryDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	matches, err := helper.GetNamespaceQueryMatches(ctx, d.client, state)
+	if err != nil {
+		errStr := constants.ReadNamespaceQueryErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error querying the namespace", message)
+		return
+	}
+	if matches == nil {
+		matches = []models.NamespaceQueryMatchModel{}
+	}
+
+	state.Matches = matches
+	state.ID = types.StringValue("namespace_query_datasource")
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading namespace query data source")
+}
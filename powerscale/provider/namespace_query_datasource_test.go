@@ -0,0 +1,195 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNamespaceQueryDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// query by system attribute
+			{
+				Config: ProviderConfig + NamespaceQueryDataSourceNameConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerscale_namespace_query.test", "matches.#", "1"),
+					resource.TestCheckResourceAttr("data.powerscale_namespace_query.test", "matches.0.path", "/ifs/tfacc_namespace_query"),
+					resource.TestCheckResourceAttr("data.powerscale_namespace_query.test", "matches.0.name", "tfacc_namespace_query"),
+					resource.TestCheckResourceAttr("data.powerscale_namespace_query.test", "matches.0.type", "container"),
+					resource.TestCheckResourceAttr("data.powerscale_namespace_query.test", "matches.0.owner", "root"),
+					resource.TestCheckResourceAttrSet("data.powerscale_namespace_query.test", "matches.0.last_modified"),
+					resource.TestCheckResourceAttr("data.powerscale_namespace_query.test", "matches.0.user_metadata.team", "storage"),
+					resource.TestCheckResourceAttr("data.powerscale_namespace_query.test", "matches.0.acl.owner.name", "root"),
+					resource.TestCheckResourceAttr("data.powerscale_namespace_query.test", "matches.0.acl.group.name", "wheel"),
+				),
+			},
+			// query by user-defined metadata attribute
+			{
+				Config: ProviderConfig + NamespaceQueryDataSourceUserMetadataConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerscale_namespace_query.test", "matches.#", "1"),
+					resource.TestCheckResourceAttr("data.powerscale_namespace_query.test", "matches.0.path", "/ifs/tfacc_namespace_query"),
+					resource.TestCheckResourceAttrSet("data.powerscale_namespace_query.test", "matches.0.attributes.size"),
+					resource.TestCheckNoResourceAttr("data.powerscale_namespace_query.test", "matches.0.user_metadata.team"),
+					resource.TestCheckNoResourceAttr("data.powerscale_namespace_query.test", "matches.0.acl.owner.name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNamespaceQueryDataSourceConfigErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + NamespaceQueryDataSourceInvalidOperatorConfig,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Value Match*.`),
+			},
+			{
+				Config:      ProviderConfig + NamespaceQueryDataSourceInvalidPathConfig,
+				ExpectError: regexp.MustCompile(`.*must start with '/ifs'*.`),
+			},
+			{
+				Config:      ProviderConfig + NamespaceQueryDataSourceInvalidMaxDepthConfig,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Value*.`),
+			},
+		},
+	})
+}
+
+func TestAccNamespaceQueryDataSourceGettingErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.GetNamespaceQueryMatches).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + NamespaceQueryDataSourceNameConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + NamespaceQueryDataSourceNameConfig,
+			},
+		},
+	})
+}
+
+var NamespaceQueryResourceConfig = `
+resource "powerscale_filesystem" "namespace_query_test" {
+	directory_path = "/ifs"
+	name           = "tfacc_namespace_query"
+	group = {
+		id   = "GID:0"
+		name = "wheel"
+		type = "group"
+	}
+	owner = {
+		id   = "UID:0",
+		name = "root",
+		type = "user"
+	}
+	user_metadata = {
+		team = "storage"
+	}
+}
+`
+
+var NamespaceQueryDataSourceNameConfig = NamespaceQueryResourceConfig + `
+data "powerscale_namespace_query" "test" {
+	depends_on = [powerscale_filesystem.namespace_query_test]
+	path       = "/ifs"
+	max_depth  = 1
+	conditions = [
+		{
+			attribute = "name"
+			operator  = "eq"
+			value     = "tfacc_namespace_query"
+		},
+	]
+	include_user_metadata = true
+	include_acl           = true
+}
+`
+
+var NamespaceQueryDataSourceUserMetadataConfig = NamespaceQueryResourceConfig + `
+data "powerscale_namespace_query" "test" {
+	depends_on = [powerscale_filesystem.namespace_query_test]
+	path       = "/ifs"
+	result     = ["size"]
+	logic      = "and"
+	conditions = [
+		{
+			attribute = "team"
+			operator  = "eq"
+			value     = "storage"
+			namespace = "user"
+		},
+		{
+			attribute = "name"
+			operator  = "like"
+			value     = "tfacc_namespace*"
+		},
+	]
+	limit = 10
+}
+`
+
+var NamespaceQueryDataSourceInvalidOperatorConfig = `
+data "powerscale_namespace_query" "test" {
+	path = "/ifs"
+	conditions = [
+		{
+			attribute = "size"
+			operator  = "greater"
+			value     = "1024"
+		},
+	]
+}
+`
+
+var NamespaceQueryDataSourceInvalidPathConfig = `
+data "powerscale_namespace_query" "test" {
+	path = "/data"
+}
+`
+
+var NamespaceQueryDataSourceInvalidMaxDepthConfig = `
+data "powerscale_namespace_query" "test" {
+	path      = "/ifs"
+	max_depth = 2147483648
+}
+`
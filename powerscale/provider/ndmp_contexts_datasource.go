@@ -0,0 +1,177 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NdmpContextsDataSource{}
+
+// NewNdmpContextsDataSource creates a new data source.
+func NewNdmpContextsDataSource() datasource.DataSource {
+	return &NdmpContextsDataSource{}
+}
+
+// NdmpContextsDataSource defines the data source implementation.
+type NdmpContextsDataSource struct {
+	client *client.Client
+}
+
+// ndmpContextAttributes returns the attributes of an NDMP context, shared by the contexts data sources.
+func ndmpContextAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "The identifier of the context.",
+			MarkdownDescription: "The identifier of the context.",
+			Computed:            true,
+		},
+		"type": schema.StringAttribute{
+			Description:         "The type of the context, one of backup, restore or bre (restartable backup).",
+			MarkdownDescription: "The type of the context, one of `backup`, `restore` or `bre` (restartable backup).",
+			Computed:            true,
+		},
+		"path": schema.StringAttribute{
+			Description:         "The path the context operates on.",
+			MarkdownDescription: "The path the context operates on.",
+			Computed:            true,
+		},
+		"status": schema.StringAttribute{
+			Description:         "The status of the context.",
+			MarkdownDescription: "The status of the context.",
+			Computed:            true,
+		},
+		"start_time": schema.Int64Attribute{
+			Description:         "The time the context was started, in seconds since the epoch.",
+			MarkdownDescription: "The time the context was started, in seconds since the epoch.",
+			Computed:            true,
+		},
+		"last_used_time": schema.Int64Attribute{
+			Description:         "The time the context was last used, in seconds since the epoch.",
+			MarkdownDescription: "The time the context was last used, in seconds since the epoch.",
+			Computed:            true,
+		},
+	}
+}
+
+// Metadata describes the data source arguments.
+func (d *NdmpContextsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ndmp_contexts"
+}
+
+// Schema describes the data source arguments.
+func (d *NdmpContextsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This datasource is used to query the NDMP backup and restore contexts from PowerScale array. " +
+			"The NDMP contexts are available from OneFS 8.2.0.",
+		Description: "This datasource is used to query the NDMP backup and restore contexts from PowerScale array. " +
+			"The NDMP contexts are available from OneFS 8.2.0.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier",
+				MarkdownDescription: "Identifier",
+				Computed:            true,
+			},
+			"ndmp_contexts": schema.ListNestedAttribute{
+				Description:         "List of NDMP contexts.",
+				MarkdownDescription: "List of NDMP contexts.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: ndmpContextAttributes(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Filter the contexts by type, one of backup or restore. Defaults to both.",
+						MarkdownDescription: "Filter the contexts by type, one of `backup` or `restore`. Defaults to both.",
+						Validators: []validator.String{
+							stringvalidator.OneOf("backup", "restore"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *NdmpContextsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *NdmpContextsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading NDMP contexts data source")
+
+	var state models.NdmpContextsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	contextType := ""
+	if state.Filter != nil {
+		contextType = state.Filter.Type.ValueString()
+	}
+	contexts, err := helper.GetNdmpContexts(ctx, d.client, contextType)
+	if err != nil {
+		errStr := constants.ReadNdmpContextsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading NDMP contexts", message)
+		return
+	}
+
+	state.Contexts = contexts
+	state.ID = types.StringValue("ndmp_contexts_datasource")
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading NDMP contexts data source")
+}
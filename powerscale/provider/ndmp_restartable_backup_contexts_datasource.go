@@ -0,0 +1,121 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NdmpRestartableBackupContextsDataSource{}
+
+// NewNdmpRestartableBackupContextsDataSource creates a new data source.
+func NewNdmpRestartableBackupContextsDataSource() datasource.DataSource {
+	return &NdmpRestartableBackupContextsDataSource{}
+}
+
+// NdmpRestartableBackupContextsDataSource defines the data source implementation.
+type NdmpRestartableBackupContextsDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *NdmpRestartableBackupContextsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ndmp_restartable_backup_contexts"
+}
+
+// Schema describes the data source arguments.
+func (d *NdmpRestartableBackupContextsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This datasource is used to query the NDMP restartable backup (BRE) contexts from PowerScale array, " +
+			"which hold the checkpoints an interrupted backup is resumed from. The NDMP contexts are available from OneFS 8.2.0.",
+		Description: "This datasource is used to query the NDMP restartable backup (BRE) contexts from PowerScale array, " +
+			"which hold the checkpoints an interrupted backup is resumed from. The NDMP contexts are available from OneFS 8.2.0.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier",
+				MarkdownDescription: "Identifier",
+				Computed:            true,
+			},
+			"ndmp_restartable_backup_contexts": schema.ListNestedAttribute{
+				Description:         "List of NDMP restartable backup contexts.",
+				MarkdownDescription: "List of NDMP restartable backup contexts.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: ndmpContextAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *NdmpRestartableBackupContextsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *NdmpRestartableBackupContextsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading NDMP restartable backup contexts data source")
+
+	var state models.NdmpRestartableBackupContextsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	contexts, err := helper.GetNdmpRestartableBackupContexts(ctx, d.client)
+	if err != nil {
+		errStr := constants.ReadNdmpContextsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading NDMP restartable backup contexts", message)
+		return
+	}
+
+	state.Contexts = contexts
+	state.ID = types.StringValue("ndmp_restartable_backup_contexts_datasource")
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading NDMP restartable backup contexts data source")
+}
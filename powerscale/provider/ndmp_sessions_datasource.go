@@ -0,0 +1,187 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NdmpSessionsDataSource{}
+
+// NewNdmpSessionsDataSource creates a new data source.
+func NewNdmpSessionsDataSource() datasource.DataSource {
+	return &NdmpSessionsDataSource{}
+}
+
+// NdmpSessionsDataSource defines the data source implementation.
+type NdmpSessionsDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *NdmpSessionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ndmp_sessions"
+}
+
+// Schema describes the data source arguments.
+func (d *NdmpSessionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This datasource is used to query the NDMP sessions running on PowerScale array.",
+		Description:         "This datasource is used to query the NDMP sessions running on PowerScale array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier",
+				MarkdownDescription: "Identifier",
+				Computed:            true,
+			},
+			"ndmp_sessions": schema.ListNestedAttribute{
+				Description:         "List of NDMP sessions.",
+				MarkdownDescription: "List of NDMP sessions.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description:         "The identifier of the session, in the <lnn>.<session> format.",
+							MarkdownDescription: "The identifier of the session, in the `<lnn>.<session>` format.",
+							Computed:            true,
+						},
+						"data_state": schema.StringAttribute{
+							Description:         "The state of the data server of the session.",
+							MarkdownDescription: "The state of the data server of the session.",
+							Computed:            true,
+						},
+						"data_operation": schema.StringAttribute{
+							Description:         "The operation of the data server of the session, ex. backup or restore.",
+							MarkdownDescription: "The operation of the data server of the session, ex. backup or restore.",
+							Computed:            true,
+						},
+						"data_bytes_moved": schema.Int64Attribute{
+							Description:         "The number of bytes processed by the data server of the session.",
+							MarkdownDescription: "The number of bytes processed by the data server of the session.",
+							Computed:            true,
+						},
+						"mover_state": schema.StringAttribute{
+							Description:         "The state of the mover of the session.",
+							MarkdownDescription: "The state of the mover of the session.",
+							Computed:            true,
+						},
+						"mover_mode": schema.StringAttribute{
+							Description:         "The mode of the mover of the session.",
+							MarkdownDescription: "The mode of the mover of the session.",
+							Computed:            true,
+						},
+						"client_address": schema.StringAttribute{
+							Description:         "The address of the data management application of the session.",
+							MarkdownDescription: "The address of the data management application of the session.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"lnn": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Filter the sessions by logical node number. Defaults to all the nodes.",
+						MarkdownDescription: "Filter the sessions by logical node number. Defaults to all the nodes.",
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"session": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Filter the sessions by session identifier.",
+						MarkdownDescription: "Filter the sessions by session identifier.",
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *NdmpSessionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *NdmpSessionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading NDMP sessions data source")
+
+	var state models.NdmpSessionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := models.NdmpSessionsFilterType{}
+	if state.Filter != nil {
+		filter = *state.Filter
+	}
+	sessions, err := helper.GetNdmpSessions(ctx, d.client, filter.Lnn.ValueString(), filter.Session.ValueString())
+	if err != nil {
+		errStr := constants.ReadNdmpSessionsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading NDMP sessions", message)
+		return
+	}
+
+	state.Sessions = []models.NdmpSessionModel{}
+	for _, session := range sessions {
+		state.Sessions = append(state.Sessions, helper.NdmpSessionMapper(session))
+	}
+
+	state.ID = types.StringValue("ndmp_sessions_datasource")
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading NDMP sessions data source")
+}
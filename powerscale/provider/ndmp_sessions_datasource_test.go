@@ -0,0 +1,89 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNdmpSessionsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// read all
+			{
+				Config: ProviderConfig + NdmpSessionsDataSourceAllConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerscale_ndmp_sessions.all", "ndmp_sessions.#"),
+				),
+			},
+			// read with filter
+			{
+				Config: ProviderConfig + NdmpSessionsDataSourceFilterConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerscale_ndmp_sessions.filtering", "ndmp_sessions.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNdmpSessionsDataSourceErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.GetNdmpSessions).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + NdmpSessionsDataSourceAllConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + NdmpSessionsDataSourceAllConfig,
+			},
+		},
+	})
+}
+
+var NdmpSessionsDataSourceAllConfig = `
+data "powerscale_ndmp_sessions" "all" {
+}
+`
+
+var NdmpSessionsDataSourceFilterConfig = `
+data "powerscale_ndmp_sessions" "filtering" {
+	filter {
+		lnn = "1"
+	}
+}
+`
@@ -0,0 +1,342 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource              = &NdmpSettingsResource{}
+	_ resource.ResourceWithConfigure = &NdmpSettingsResource{}
+)
+
+// NewNdmpSettingsResource creates a new resource.
+func NewNdmpSettingsResource() resource.Resource {
+	return &NdmpSettingsResource{}
+}
+
+// NdmpSettingsResource defines the resource implementation.
+type NdmpSettingsResource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (r *NdmpSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ndmp_settings"
+}
+
+// Schema describes the data source arguments.
+func (r *NdmpSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `This resource is used to manage the NDMP Settings of PowerScale Array. We can Create, Update and Delete the NDMP Settings using this resource.  
+Note that, NDMP Settings is the native functionality of PowerScale. When creating the resource, we actually load NDMP Settings from PowerScale to the resource.`,
+		Description: `This resource is used to manage the NDMP Settings of PowerScale Array. We can Create, Update and Delete the NDMP Settings using this resource.  
+Note that, NDMP Settings is the native functionality of PowerScale. When creating the resource, we actually load NDMP Settings from PowerScale to the resource.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Id of NDMP Settings. Readonly. ",
+				MarkdownDescription: "Id of NDMP Settings. Readonly. ",
+			},
+			"bre_max_num_contexts": schema.Int64Attribute{
+				Description:         "The maximum number of restartable backup contexts.",
+				MarkdownDescription: "The maximum number of restartable backup contexts.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"dma": schema.StringAttribute{
+				Description:         "The name of the data management application, ex. generic, atempo, bakbone, commvault, emc, symantec, tivoli, symantec-netbackup or symantec-backupexec.",
+				MarkdownDescription: "The name of the data management application, ex. generic, atempo, bakbone, commvault, emc, symantec, tivoli, symantec-netbackup or symantec-backupexec.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"enable_redirector": schema.BoolAttribute{
+				Description:         "Enable or disable the NDMP redirector, which balances the NDMP sessions across the nodes.",
+				MarkdownDescription: "Enable or disable the NDMP redirector, which balances the NDMP sessions across the nodes.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"enable_throttler": schema.BoolAttribute{
+				Description:         "Enable or disable the NDMP throttler, which slows down backups when the CPU usage is high.",
+				MarkdownDescription: "Enable or disable the NDMP throttler, which slows down backups when the CPU usage is high.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"msb_context_retention_duration": schema.Int64Attribute{
+				Description:         "The number of seconds a multi-stream backup context is retained.",
+				MarkdownDescription: "The number of seconds a multi-stream backup context is retained.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"msr_context_retention_duration": schema.Int64Attribute{
+				Description:         "The number of seconds a multi-stream restore context is retained.",
+				MarkdownDescription: "The number of seconds a multi-stream restore context is retained.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"port": schema.Int64Attribute{
+				Description:         "The port the NDMP service listens on.",
+				MarkdownDescription: "The port the NDMP service listens on.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"service": schema.BoolAttribute{
+				Description:         "True if the NDMP service is enabled.",
+				MarkdownDescription: "True if the NDMP service is enabled.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"throttler_cpu_threshold": schema.Int64Attribute{
+				Description:         "The threshold of CPU usage, in percent, above which the NDMP throttler slows down backups.",
+				MarkdownDescription: "The threshold of CPU usage, in percent, above which the NDMP throttler slows down backups.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *NdmpSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *NdmpSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating NDMP Settings resource...")
+
+	var plan models.NdmpSettingsModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var toUpdate powerscale.V3NdmpSettingsGlobalExtended
+	// Get param from tf input
+	err := helper.ReadFromState(ctx, &plan, &toUpdate)
+	if err != nil {
+		errStr := constants.UpdateNdmpSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error updating ndmp settings",
+			fmt.Sprintf("Could not read ndmp settings param with error: %s", message),
+		)
+		return
+	}
+
+	err = helper.UpdateNdmpSettings(ctx, r.client, toUpdate)
+	if err != nil {
+		errStr := constants.UpdateNdmpSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error updating ndmp settings",
+			message,
+		)
+		return
+	}
+
+	settings, err := helper.GetNdmpSettings(ctx, r.client)
+	if err != nil {
+		errStr := constants.ReadNdmpSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading ndmp settings", message)
+		return
+	}
+
+	var state models.NdmpSettingsModel
+	err = helper.CopyFieldsToNonNestedModel(ctx, settings.GetSettings(), &state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error copying fields of ndmp settings resource",
+			err.Error(),
+		)
+		return
+	}
+	state.ID = types.StringValue("ndmp_settings")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Create ndmp settings resource")
+}
+
+// Read reads the resource state.
+func (r *NdmpSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading NDMP Settings resource")
+
+	var state models.NdmpSettingsModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := helper.GetNdmpSettings(ctx, r.client)
+	if err != nil {
+		errStr := constants.ReadNdmpSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading ndmp settings", message)
+		return
+	}
+
+	err = helper.CopyFieldsToNonNestedModel(ctx, settings.GetSettings(), &state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error copying fields of ndmp settings resource",
+			err.Error(),
+		)
+		return
+	}
+	state.ID = types.StringValue("ndmp_settings")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Read ndmp settings resource")
+}
+
+// Update updates the resource state.
+func (r *NdmpSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating NDMP Settings resource...")
+
+	var plan models.NdmpSettingsModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.NdmpSettingsModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var toUpdate powerscale.V3NdmpSettingsGlobalExtended
+	// Get param from tf input
+	err := helper.ReadFromState(ctx, &plan, &toUpdate)
+	if err != nil {
+		errStr := constants.UpdateNdmpSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error updating ndmp settings",
+			fmt.Sprintf("Could not read ndmp settings param with error: %s", message),
+		)
+		return
+	}
+
+	err = helper.UpdateNdmpSettings(ctx, r.client, toUpdate)
+	if err != nil {
+		errStr := constants.UpdateNdmpSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error updating ndmp settings",
+			message,
+		)
+		return
+	}
+
+	settings, err := helper.GetNdmpSettings(ctx, r.client)
+	if err != nil {
+		errStr := constants.ReadNdmpSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading ndmp settings", message)
+		return
+	}
+
+	err = helper.CopyFieldsToNonNestedModel(ctx, settings.GetSettings(), &state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error copying fields of ndmp settings resource",
+			err.Error(),
+		)
+		return
+	}
+	state.ID = types.StringValue("ndmp_settings")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Update ndmp settings resource")
+}
+
+// Delete deletes the resource.
+func (r *NdmpSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting NDMP Settings resource")
+	var state models.NdmpSettingsModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// NDMP settings are the native functionality that cannot be deleted, so just remove state
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with Delete ndmp settings resource")
+}
+
+// ImportState imports the resource state.
+func (r *NdmpSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Importing NDMP Settings resource")
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
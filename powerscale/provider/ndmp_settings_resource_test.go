@@ -0,0 +1,234 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"github.com/bytedance/mockey"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccNdmpSettingsImport(t *testing.T) {
+	var ndmpSettings = "powerscale_ndmp_settings.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + ndmpSettingsResourceConfig,
+			},
+			// Import testing
+			{
+				ResourceName: ndmpSettings,
+				ImportState:  true,
+				ExpectError:  nil,
+				ImportStateCheck: func(s []*terraform.InstanceState) error {
+					resource.TestCheckResourceAttrSet(ndmpSettings, "id")
+					resource.TestCheckResourceAttrSet(ndmpSettings, "dma")
+					resource.TestCheckResourceAttrSet(ndmpSettings, "port")
+					resource.TestCheckResourceAttrSet(ndmpSettings, "service")
+					resource.TestCheckResourceAttrSet(ndmpSettings, "bre_max_num_contexts")
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccNdmpSettingsUpdate(t *testing.T) {
+	var ndmpSettings = "powerscale_ndmp_settings.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + ndmpSettingsResourceConfig,
+			},
+			// Update and Read testing
+			{
+				Config: ProviderConfig + ndmpSettingsUpdateResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(ndmpSettings, "dma", "generic"),
+					resource.TestCheckResourceAttr(ndmpSettings, "enable_throttler", "true"),
+					resource.TestCheckResourceAttr(ndmpSettings, "throttler_cpu_threshold", "60"),
+					resource.TestCheckResourceAttr(ndmpSettings, "bre_max_num_contexts", "128"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: ProviderConfig + ndmpSettingsUpdateRevertResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(ndmpSettings, "dma", "emc"),
+					resource.TestCheckResourceAttr(ndmpSettings, "enable_throttler", "false"),
+					resource.TestCheckResourceAttr(ndmpSettings, "throttler_cpu_threshold", "50"),
+					resource.TestCheckResourceAttr(ndmpSettings, "bre_max_num_contexts", "64"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNdmpSettingsCreateMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetNdmpSettings).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + ndmpSettingsResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.UpdateNdmpSettings).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + ndmpSettingsResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.ReadFromState).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + ndmpSettingsResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.CopyFieldsToNonNestedModel).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + ndmpSettingsResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+		},
+	})
+}
+
+func TestAccNdmpSettingsUpdateMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + ndmpSettingsResourceConfig,
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetNdmpSettings).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + ndmpSettingsUpdateResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.UpdateNdmpSettings).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + ndmpSettingsUpdateResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.ReadFromState).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + ndmpSettingsUpdateResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.CopyFieldsToNonNestedModel).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + ndmpSettingsUpdateResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+		},
+	})
+}
+
+func TestAccNdmpSettingsImportMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + ndmpSettingsResourceConfig,
+			},
+			// Import and read Error testing
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.GetNdmpSettings).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:            ProviderConfig + ndmpSettingsResourceConfig,
+				ResourceName:      "powerscale_ndmp_settings.test",
+				ImportState:       true,
+				ExpectError:       regexp.MustCompile(`.*mock error*.`),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+var ndmpSettingsResourceConfig = `
+resource "powerscale_ndmp_settings" "test" {
+
+}
+`
+
+var ndmpSettingsUpdateResourceConfig = `
+resource "powerscale_ndmp_settings" "test" {
+	dma = "generic"
+	enable_throttler = true
+	throttler_cpu_threshold = 60
+	bre_max_num_contexts = 128
+}
+`
+
+var ndmpSettingsUpdateRevertResourceConfig = `
+resource "powerscale_ndmp_settings" "test" {
+	dma = "emc"
+	enable_throttler = false
+	throttler_cpu_threshold = 50
+	bre_max_num_contexts = 64
+}
+`
@@ -0,0 +1,261 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &NdmpUserResource{}
+	_ resource.ResourceWithConfigure   = &NdmpUserResource{}
+	_ resource.ResourceWithImportState = &NdmpUserResource{}
+)
+
+// NewNdmpUserResource is a helper function to simplify the provider implementation.
+func NewNdmpUserResource() resource.Resource {
+	return &NdmpUserResource{}
+}
+
+// NdmpUserResource defines the resource implementation.
+type NdmpUserResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *NdmpUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ndmp_user"
+}
+
+// Schema describes the resource arguments.
+func (r *NdmpUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the NDMP users of PowerScale Array, which the data management applications authenticate as. " +
+			"We can Create, Update and Delete the NDMP user using this resource. We can also import an existing NDMP user from PowerScale array. " +
+			"The password is write-only: it is never stored in the Terraform state, and is pushed again to PowerScale only when `password_version` changes.",
+		Description: "This resource is used to manage the NDMP users of PowerScale Array, which the data management applications authenticate as. " +
+			"We can Create, Update and Delete the NDMP user using this resource. We can also import an existing NDMP user from PowerScale array. " +
+			"The password is write-only: it is never stored in the Terraform state, and is pushed again to PowerScale only when password_version changes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "The ID of the NDMP user, same as its name.",
+				MarkdownDescription: "The ID of the NDMP user, same as its name.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description:         "The name of the NDMP user.",
+				MarkdownDescription: "The name of the NDMP user.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"password": schema.StringAttribute{
+				Description:         "The password of the NDMP user. Write-only, requires Terraform 1.11 or later.",
+				MarkdownDescription: "The password of the NDMP user. Write-only, requires Terraform 1.11 or later.",
+				Required:            true,
+				WriteOnly:           true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"password_version": schema.Int64Attribute{
+				Description:         "Change this value to push the password to PowerScale again, ex. after rotating it.",
+				MarkdownDescription: "Change this value to push the password to PowerScale again, ex. after rotating it.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *NdmpUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *NdmpUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating NDMP user resource...")
+	var plan models.NdmpUserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// write-only values are only available in the configuration
+	var password types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.CreateNdmpUser(ctx, r.client, plan.Name.ValueString(), password.ValueString()); err != nil {
+		errStr := constants.CreateNdmpUserErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating ndmp user", message)
+		return
+	}
+
+	found, err := r.readUser(ctx, &plan)
+	if err != nil {
+		errStr := constants.ReadNdmpUserErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading ndmp user", message)
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Error reading ndmp user",
+			fmt.Sprintf("NDMP user %s was not found after creation", plan.Name.ValueString()))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with Create NDMP user resource")
+}
+
+// Read reads the resource state.
+func (r *NdmpUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading NDMP user resource..")
+	var state models.NdmpUserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, err := r.readUser(ctx, &state)
+	if err != nil {
+		errStr := constants.ReadNdmpUserErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading ndmp user", message)
+		return
+	}
+	if !found {
+		// the user was removed outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Read NDMP user resource")
+}
+
+// Update updates the resource state.
+func (r *NdmpUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating NDMP user resource...")
+	var plan, state models.NdmpUserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the password can not be compared with the state, so it is pushed again only when asked for
+	if !plan.PasswordVersion.Equal(state.PasswordVersion) {
+		var password types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := helper.UpdateNdmpUserPassword(ctx, r.client, plan.Name.ValueString(), password.ValueString()); err != nil {
+			errStr := constants.UpdateNdmpUserErrorMsg + "with error: "
+			message := helper.GetErrorString(err, errStr)
+			resp.Diagnostics.AddError("Error updating ndmp user", message)
+			return
+		}
+	}
+
+	plan.ID = state.ID
+	plan.Password = types.StringNull()
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with Update NDMP user resource")
+}
+
+// Delete deletes the resource.
+func (r *NdmpUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting NDMP user resource...")
+	var state models.NdmpUserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.DeleteNdmpUser(ctx, r.client, state.Name.ValueString()); err != nil {
+		errStr := constants.DeleteNdmpUserErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error deleting ndmp user", message)
+		return
+	}
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with Delete NDMP user resource")
+}
+
+// ImportState imports the resource state.
+func (r *NdmpUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Importing NDMP user resource...")
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+	tflog.Info(ctx, "Done with Import NDMP user resource")
+}
+
+// readUser refreshes the model from PowerScale, returning false when the user does not exist.
+func (r *NdmpUserResource) readUser(ctx context.Context, model *models.NdmpUserResourceModel) (bool, error) {
+	user, err := helper.GetNdmpUser(ctx, r.client, model.Name.ValueString())
+	if err != nil || user == nil {
+		return false, err
+	}
+	model.ID = types.StringValue(user.GetName())
+	model.Name = types.StringValue(user.GetName())
+	model.Password = types.StringNull()
+	return true, nil
+}
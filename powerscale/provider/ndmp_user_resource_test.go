@@ -0,0 +1,145 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNdmpUserResource(t *testing.T) {
+	var ndmpUser = "powerscale_ndmp_user.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + NdmpUserResourceConfig(1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(ndmpUser, "id", "tfacc_ndmp_user"),
+					resource.TestCheckResourceAttr(ndmpUser, "name", "tfacc_ndmp_user"),
+					resource.TestCheckNoResourceAttr(ndmpUser, "password"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            ndmpUser,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password_version"},
+			},
+			// Update password testing
+			{
+				Config: ProviderConfig + NdmpUserResourceConfig(2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(ndmpUser, "password_version", "2"),
+					resource.TestCheckNoResourceAttr(ndmpUser, "password"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNdmpUserResourceErrorCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.CreateNdmpUser).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + NdmpUserResourceConfig(1),
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetNdmpUser).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + NdmpUserResourceConfig(1),
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+		},
+	})
+}
+
+func TestAccNdmpUserResourceErrorUpdate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + NdmpUserResourceConfig(1),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.UpdateNdmpUserPassword).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + NdmpUserResourceConfig(2),
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetNdmpUser).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + NdmpUserResourceConfig(2),
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.DeleteNdmpUser).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + NdmpUserResourceConfig(1),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + NdmpUserResourceConfig(1),
+			},
+		},
+	})
+}
+
+// NdmpUserResourceConfig returns the config of an NDMP user, pushing the password again when passwordVersion changes.
+func NdmpUserResourceConfig(passwordVersion int) string {
+	return fmt.Sprintf(`
+resource "powerscale_ndmp_user" "test" {
+	name             = "tfacc_ndmp_user"
+	password         = "Password123!"
+	password_version = %d
+}
+`, passwordVersion)
+}
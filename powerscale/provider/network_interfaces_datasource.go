@@ -0,0 +1,223 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NetworkInterfacesDataSource{}
+
+// NewNetworkInterfacesDataSource creates a new data source.
+func NewNetworkInterfacesDataSource() datasource.DataSource {
+	return &NetworkInterfacesDataSource{}
+}
+
+// NetworkInterfacesDataSource defines the data source implementation.
+type NetworkInterfacesDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *NetworkInterfacesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_interfaces"
+}
+
+// Schema describes the data source arguments.
+func (d *NetworkInterfacesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This datasource is used to query the network interfaces of the nodes of PowerScale array, with their link state, speed, MTU and aggregation membership. " +
+			"The interface names and LNNs can be used for the `ifaces` of a network pool.",
+		Description: "This datasource is used to query the network interfaces of the nodes of PowerScale array, with their link state, speed, MTU and aggregation membership. " +
+			"The interface names and LNNs can be used for the ifaces of a network pool.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier",
+				MarkdownDescription: "Identifier",
+				Computed:            true,
+			},
+			"network_interfaces": schema.ListNestedAttribute{
+				Description:         "List of network interfaces.",
+				MarkdownDescription: "List of network interfaces.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description:         "Unique interface ID.",
+							MarkdownDescription: "Unique interface ID.",
+							Computed:            true,
+						},
+						"lnn": schema.Int64Attribute{
+							Description:         "Logical Node Number (LNN) of the node of the interface.",
+							MarkdownDescription: "Logical Node Number (LNN) of the node of the interface.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							Description:         "Name of the interface, as referenced by the ifaces of a network pool, ex. ext-1 or 40gige-agg-1.",
+							MarkdownDescription: "Name of the interface, as referenced by the `ifaces` of a network pool, ex. `ext-1` or `40gige-agg-1`.",
+							Computed:            true,
+						},
+						"nic_name": schema.StringAttribute{
+							Description:         "Name of the interface in the operating system of the node.",
+							MarkdownDescription: "Name of the interface in the operating system of the node.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							Description:         "Type of the interface.",
+							MarkdownDescription: "Type of the interface.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							Description:         "Link state of the interface, ex. up or no_carrier.",
+							MarkdownDescription: "Link state of the interface, ex. `up` or `no_carrier`.",
+							Computed:            true,
+						},
+						"speed": schema.Int64Attribute{
+							Description:         "Link speed of the interface in Mbps.",
+							MarkdownDescription: "Link speed of the interface in Mbps.",
+							Computed:            true,
+						},
+						"mtu": schema.Int64Attribute{
+							Description:         "Maximum transmission unit of the interface.",
+							MarkdownDescription: "Maximum transmission unit of the interface.",
+							Computed:            true,
+						},
+						"macaddr": schema.StringAttribute{
+							Description:         "MAC address of the interface.",
+							MarkdownDescription: "MAC address of the interface.",
+							Computed:            true,
+						},
+						"ip_addrs": schema.ListAttribute{
+							Description:         "IP addresses assigned to the interface.",
+							MarkdownDescription: "IP addresses assigned to the interface.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"aggregate": schema.StringAttribute{
+							Description:         "Name of the aggregated interface this interface is a member of, null when it is not aggregated.",
+							MarkdownDescription: "Name of the aggregated interface this interface is a member of, null when it is not aggregated.",
+							Computed:            true,
+						},
+						"aggregation_members": schema.ListAttribute{
+							Description:         "Names of the interfaces of the same node aggregated by this interface, empty when it is not an aggregated interface.",
+							MarkdownDescription: "Names of the interfaces of the same node aggregated by this interface, empty when it is not an aggregated interface.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"lnns": schema.SetAttribute{
+						Optional:            true,
+						ElementType:         types.Int64Type,
+						Description:         "Filter the interfaces by the Logical Node Number (LNN) of their node.",
+						MarkdownDescription: "Filter the interfaces by the Logical Node Number (LNN) of their node.",
+					},
+					"types": schema.SetAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						Description:         "Filter the interfaces by type.",
+						MarkdownDescription: "Filter the interfaces by type.",
+					},
+					"statuses": schema.SetAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						Description:         "Filter the interfaces by link state, ex. up or no_carrier.",
+						MarkdownDescription: "Filter the interfaces by link state, ex. `up` or `no_carrier`.",
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *NetworkInterfacesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *NetworkInterfacesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading network interfaces data source")
+
+	var state models.NetworkInterfacesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	interfaces, err := helper.GetNetworkInterfaces(ctx, d.client)
+	if err != nil {
+		errStr := constants.ReadNetworkInterfacesErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading network interfaces", message)
+		return
+	}
+
+	var all []models.NetworkInterfaceModel
+	for _, iface := range interfaces {
+		all = append(all, helper.NetworkInterfaceMapper(iface))
+	}
+	// aggregation membership is resolved before filtering, so that it does not depend on the filter
+	helper.SetNetworkInterfaceAggregation(all)
+
+	state.Interfaces, err = helper.FilterNetworkInterfaces(ctx, all, state.Filter)
+	if err != nil {
+		resp.Diagnostics.AddError("Error filtering network interfaces", err.Error())
+		return
+	}
+	if state.Interfaces == nil {
+		state.Interfaces = []models.NetworkInterfaceModel{}
+	}
+
+	state.ID = types.StringValue("network_interfaces_datasource")
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading network interfaces data source")
+}
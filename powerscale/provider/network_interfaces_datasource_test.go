@@ -0,0 +1,119 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNetworkInterfacesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// read all
+			{
+				Config: ProviderConfig + NetworkInterfacesDataSourceAllConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerscale_network_interfaces.all", "network_interfaces.#"),
+					resource.TestCheckResourceAttrSet("data.powerscale_network_interfaces.all", "network_interfaces.0.lnn"),
+					resource.TestCheckResourceAttrSet("data.powerscale_network_interfaces.all", "network_interfaces.0.name"),
+					resource.TestCheckResourceAttrSet("data.powerscale_network_interfaces.all", "network_interfaces.0.status"),
+					resource.TestCheckResourceAttrSet("data.powerscale_network_interfaces.all", "network_interfaces.0.mtu"),
+				),
+			},
+			// read with filter
+			{
+				Config: ProviderConfig + NetworkInterfacesDataSourceFilterConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerscale_network_interfaces.filtering", "network_interfaces.#"),
+					resource.TestCheckResourceAttr("data.powerscale_network_interfaces.filtering", "network_interfaces.0.lnn", "1"),
+					resource.TestCheckResourceAttr("data.powerscale_network_interfaces.filtering", "network_interfaces.0.status", "up"),
+				),
+			},
+			// filter with no match
+			{
+				Config: ProviderConfig + NetworkInterfacesDataSourceNoMatchConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerscale_network_interfaces.none", "network_interfaces.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkInterfacesDataSourceGettingErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.GetNetworkInterfaces).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + NetworkInterfacesDataSourceAllConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.FilterNetworkInterfaces).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + NetworkInterfacesDataSourceAllConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + NetworkInterfacesDataSourceAllConfig,
+			},
+		},
+	})
+}
+
+var NetworkInterfacesDataSourceAllConfig = `
+data "powerscale_network_interfaces" "all" {
+}
+`
+
+var NetworkInterfacesDataSourceFilterConfig = `
+data "powerscale_network_interfaces" "filtering" {
+	filter {
+		lnns     = [1]
+		statuses = ["up"]
+	}
+}
+`
+
+var NetworkInterfacesDataSourceNoMatchConfig = `
+data "powerscale_network_interfaces" "none" {
+	filter {
+		types = ["invalid"]
+	}
+}
+`
@@ -125,6 +125,31 @@ func (d *NetworkPoolDataSource) Schema(ctx context.Context, req datasource.Schem
 								},
 							},
 						},
+						"ip_assignments": schema.ListNestedAttribute{
+							Description:         "IP addresses of this pool currently assigned to each member interface.",
+							MarkdownDescription: "IP addresses of this pool currently assigned to each member interface.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"lnn": schema.Int64Attribute{
+										Description:         "Logical Node Number (LNN) of a node.",
+										MarkdownDescription: "Logical Node Number (LNN) of a node.",
+										Computed:            true,
+									},
+									"iface": schema.StringAttribute{
+										Description:         "A string that defines an interface name.",
+										MarkdownDescription: "A string that defines an interface name.",
+										Computed:            true,
+									},
+									"ip_addrs": schema.ListAttribute{
+										Description:         "IP addresses of this pool assigned to the interface.",
+										MarkdownDescription: "IP addresses of this pool assigned to the interface.",
+										Computed:            true,
+										ElementType:         types.StringType,
+									},
+								},
+							},
+						},
 						"name": schema.StringAttribute{
 							Description:         "The name of the pool. It must be unique throughout the given subnet.It's a required field with POST method.",
 							MarkdownDescription: "The name of the pool. It must be unique throughout the given subnet.It's a required field with POST method.",
@@ -352,6 +377,22 @@ func (d *NetworkPoolDataSource) Read(ctx context.Context, req datasource.ReadReq
 		networkPools = append(networkPools, networkPool)
 	}
 
+	if len(networkPools) > 0 {
+		interfaces, err := helper.GetNetworkInterfaces(ctx, d.client)
+		if err != nil {
+			errStr := constants.ReadNetworkInterfacesErrorMsg + "with error: "
+			message := helper.GetErrorString(err, errStr)
+			resp.Diagnostics.AddError(
+				"Error getting the IP assignments of network pools",
+				message,
+			)
+			return
+		}
+		for i := range networkPools {
+			networkPools[i].IPAssignments = helper.GetNetworkPoolIPAssignments(networkPools[i].ID.ValueString(), interfaces)
+		}
+	}
+
 	state.NetworkPools = networkPools
 
 	// filter network pools by names
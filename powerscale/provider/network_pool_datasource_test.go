@@ -43,6 +43,7 @@ func TestAccNetworkPoolDataSourceNames(t *testing.T) {
 					resource.TestCheckResourceAttr(poolTerraformName, "network_pools_details.0.subnet", "subnet0"),
 					resource.TestCheckResourceAttr(poolTerraformName, "network_pools_details.0.sc_ttl", "0"),
 					resource.TestCheckResourceAttrSet(poolTerraformName, "network_pools_details.0.ifaces.#"),
+					resource.TestCheckResourceAttrSet(poolTerraformName, "network_pools_details.0.ip_assignments.#"),
 				),
 			},
 		},
@@ -146,6 +147,25 @@ func TestAccNetworkPoolDataSourceMappingErr(t *testing.T) {
 	})
 }
 
+func TestAccNetworkPoolDataSourceInterfacesErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.GetNetworkInterfaces).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + PoolDataSourceNamesConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+		},
+	})
+}
+
 var PoolDataSourceNamesConfig = `
 data "powerscale_networkpool" "test" {
 	filter {
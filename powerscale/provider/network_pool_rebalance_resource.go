@@ -0,0 +1,262 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource              = &NetworkPoolRebalanceResource{}
+	_ resource.ResourceWithConfigure = &NetworkPoolRebalanceResource{}
+)
+
+// NewNetworkPoolRebalanceResource creates a new resource.
+func NewNetworkPoolRebalanceResource() resource.Resource {
+	return &NetworkPoolRebalanceResource{}
+}
+
+// NetworkPoolRebalanceResource defines the resource implementation.
+type NetworkPoolRebalanceResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *NetworkPoolRebalanceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_networkpool_rebalance"
+}
+
+// Schema describes the resource arguments.
+func (r *NetworkPoolRebalanceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to trigger a manual SmartConnect rebalance of the IP addresses of a network pool on PowerScale array. " +
+			"Creating this resource rebalances the pool and waits until the IP assignments of the pool stabilize, changing `triggers` rebalances the pool again. " +
+			"Destroying this resource only removes it from the state.",
+		Description: "This resource is used to trigger a manual SmartConnect rebalance of the IP addresses of a network pool on PowerScale array. " +
+			"Creating this resource rebalances the pool and waits until the IP assignments of the pool stabilize, changing `triggers` rebalances the pool again. " +
+			"Destroying this resource only removes it from the state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Unique Pool ID.",
+				MarkdownDescription: "Unique Pool ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"groupnet": schema.StringAttribute{
+				Description:         "Name of the groupnet the pool belongs to.",
+				MarkdownDescription: "Name of the groupnet the pool belongs to.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"subnet": schema.StringAttribute{
+				Description:         "Name of the subnet the pool belongs to.",
+				MarkdownDescription: "Name of the subnet the pool belongs to.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"pool": schema.StringAttribute{
+				Description:         "Name of the pool to rebalance.",
+				MarkdownDescription: "Name of the pool to rebalance.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description:         "Arbitrary map of values that, when changed, rebalances the pool again.",
+				MarkdownDescription: "Arbitrary map of values that, when changed, rebalances the pool again.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_timeout": schema.Int64Attribute{
+				Description:         "Time in seconds to wait for the IP assignments of the pool to stabilize after the rebalance. Unchanged IP assignments are only accepted 30 seconds after the rebalance.",
+				MarkdownDescription: "Time in seconds to wait for the IP assignments of the pool to stabilize after the rebalance. Unchanged IP assignments are only accepted 30 seconds after the rebalance.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(300),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+			"ip_assignments": schema.ListNestedAttribute{
+				Description:         "IP addresses of the pool assigned to each member interface once the rebalance settled.",
+				MarkdownDescription: "IP addresses of the pool assigned to each member interface once the rebalance settled.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"lnn": schema.Int64Attribute{
+							Description:         "Logical Node Number (LNN) of a node.",
+							MarkdownDescription: "Logical Node Number (LNN) of a node.",
+							Computed:            true,
+						},
+						"iface": schema.StringAttribute{
+							Description:         "A string that defines an interface name.",
+							MarkdownDescription: "A string that defines an interface name.",
+							Computed:            true,
+						},
+						"ip_addrs": schema.ListAttribute{
+							Description:         "IP addresses of the pool assigned to the interface.",
+							MarkdownDescription: "IP addresses of the pool assigned to the interface.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *NetworkPoolRebalanceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create rebalances the network pool and waits for its IP assignments to stabilize.
+func (r *NetworkPoolRebalanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Rebalancing network pool")
+
+	var plan models.NetworkPoolRebalanceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupnet, subnet, pool := plan.Groupnet.ValueString(), plan.Subnet.ValueString(), plan.Pool.ValueString()
+	poolID := fmt.Sprintf("%s.%s.%s", groupnet, subnet, pool)
+
+	// the assignments before the rebalance tell whether it already took effect
+	interfaces, err := helper.GetNetworkInterfaces(ctx, r.client)
+	if err != nil {
+		errStr := constants.ReadNetworkInterfacesErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading the IP assignments of the network pool", message)
+		return
+	}
+	before := helper.GetNetworkPoolIPAssignments(poolID, interfaces)
+
+	if err := helper.RebalanceNetworkPool(ctx, r.client, groupnet, subnet, pool); err != nil {
+		errStr := constants.RebalanceNetworkPoolErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error rebalancing network pool", message)
+		return
+	}
+
+	timeout := time.Duration(plan.WaitTimeout.ValueInt64()) * time.Second
+	assignments, err := helper.WaitForNetworkPoolIPAssignments(ctx, r.client, poolID, before, timeout)
+	if err != nil {
+		errStr := constants.RebalanceNetworkPoolErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error waiting for the network pool rebalance to settle", message)
+		return
+	}
+
+	ipAssignments, diags := helper.NetworkPoolIPAssignmentsValue(ctx, assignments)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(poolID)
+	plan.IPAssignments = ipAssignments
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with rebalancing network pool")
+}
+
+// Read keeps the state, the IP assignments are recorded as they were right after the rebalance.
+func (r *NetworkPoolRebalanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.NetworkPoolRebalanceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only records the new wait timeout, every other configurable attribute requires replacement.
+func (r *NetworkPoolRebalanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.NetworkPoolRebalanceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the resource from the state, a rebalance cannot be undone.
+func (r *NetworkPoolRebalanceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting network pool rebalance")
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,136 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNetworkPoolRebalanceResource(t *testing.T) {
+	var rebalanceTerraformName = "powerscale_networkpool_rebalance.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + NetworkPoolRebalanceResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rebalanceTerraformName, "id", "groupnet0.subnet0.pool0"),
+					resource.TestCheckResourceAttr(rebalanceTerraformName, "wait_timeout", "300"),
+					resource.TestCheckResourceAttrSet(rebalanceTerraformName, "ip_assignments.#"),
+				),
+			},
+			// changing the wait timeout does not rebalance again
+			{
+				Config: ProviderConfig + NetworkPoolRebalanceResourceUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rebalanceTerraformName, "id", "groupnet0.subnet0.pool0"),
+					resource.TestCheckResourceAttr(rebalanceTerraformName, "wait_timeout", "600"),
+				),
+			},
+			// changing the triggers rebalances again
+			{
+				Config: ProviderConfig + NetworkPoolRebalanceResourceTriggersConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rebalanceTerraformName, "triggers.run", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkPoolRebalanceResourceErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + NetworkPoolRebalanceResourceInvalidConfig,
+				ExpectError: regexp.MustCompile(`.*Error rebalancing network pool*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.RebalanceNetworkPool).Return(nil).Build()
+					FunctionMocker2 = mockey.Mock(helper.WaitForNetworkPoolIPAssignments).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + NetworkPoolRebalanceResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker2.Release()
+				},
+				Config:      ProviderConfig + NetworkPoolRebalanceResourceInvalidConfig,
+				ExpectError: regexp.MustCompile(`.*Error rebalancing network pool*.`),
+			},
+		},
+	})
+}
+
+var NetworkPoolRebalanceResourceConfig = `
+resource "powerscale_networkpool_rebalance" "test" {
+	groupnet = "groupnet0"
+	subnet   = "subnet0"
+	pool     = "pool0"
+	triggers = {
+		run = "1"
+	}
+}
+`
+
+var NetworkPoolRebalanceResourceUpdateConfig = `
+resource "powerscale_networkpool_rebalance" "test" {
+	groupnet     = "groupnet0"
+	subnet       = "subnet0"
+	pool         = "pool0"
+	wait_timeout = 600
+	triggers = {
+		run = "1"
+	}
+}
+`
+
+var NetworkPoolRebalanceResourceTriggersConfig = `
+resource "powerscale_networkpool_rebalance" "test" {
+	groupnet     = "groupnet0"
+	subnet       = "subnet0"
+	pool         = "pool0"
+	wait_timeout = 600
+	triggers = {
+		run = "2"
+	}
+}
+`
+
+var NetworkPoolRebalanceResourceInvalidConfig = `
+resource "powerscale_networkpool_rebalance" "test" {
+	groupnet = "groupnet0"
+	subnet   = "subnet0"
+	pool     = "tfaccInvalidPool"
+}
+`
@@ -0,0 +1,240 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NfsClientsDataSource{}
+
+// NewNfsClientsDataSource creates a new data source.
+func NewNfsClientsDataSource() datasource.DataSource {
+	return &NfsClientsDataSource{}
+}
+
+// NfsClientsDataSource defines the data source implementation.
+type NfsClientsDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *NfsClientsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nfs_clients"
+}
+
+// Schema describes the data source arguments.
+func (d *NfsClientsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This datasource is used to query the NFS clients active on the nodes of PowerScale array, from the client statistics of the cluster. " +
+			"It can be used to check that no client is still connected before an export is retired. " +
+			"NFS being stateless for NFSv3, a client is listed while it sends requests to the cluster.",
+		Description: "This datasource is used to query the NFS clients active on the nodes of PowerScale array, from the client statistics of the cluster. " +
+			"It can be used to check that no client is still connected before an export is retired. " +
+			"NFS being stateless for NFSv3, a client is listed while it sends requests to the cluster.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier",
+				MarkdownDescription: "Identifier",
+				Computed:            true,
+			},
+			"nfs_clients": schema.ListNestedAttribute{
+				Description:         "List of NFS clients.",
+				MarkdownDescription: "List of NFS clients.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"node": schema.Int64Attribute{
+							Description:         "The logical node number the client is connected to.",
+							MarkdownDescription: "The logical node number the client is connected to.",
+							Computed:            true,
+						},
+						"protocol": schema.StringAttribute{
+							Description:         "The NFS protocol version used by the client.",
+							MarkdownDescription: "The NFS protocol version used by the client.",
+							Computed:            true,
+						},
+						"remote_address": schema.StringAttribute{
+							Description:         "The IP address of the client.",
+							MarkdownDescription: "The IP address of the client.",
+							Computed:            true,
+						},
+						"remote_name": schema.StringAttribute{
+							Description:         "The host name of the client.",
+							MarkdownDescription: "The host name of the client.",
+							Computed:            true,
+						},
+						"local_address": schema.StringAttribute{
+							Description:         "The IP address of the node the client is connected to.",
+							MarkdownDescription: "The IP address of the node the client is connected to.",
+							Computed:            true,
+						},
+						"local_name": schema.StringAttribute{
+							Description:         "The host name of the node the client is connected to.",
+							MarkdownDescription: "The host name of the node the client is connected to.",
+							Computed:            true,
+						},
+						"user": schema.StringAttribute{
+							Description:         "The user of the client.",
+							MarkdownDescription: "The user of the client.",
+							Computed:            true,
+						},
+						"ops": schema.Float64Attribute{
+							Description:         "The rate of operations of the client, per second.",
+							MarkdownDescription: "The rate of operations of the client, per second.",
+							Computed:            true,
+						},
+						"time": schema.Int64Attribute{
+							Description:         "The time of the statistics, in seconds since the epoch.",
+							MarkdownDescription: "The time of the statistics, in seconds since the epoch.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"nodes": schema.SetAttribute{
+						Optional:            true,
+						ElementType:         types.Int64Type,
+						Description:         "Filter the clients by logical node number of the node they are connected to.",
+						MarkdownDescription: "Filter the clients by logical node number of the node they are connected to.",
+						Validators: []validator.Set{
+							setvalidator.ValueInt64sAre(int64validator.AtLeast(1)),
+						},
+					},
+					"zone": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Filter the clients by access zone, from the IP address ranges of the network pools of the zone.",
+						MarkdownDescription: "Filter the clients by access zone, from the IP address ranges of the network pools of the zone.",
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"user": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Filter the clients by user.",
+						MarkdownDescription: "Filter the clients by user.",
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"client_address": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Filter the clients by IP address or by network in CIDR notation, ex. 10.10.0.0/24.",
+						MarkdownDescription: "Filter the clients by IP address or by network in CIDR notation, ex. `10.10.0.0/24`.",
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *NfsClientsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *NfsClientsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading NFS clients data source")
+
+	var state models.NfsClientsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := models.NfsClientsFilterType{}
+	if state.Filter != nil {
+		filter = *state.Filter
+	}
+	var nodes []int64
+	if !filter.Nodes.IsNull() && !filter.Nodes.IsUnknown() {
+		resp.Diagnostics.Append(filter.Nodes.ElementsAs(ctx, &nodes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	clients, err := helper.GetNfsClients(ctx, d.client, nodes)
+	if err != nil {
+		errStr := constants.ReadNfsClientsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading NFS clients", message)
+		return
+	}
+
+	var ranges []powerscale.V12GroupnetSubnetScServiceAddr
+	if zone := filter.Zone.ValueString(); zone != "" {
+		ranges, err = helper.GetAccessZoneAddressRanges(ctx, d.client, zone)
+		if err != nil {
+			errStr := constants.ReadNfsClientsErrorMsg + "with error: "
+			message := helper.GetErrorString(err, errStr)
+			resp.Diagnostics.AddError("Error reading the network pools of the access zone", message)
+			return
+		}
+	}
+
+	state.Clients = []models.NfsClientModel{}
+	for _, nfsClient := range helper.FilterNfsClients(clients, ranges, filter.User.ValueString(), filter.ClientAddress.ValueString()) {
+		state.Clients = append(state.Clients, helper.NfsClientMapper(nfsClient))
+	}
+
+	state.ID = types.StringValue("nfs_clients_datasource")
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading NFS clients data source")
+}
@@ -0,0 +1,99 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNfsClientsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// read all
+			{
+				Config: ProviderConfig + NfsClientsDataSourceAllConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerscale_nfs_clients.all", "nfs_clients.#"),
+				),
+			},
+			// read with filter
+			{
+				Config: ProviderConfig + NfsClientsDataSourceFilterConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerscale_nfs_clients.filtering", "nfs_clients.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNfsClientsDataSourceErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.GetNfsClients).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + NfsClientsDataSourceAllConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetAccessZoneAddressRanges).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + NfsClientsDataSourceFilterConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + NfsClientsDataSourceAllConfig,
+			},
+		},
+	})
+}
+
+var NfsClientsDataSourceAllConfig = `
+data "powerscale_nfs_clients" "all" {
+}
+`
+
+var NfsClientsDataSourceFilterConfig = `
+data "powerscale_nfs_clients" "filtering" {
+	filter {
+		nodes          = [1]
+		zone           = "System"
+		client_address = "192.0.2.0/24"
+	}
+}
+`
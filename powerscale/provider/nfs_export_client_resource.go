@@ -0,0 +1,334 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &NfsExportClientResource{}
+	_ resource.ResourceWithConfigure   = &NfsExportClientResource{}
+	_ resource.ResourceWithImportState = &NfsExportClientResource{}
+)
+
+// NewNfsExportClientResource is a helper function to simplify the provider implementation.
+func NewNfsExportClientResource() resource.Resource {
+	return &NfsExportClientResource{}
+}
+
+// NfsExportClientResource defines the resource implementation.
+type NfsExportClientResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *NfsExportClientResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nfs_export_client"
+}
+
+// Schema describes the resource arguments.
+func (r *NfsExportClientResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to add a single host, netgroup or CIDR to a client list of an existing NFS export of PowerScale Array, identified by its id or by its zone and path." +
+			" The other clients of the list are kept, so that several configurations can grant access to the same export, and only this client is removed on destroy." +
+			" The client lists of the `powerscale_nfs_export` resource are authoritative and remove the clients added by this resource:" +
+			" when both are used for the same export, add the client list to the `ignore_changes` of the export lifecycle.",
+		Description: "This resource is used to add a single host, netgroup or CIDR to a client list of an existing NFS export of PowerScale Array, identified by its id or by its zone and path." +
+			" The other clients of the list are kept, so that several configurations can grant access to the same export, and only this client is removed on destroy." +
+			" The client lists of the powerscale_nfs_export resource are authoritative and remove the clients added by this resource:" +
+			" when both are used for the same export, add the client list to the ignore_changes of the export lifecycle.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Export client identifier, of the form zone:export_id:list_type:client.",
+				MarkdownDescription: "Export client identifier, of the form `zone:export_id:list_type:client`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"export_id": schema.Int64Attribute{
+				Description:         "ID of the NFS export. Conflicts with path.",
+				MarkdownDescription: "ID of the NFS export. Conflicts with `path`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.MatchRoot("path")),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Description:         "Path of the NFS export, which must be shared by a single export of the zone. Conflicts with export_id.",
+				MarkdownDescription: "Path of the NFS export, which must be shared by a single export of the zone. Conflicts with `export_id`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^/ifs($|/)`), "must start with '/ifs'"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"zone": schema.StringAttribute{
+				Description:         "Access zone of the NFS export. Defaults to the System access zone.",
+				MarkdownDescription: "Access zone of the NFS export. Defaults to the System access zone.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"list_type": schema.StringAttribute{
+				Description:         "Client list the client is added to, clients, root_clients, read_only_clients or read_write_clients. Defaults to clients.",
+				MarkdownDescription: "Client list the client is added to, `clients`, `root_clients`, `read_only_clients` or `read_write_clients`. Defaults to `clients`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("clients"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(helper.NfsExportClientListTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"client": schema.StringAttribute{
+				Description:         "Host name, IP address, netgroup or CIDR of the client, ex. 10.10.0.0/24.",
+				MarkdownDescription: "Host name, IP address, netgroup or CIDR of the client, ex. `10.10.0.0/24`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ignore_unresolvable_hosts": schema.BoolAttribute{
+				Description:         "Does not present an error condition on unresolvable hosts when adding the client.(Update Supported)",
+				MarkdownDescription: "Does not present an error condition on unresolvable hosts when adding the client.(Update Supported)",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *NfsExportClientResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *NfsExportClientResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating NFS export client resource..")
+	var plan models.NfsExportClientResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ExportID.IsUnknown() || plan.ExportID.IsNull() {
+		exportID, err := helper.GetNFSExportIDByPath(ctx, r.client, plan.Zone.ValueString(), plan.Path.ValueString())
+		if err != nil {
+			errStr := constants.GetNfsExportErrorMsg + "with error: "
+			message := helper.GetErrorString(err, errStr)
+			resp.Diagnostics.AddError("Error adding nfs export client", message)
+			return
+		}
+		plan.ExportID = types.Int64Value(exportID)
+	}
+
+	exportClient := plan.Client.ValueString()
+	err := helper.ModifyNFSExportClients(ctx, r.client, plan, func(clients []string) []string {
+		if helper.FindNFSExportClient(clients, exportClient) >= 0 {
+			return clients
+		}
+		return append(clients, exportClient)
+	})
+	if err != nil {
+		errStr := constants.CreateNfsExportClientErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error adding nfs export client", message)
+		return
+	}
+
+	found, err := r.readClient(ctx, &plan)
+	if err != nil || !found {
+		message := fmt.Sprintf("Client %s was not found in the %s of nfs export %d after adding it", exportClient, plan.ListType.ValueString(), plan.ExportID.ValueInt64())
+		if err != nil {
+			message = helper.GetErrorString(err, constants.GetNfsExportClientErrorMsg+"with error: ")
+		}
+		resp.Diagnostics.AddError("Error reading nfs export client", message)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with Create NFS export client resource")
+}
+
+// Read reads data from the resource.
+func (r *NfsExportClientResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading NFS export client resource..")
+	var state models.NfsExportClientResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, err := r.readClient(ctx, &state)
+	if err != nil {
+		errStr := constants.GetNfsExportClientErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading nfs export client", message)
+		return
+	}
+	if !found {
+		// the client was removed outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Read NFS export client resource")
+}
+
+// Update updates the resource state.
+func (r *NfsExportClientResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating NFS export client resource..")
+	var plan models.NfsExportClientResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// only ignore_unresolvable_hosts can be updated, which applies when the client is added
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with Update NFS export client resource")
+}
+
+// Delete deletes the resource.
+func (r *NfsExportClientResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting NFS export client resource..")
+	var state models.NfsExportClientResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	exportClient := state.Client.ValueString()
+	err := helper.ModifyNFSExportClients(ctx, r.client, state, func(clients []string) []string {
+		return slices.DeleteFunc(clients, func(item string) bool {
+			return helper.FindNFSExportClient([]string{item}, exportClient) == 0
+		})
+	})
+	if err != nil {
+		errStr := constants.DeleteNfsExportClientErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error removing nfs export client", message)
+		return
+	}
+	tflog.Info(ctx, "Done with Delete NFS export client resource")
+}
+
+// ImportState imports the resource state.
+func (r *NfsExportClientResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	zone, exportID, listType, exportClient, err := helper.ParseNFSExportClientID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing nfs export client", err.Error())
+		return
+	}
+
+	state := models.NfsExportClientResourceModel{
+		ExportID:                types.Int64Value(exportID),
+		Zone:                    types.StringNull(),
+		Path:                    types.StringNull(),
+		ListType:                types.StringValue(listType),
+		Client:                  types.StringValue(exportClient),
+		IgnoreUnresolvableHosts: types.BoolNull(),
+	}
+	if zone != "" {
+		state.Zone = types.StringValue(zone)
+	}
+	found, err := r.readClient(ctx, &state)
+	if err != nil {
+		errStr := constants.GetNfsExportClientErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error importing nfs export client", message)
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Error importing nfs export client",
+			fmt.Sprintf("Could not find client %s in the %s of nfs export %d", exportClient, listType, exportID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// readClient reads the client of the model from the export, and returns whether it was found.
+func (r *NfsExportClientResource) readClient(ctx context.Context, model *models.NfsExportClientResourceModel) (bool, error) {
+	exportResponse, err := helper.GetNFSExportByID(ctx, r.client, strconv.FormatInt(model.ExportID.ValueInt64(), 10), model.Zone.ValueString())
+	if err != nil {
+		return false, err
+	}
+	if len(exportResponse.Exports) == 0 {
+		return false, nil
+	}
+	clients := helper.GetNFSExportClientList(exportResponse.Exports[0], model.ListType.ValueString())
+	if helper.FindNFSExportClient(clients, model.Client.ValueString()) < 0 {
+		return false, nil
+	}
+	model.ID = types.StringValue(fmt.Sprintf("%s:%d:%s:%s", helper.DefaultIfEmpty(model.Zone.ValueString(), "System"),
+		model.ExportID.ValueInt64(), model.ListType.ValueString(), model.Client.ValueString()))
+	return true, nil
+}
@@ -0,0 +1,186 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccNfsExportClientResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + NfsExportClientResourceConfig(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_nfs_export_client.by_id", "list_type", "clients"),
+					resource.TestCheckResourceAttrPair("powerscale_nfs_export_client.by_id", "export_id",
+						"powerscale_nfs_export.test_export", "id"),
+					resource.TestCheckResourceAttrPair("powerscale_nfs_export_client.by_path", "export_id",
+						"powerscale_nfs_export.test_export", "id"),
+					resource.TestCheckResourceAttr("powerscale_nfs_export_client.by_path", "client", "10.10.0.0/24"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "powerscale_nfs_export_client.by_path",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["powerscale_nfs_export_client.by_path"].Primary.ID, nil
+				},
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					assert.Equal(t, "read_only_clients", states[0].Attributes["list_type"])
+					assert.Equal(t, "10.10.0.0/24", states[0].Attributes["client"])
+					return nil
+				},
+			},
+			// Update testing
+			{
+				Config: ProviderConfig + NfsExportClientResourceConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_nfs_export_client.by_id", "ignore_unresolvable_hosts", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNfsExportClientResourceErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid import identifier
+			{
+				Config:        ProviderConfig + NfsExportClientResourceConfig(false),
+				ResourceName:  "powerscale_nfs_export_client.by_id",
+				ImportState:   true,
+				ImportStateId: "invalid",
+				ExpectError:   regexp.MustCompile(`.*invalid identifier*.`),
+			},
+			// Create error
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.ModifyNFSExportClients).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + NfsExportClientResourceConfig(false),
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			// Path resolution error
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.GetNFSExportIDByPath).Return(0, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + NfsExportClientResourceConfig(false),
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			// Entry missing after create
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.FindNFSExportClient).Return(-1).Build()
+				},
+				Config:      ProviderConfig + NfsExportClientResourceConfig(false),
+				ExpectError: regexp.MustCompile(`.*was not found*.`),
+			},
+			// Read error
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+				},
+				Config: ProviderConfig + NfsExportClientResourceConfig(false),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.GetNFSExportByID).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + NfsExportClientResourceConfig(false),
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			// Delete error
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.ModifyNFSExportClients).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + NfsExportClientResourceConfig(false),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+				},
+				Config: ProviderConfig + NfsExportClientResourceConfig(false),
+			},
+		},
+	})
+}
+
+func NfsExportClientResourceConfig(ignoreUnresolvableHosts bool) string {
+	return FileSystemResourceConfigCommon2 + fmt.Sprintf(`
+resource "powerscale_nfs_export" "test_export" {
+	depends_on = [powerscale_filesystem.file_system_test]
+	paths = ["/ifs/tfacc_nfs_export"]
+	lifecycle {
+		ignore_changes = [clients, read_only_clients]
+	}
+}
+
+resource "powerscale_nfs_export_client" "by_id" {
+	export_id = powerscale_nfs_export.test_export.id
+	client = "10.10.1.1"
+	ignore_unresolvable_hosts = %t
+}
+
+resource "powerscale_nfs_export_client" "by_path" {
+	depends_on = [powerscale_nfs_export.test_export]
+	path = "/ifs/tfacc_nfs_export"
+	zone = "System"
+	list_type = "read_only_clients"
+	client = "10.10.0.0/24"
+}
+`, ignoreUnresolvableHosts)
+}
@@ -20,6 +20,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"terraform-provider-powerscale/client"
@@ -28,6 +29,7 @@ import (
 	"terraform-provider-powerscale/powerscale/models"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -38,6 +40,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &NfsExportResource{}
 var _ resource.ResourceWithImportState = &NfsExportResource{}
+var _ resource.ResourceWithModifyPlan = &NfsExportResource{}
 
 // NewNfsExportResource creates a new resource.
 func NewNfsExportResource() resource.Resource {
@@ -76,18 +79,18 @@ func (r *NfsExportResource) Schema(ctx context.Context, req resource.SchemaReque
 				Optional:            true,
 			},
 			"ignore_unresolvable_hosts": schema.BoolAttribute{
-				Description:         "Ignore unresolvable hosts.",
-				MarkdownDescription: "Ignore unresolvable hosts.",
+				Description:         "Ignore unresolvable hosts. The host names of the clients are also resolved on the Terraform host at plan time, and reported as warnings unless this flag is set.",
+				MarkdownDescription: "Ignore unresolvable hosts. The host names of the clients are also resolved on the Terraform host at plan time, and reported as warnings unless this flag is set.",
 				Optional:            true,
 			},
 			"ignore_conflicts": schema.BoolAttribute{
-				Description:         "Ignore conflicts with existing exports.",
-				MarkdownDescription: "Ignore conflicts with existing exports.",
+				Description:         "Ignore conflicts with existing exports. The conflicts are also checked at plan time, and reported as errors unless this flag or force is set.",
+				MarkdownDescription: "Ignore conflicts with existing exports. The conflicts are also checked at plan time, and reported as errors unless this flag or force is set.",
 				Optional:            true,
 			},
 			"ignore_bad_paths": schema.BoolAttribute{
-				Description:         "Ignore nonexistent or otherwise bad paths.",
-				MarkdownDescription: "Ignore nonexistent or otherwise bad paths.",
+				Description:         "Ignore nonexistent or otherwise bad paths. The nonexistent paths are also reported as warnings at plan time unless this flag is set.",
+				MarkdownDescription: "Ignore nonexistent or otherwise bad paths. The nonexistent paths are also reported as warnings at plan time unless this flag is set.",
 				Optional:            true,
 			},
 			"ignore_bad_auth": schema.BoolAttribute{
@@ -782,6 +785,132 @@ func (r *NfsExportResource) Configure(ctx context.Context, req resource.Configur
 	r.client = c
 }
 
+// ModifyPlan reports at plan time the conflicts with the other exports of the zone, the unresolvable hosts and the nonexistent paths,
+// which would otherwise only fail the apply. Conflicts are errors, which ignore_conflicts or force downgrade to warnings.
+// Unresolvable hosts and nonexistent paths are only warnings, since the cluster resolves the hosts with its own DNS and
+// the path may be created earlier in the same apply; ignore_unresolvable_hosts and ignore_bad_paths skip these warnings.
+func (r *NfsExportResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to check on destroy, or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan models.NfsExportResource
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state *models.NfsExportResource
+	if !req.State.Raw.IsNull() {
+		state = &models.NfsExportResource{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if plan.Paths.IsUnknown() {
+		return
+	}
+	zone := plan.Zone.ValueString()
+	if plan.Zone.IsUnknown() && state != nil {
+		zone = state.Zone.ValueString()
+	}
+
+	var paths []string
+	resp.Diagnostics.Append(plan.Paths.ElementsAs(ctx, &paths, false)...)
+	clients, known := nfsExportPlannedClients(ctx, plan, state)
+	if resp.Diagnostics.HasError() || !known {
+		return
+	}
+	// the export was already accepted by the cluster, unless its paths, zone or clients change
+	if state != nil && plan.Paths.Equal(state.Paths) && zone == state.Zone.ValueString() {
+		stateClients, _ := nfsExportPlannedClients(ctx, *state, nil)
+		if slices.Equal(clients, stateClients) {
+			return
+		}
+	}
+
+	filter := &models.NfsExportDatasourceFilter{}
+	if zone != "" {
+		filter.Zone = types.StringValue(zone)
+	}
+	exports, err := helper.ListNFSExports(ctx, r.client, filter)
+	if err != nil {
+		errStr := constants.ListNfsExportErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error checking nfs export conflicts", message)
+		return
+	}
+	exportID := int64(-1)
+	if state != nil {
+		exportID = state.ID.ValueInt64()
+	}
+	if conflicts := helper.FindNFSExportConflicts(exportID, paths, clients, *exports); len(conflicts) > 0 {
+		summary := "NFS export conflicts with existing exports"
+		detail := strings.Join(conflicts, ", ") + "."
+		if plan.IgnoreConflicts.ValueBool() || plan.Force.ValueBool() {
+			resp.Diagnostics.AddAttributeWarning(path.Root("paths"), summary, detail)
+		} else {
+			resp.Diagnostics.AddAttributeError(path.Root("paths"), summary, detail+" Set ignore_conflicts to create the export anyway.")
+		}
+	}
+
+	// the cluster resolves the clients with its own DNS, a failure on the Terraform host is only a hint
+	for _, exportClient := range clients {
+		if plan.IgnoreUnresolvableHosts.ValueBool() || !helper.IsNFSExportHostName(exportClient) {
+			continue
+		}
+		if err := helper.ResolveNFSExportHost(ctx, exportClient); err != nil {
+			resp.Diagnostics.AddWarning(fmt.Sprintf("NFS export client %s cannot be resolved", exportClient),
+				err.Error()+". The cluster may still resolve it, otherwise the export fails unless ignore_unresolvable_hosts is set.")
+		}
+	}
+
+	if plan.IgnoreBadPaths.ValueBool() {
+		return
+	}
+	for _, exportPath := range paths {
+		exists, err := helper.NFSExportPathExists(ctx, r.client, exportPath)
+		if err != nil {
+			errStr := constants.GetNfsExportErrorMsg + "with error: "
+			message := helper.GetErrorString(err, errStr)
+			resp.Diagnostics.AddError(fmt.Sprintf("Error checking nfs export path %s", exportPath), message)
+			return
+		}
+		// the directory may be created earlier in the same apply, so that the missing path is only a warning
+		if !exists {
+			resp.Diagnostics.AddAttributeWarning(path.Root("paths"), fmt.Sprintf("NFS export path %s does not exist", exportPath),
+				"The export will fail unless the directory is created before it, or ignore_bad_paths is set.")
+		}
+	}
+}
+
+// nfsExportPlannedClients returns the sorted clients of all the client lists of the export, and whether they are known.
+// The client lists left to the cluster keep their current value on update, and are empty on create.
+func nfsExportPlannedClients(ctx context.Context, plan models.NfsExportResource, state *models.NfsExportResource) ([]string, bool) {
+	lists := []types.Set{plan.Clients, plan.RootClients, plan.ReadOnlyClients, plan.ReadWriteClients}
+	if state != nil {
+		stateLists := []types.Set{state.Clients, state.RootClients, state.ReadOnlyClients, state.ReadWriteClients}
+		for i := range lists {
+			if lists[i].IsUnknown() {
+				lists[i] = stateLists[i]
+			}
+		}
+	}
+	var clients []string
+	for _, list := range lists {
+		for _, element := range list.Elements() {
+			value, ok := element.(types.String)
+			if !ok || value.IsUnknown() {
+				return nil, false
+			}
+			clients = append(clients, value.ValueString())
+		}
+	}
+	slices.Sort(clients)
+	return clients, true
+}
+
 // Create allocates the resource.
 func (r NfsExportResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	tflog.Info(ctx, "creating nfs export")
@@ -28,7 +28,9 @@ import (
 	"testing"
 
 	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccNFSExport(t *testing.T) {
@@ -338,3 +340,74 @@ resource "powerscale_nfs_export" "test_export" {
 	name_max_size = 255
 }
 `
+
+func TestAccNFSExportPlanChecks(t *testing.T) {
+	// record the warnings of the plan, the test framework does not expose them
+	var warnings []string
+	var warningMocker *mockey.Mocker
+	addWarning := func(d *diag.Diagnostics, summary string, detail string) {}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + NFSExportResourceConfig,
+			},
+			// a second export of the same path to all the clients conflicts with the first one
+			{
+				Config:      ProviderConfig + NFSExportResourceConfig + NFSExportConflictResourceConfig("false", "[]"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`.*conflicts with existing exports*.`),
+			},
+			{
+				Config:             ProviderConfig + NFSExportResourceConfig + NFSExportConflictResourceConfig("true", "[]"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// a host name that cannot be resolved locally is only a warning, the cluster resolves it on its own
+			{
+				PreConfig: func() {
+					warningMocker = mockey.Mock((*diag.Diagnostics).AddWarning).To(func(d *diag.Diagnostics, summary string, detail string) {
+						warnings = append(warnings, summary)
+						addWarning(d, summary, detail)
+					}).Origin(&addWarning).Build()
+				},
+				Config:             ProviderConfig + NFSExportResourceConfig + NFSExportConflictResourceConfig("true", `["tfacc-unresolvable-host.invalid"]`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				PreConfig: func() {
+					warningMocker.Release()
+					assert.Contains(t, warnings, "NFS export client tfacc-unresolvable-host.invalid cannot be resolved")
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.ListNFSExports).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + NFSExportResourceConfig + NFSExportConflictResourceConfig("true", "[]"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+				},
+				Config: ProviderConfig + NFSExportResourceConfig,
+			},
+		},
+	})
+}
+
+func NFSExportConflictResourceConfig(ignoreConflicts string, clients string) string {
+	return fmt.Sprintf(`
+resource "powerscale_nfs_export" "conflict_export" {
+	depends_on = [powerscale_nfs_export.test_export]
+	paths = ["/ifs/tfacc_nfs_export"]
+	clients = %s
+	ignore_conflicts = %s
+}
+`, clients, ignoreConflicts)
+}
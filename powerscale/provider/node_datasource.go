@@ -0,0 +1,258 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NodeDataSource{}
+
+// NewNodeDataSource creates a new data source.
+func NewNodeDataSource() datasource.DataSource {
+	return &NodeDataSource{}
+}
+
+// NodeDataSource defines the data source implementation.
+type NodeDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *NodeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node"
+}
+
+// Schema describes the data source arguments.
+func (d *NodeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This datasource is used to query the inventory of the nodes of the PowerScale cluster." +
+			" The nodes can be filtered by LNN, node pool and health, which can be used to write checks such as `no smartfailed node` as Terraform preconditions.",
+		Description: "This datasource is used to query the inventory of the nodes of the PowerScale cluster." +
+			" The nodes can be filtered by LNN, node pool and health, which can be used to write checks such as no smartfailed node as Terraform preconditions.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier",
+				MarkdownDescription: "Identifier",
+				Computed:            true,
+			},
+			"nodes": schema.ListNestedAttribute{
+				Description:         "List of nodes.",
+				MarkdownDescription: "List of nodes.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description:         "Node ID (Device Number) of the node.",
+							MarkdownDescription: "Node ID (Device Number) of the node.",
+							Computed:            true,
+						},
+						"lnn": schema.Int64Attribute{
+							Description:         "Logical Node Number (LNN) of the node.",
+							MarkdownDescription: "Logical Node Number (LNN) of the node.",
+							Computed:            true,
+						},
+						"node_pool": schema.StringAttribute{
+							Description:         "Name of the node pool the node belongs to.",
+							MarkdownDescription: "Name of the node pool the node belongs to.",
+							Computed:            true,
+						},
+						"serial_number": schema.StringAttribute{
+							Description:         "Serial number of the node.",
+							MarkdownDescription: "Serial number of the node.",
+							Computed:            true,
+						},
+						"product": schema.StringAttribute{
+							Description:         "PowerScale product name.",
+							MarkdownDescription: "PowerScale product name.",
+							Computed:            true,
+						},
+						"class": schema.StringAttribute{
+							Description:         "Class of the node (storage, accelerator, etc.).",
+							MarkdownDescription: "Class of the node (storage, accelerator, etc.).",
+							Computed:            true,
+						},
+						"hwgen": schema.StringAttribute{
+							Description:         "PowerScale hardware generation name.",
+							MarkdownDescription: "PowerScale hardware generation name.",
+							Computed:            true,
+						},
+						"release": schema.StringAttribute{
+							Description:         "OneFS release of the node.",
+							MarkdownDescription: "OneFS release of the node.",
+							Computed:            true,
+						},
+						"version": schema.StringAttribute{
+							Description:         "OneFS version of the node.",
+							MarkdownDescription: "OneFS version of the node.",
+							Computed:            true,
+						},
+						"uptime": schema.Int64Attribute{
+							Description:         "Seconds the node has been online.",
+							MarkdownDescription: "Seconds the node has been online.",
+							Computed:            true,
+						},
+						"ram": schema.Int64Attribute{
+							Description:         "Size of RAM in bytes.",
+							MarkdownDescription: "Size of RAM in bytes.",
+							Computed:            true,
+						},
+						"capacity_bytes": schema.Int64Attribute{
+							Description:         "Total device storage bytes of the node.",
+							MarkdownDescription: "Total device storage bytes of the node.",
+							Computed:            true,
+						},
+						"drive_count": schema.Int64Attribute{
+							Description:         "Number of drives in the node.",
+							MarkdownDescription: "Number of drives in the node.",
+							Computed:            true,
+						},
+						"smartfailed": schema.BoolAttribute{
+							Description:         "Whether the node is smartfailed.",
+							MarkdownDescription: "Whether the node is smartfailed.",
+							Computed:            true,
+						},
+						"readonly": schema.BoolAttribute{
+							Description:         "Whether the node is in read-only mode.",
+							MarkdownDescription: "Whether the node is in read-only mode.",
+							Computed:            true,
+						},
+						"health": schema.StringAttribute{
+							Description:         "Health of the node, one of ok, smartfailed, readonly or down.",
+							MarkdownDescription: "Health of the node, one of `ok`, `smartfailed`, `readonly` or `down`.",
+							Computed:            true,
+						},
+						"error": schema.StringAttribute{
+							Description:         "Error message, if the node did not respond.",
+							MarkdownDescription: "Error message, if the node did not respond.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"lnns": schema.SetAttribute{
+						Optional:            true,
+						ElementType:         types.Int64Type,
+						Description:         "Filter the nodes by Logical Node Number (LNN).",
+						MarkdownDescription: "Filter the nodes by Logical Node Number (LNN).",
+					},
+					"node_pools": schema.SetAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						Description:         "Filter the nodes by node pool name.",
+						MarkdownDescription: "Filter the nodes by node pool name.",
+					},
+					"health": schema.SetAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						Description:         "Filter the nodes by health. Supported values are ok, smartfailed, readonly and down.",
+						MarkdownDescription: "Filter the nodes by health. Supported values are `ok`, `smartfailed`, `readonly` and `down`.",
+						Validators: []validator.Set{
+							setvalidator.ValueStringsAre(stringvalidator.OneOf(helper.GetNodeHealthValues()...)),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *NodeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *NodeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading node data source")
+
+	var state models.NodeDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nodes, err := helper.GetNodeInventory(ctx, d.client)
+	if err != nil {
+		errStr := constants.ReadNodeInventoryErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading nodes", message)
+		return
+	}
+
+	pools, err := helper.GetNodePoolNames(ctx, d.client)
+	if err != nil {
+		errStr := constants.ReadNodePoolsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading node pools", message)
+		return
+	}
+
+	var inventory []models.NodeInventoryModel
+	for _, node := range nodes {
+		inventory = append(inventory, helper.NodeInventoryMapper(node, pools))
+	}
+
+	state.Nodes, err = helper.FilterNodeInventory(ctx, inventory, state.Filter)
+	if err != nil {
+		resp.Diagnostics.AddError("Error filtering nodes", err.Error())
+		return
+	}
+	if state.Nodes == nil {
+		state.Nodes = []models.NodeInventoryModel{}
+	}
+
+	state.ID = types.StringValue("node_datasource")
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading node data source")
+}
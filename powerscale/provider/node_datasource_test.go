@@ -0,0 +1,133 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNodeDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// read all
+			{
+				Config: ProviderConfig + NodeDataSourceAllConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerscale_node.all", "nodes.#"),
+					resource.TestCheckResourceAttrSet("data.powerscale_node.all", "nodes.0.serial_number"),
+					resource.TestCheckResourceAttrSet("data.powerscale_node.all", "nodes.0.node_pool"),
+					resource.TestCheckResourceAttrSet("data.powerscale_node.all", "nodes.0.health"),
+				),
+			},
+			// read with filter
+			{
+				Config: ProviderConfig + NodeDataSourceFilterConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerscale_node.filtering", "nodes.#", "1"),
+					resource.TestCheckResourceAttr("data.powerscale_node.filtering", "nodes.0.lnn", "1"),
+					resource.TestCheckResourceAttr("data.powerscale_node.filtering", "nodes.0.health", "ok"),
+					resource.TestCheckResourceAttr("data.powerscale_node.filtering", "nodes.0.smartfailed", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNodeDataSourceFilterErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + NodeDataSourceFilterConfigErr,
+				ExpectError: regexp.MustCompile(`.*Attribute filter.health.* value must be one of*.`),
+			},
+		},
+	})
+}
+
+func TestAccNodeDataSourceGettingErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.GetNodeInventory).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + NodeDataSourceAllConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetNodePoolNames).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + NodeDataSourceAllConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.FilterNodeInventory).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + NodeDataSourceAllConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + NodeDataSourceAllConfig,
+			},
+		},
+	})
+}
+
+var NodeDataSourceAllConfig = `
+data "powerscale_node" "all" {
+}
+`
+
+var NodeDataSourceFilterConfig = `
+data "powerscale_node" "filtering" {
+	filter {
+		lnns   = [1]
+		health = ["ok"]
+	}
+}
+`
+
+var NodeDataSourceFilterConfigErr = `
+data "powerscale_node" "test" {
+	filter {
+		health = ["invalid"]
+	}
+}
+`
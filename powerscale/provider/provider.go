@@ -32,6 +32,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -39,7 +40,10 @@ import (
 )
 
 // Ensure PscaleProvider satisfies various provider interfaces.
-var _ provider.Provider = &PscaleProvider{}
+var (
+	_ provider.Provider              = &PscaleProvider{}
+	_ provider.ProviderWithFunctions = &PscaleProvider{}
+)
 
 // PscaleProvider defines the provider implementation.
 type PscaleProvider struct {
@@ -277,6 +281,20 @@ func (p *PscaleProvider) Resources(ctx context.Context) []func() resource.Resour
 		NewNfsAliasResource,
 		NewSyncIQReplicationJobResource,
 		NewStoragepoolTierResource,
+		NewSnapshotChangelistResource,
+		NewSyncIQFailoverResource,
+		NewSyncIQTargetPolicyBreakResource,
+		NewFileResource,
+		NewDirectoryTreeResource,
+		NewSmbSharePermissionResource,
+		NewUserMappingRuleResource,
+		NewNfsExportClientResource,
+		NewSmbSessionCloseResource,
+		NewFtpSettingsResource,
+		NewHTTPSettingsResource,
+		NewNdmpSettingsResource,
+		NewNdmpUserResource,
+		NewNetworkPoolRebalanceResource,
 	}
 }
 
@@ -325,6 +343,32 @@ func (p *PscaleProvider) DataSources(ctx context.Context) []func() datasource.Da
 		NewNfsAliasDataSource,
 		NewWritableSnapshotDataSource,
 		NewSyncIQReplicationJobDataSource,
+		NewSnapshotChangelistDataSource,
+		NewSyncIQTargetPolicyDataSource,
+		NewSyncIQTargetReportDataSource,
+		NewNodeDataSource,
+		NewDriveDataSource,
+		NewStatisticsDataSource,
+		NewStatisticsKeysDataSource,
+		NewNamespaceQueryDataSource,
+		NewUserMappingRulesEvaluationDataSource,
+		NewSmbSessionsDataSource,
+		NewSmbOpenfilesDataSource,
+		NewNfsClientsDataSource,
+		NewFtpSettingsDataSource,
+		NewHTTPSettingsDataSource,
+		NewNdmpContextsDataSource,
+		NewNdmpRestartableBackupContextsDataSource,
+		NewNdmpSessionsDataSource,
+		NewNetworkInterfacesDataSource,
+		NewSyncIQRpoStatusDataSource,
+	}
+}
+
+// Functions describes the provider functions.
+func (p *PscaleProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewEvaluateUserMappingRulesFunction,
 	}
 }
 
@@ -24,8 +24,11 @@ import (
 	"terraform-provider-powerscale/client"
 	"terraform-provider-powerscale/powerscale/helper"
 	"terraform-provider-powerscale/powerscale/models"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -36,7 +39,8 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource = &S3KeyResource{}
+	_ resource.Resource               = &S3KeyResource{}
+	_ resource.ResourceWithModifyPlan = &S3KeyResource{}
 )
 
 // NewS3KeyResource returns the S3 Key resource object.
@@ -121,9 +125,38 @@ func S3KeyResourceSchema() map[string]schema.Attribute {
 			},
 		},
 		"existing_key_expiry_time": schema.Int32Attribute{
+			Optional: true,
+			MarkdownDescription: "The expiry of the old secret key in minutes. Optional. It will be applicable only if old_secret_key is exist." +
+				" It is the overlap window during which both keys are valid after a rotation.",
+			Description: "The expiry of the old secret key in minutes. Optional. It will be applicable only if old_secret_key is exist." +
+				" It is the overlap window during which both keys are valid after a rotation.",
+		},
+		"rotation_days": schema.Int64Attribute{
+			Optional: true,
+			MarkdownDescription: "The maximum age of the secret key in days. Optional." +
+				" When the key derived from `secret_key_timestamp` gets older, a plan regenerates it, keeping the old key valid for `existing_key_expiry_time`.",
+			Description: "The maximum age of the secret key in days. Optional." +
+				" When the key derived from secret_key_timestamp gets older, a plan regenerates it, keeping the old key valid for existing_key_expiry_time.",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"rotate_before_expiry": schema.Int64Attribute{
+			Optional: true,
+			MarkdownDescription: "The number of days before the key reaches `rotation_days` from which a plan regenerates it, so that routine applies rotate the key in time. Optional." +
+				" Must be lower than `rotation_days`.",
+			Description: "The number of days before the key reaches rotation_days from which a plan regenerates it, so that routine applies rotate the key in time. Optional." +
+				" Must be lower than rotation_days.",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+				int64validator.AlsoRequires(path.MatchRoot("rotation_days")),
+			},
+		},
+		"keepers": schema.MapAttribute{
 			Optional:            true,
-			MarkdownDescription: "The expiry of the old secret key in minutes. Optional. It will be applicable only if old_secret_key is exist.",
-			Description:         "The expiry of the old secret key in minutes. Optional. It will be applicable only if old_secret_key is exist.",
+			ElementType:         types.StringType,
+			MarkdownDescription: "Arbitrary map of values that, when changed, regenerates the secret key. Optional.",
+			Description:         "Arbitrary map of values that, when changed, regenerates the secret key. Optional.",
 		},
 		"secret_key": schema.StringAttribute{
 			Computed:            true,
@@ -155,6 +188,54 @@ func S3KeyResourceSchema() map[string]schema.Attribute {
 	}
 }
 
+// ModifyPlan plans the regeneration of the secret key when the rotation policy or the keepers require it.
+// Otherwise the key of the state is kept, so that changing the rotation policy alone does not regenerate it.
+func (r *S3KeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to rotate on create or destroy, and a replaced key is generated anyway
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || len(resp.RequiresReplace) > 0 {
+		return
+	}
+
+	var plan, state models.S3KeyResourceData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !plan.RotationDays.IsUnknown() && !plan.RotateBeforeExpiry.IsUnknown() && !plan.RotateBeforeExpiry.IsNull() &&
+		plan.RotateBeforeExpiry.ValueInt64() >= plan.RotationDays.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(path.Root("rotate_before_expiry"), "Invalid rotation policy",
+			"rotate_before_expiry must be lower than rotation_days.")
+		return
+	}
+
+	regenerate := !plan.Keepers.Equal(state.Keepers) || !plan.ExistingKeyExpiryTime.Equal(state.ExistingKeyExpiryTime)
+	if !plan.RotationDays.IsNull() && !plan.RotationDays.IsUnknown() && !plan.RotateBeforeExpiry.IsUnknown() &&
+		helper.IsS3KeyRotationDue(state.SecretKeyTimestamp.ValueInt64(), plan.RotationDays.ValueInt64(), plan.RotateBeforeExpiry.ValueInt64(), time.Now()) {
+		resp.Diagnostics.AddWarning("S3 key will be rotated",
+			fmt.Sprintf("The secret key of user %s is due for rotation after %d days, it will be regenerated.", plan.User.ValueString(), plan.RotationDays.ValueInt64()))
+		regenerate = true
+	}
+
+	if regenerate {
+		// the key attributes are only known once the key is generated again
+		plan.AccessID = types.StringUnknown()
+		plan.SecretKey = types.StringUnknown()
+		plan.SecretKeyTimestamp = types.Int64Unknown()
+		plan.OldSecretKey = types.StringUnknown()
+		plan.OldKeyExpiry = types.Int64Unknown()
+		plan.OldKeyTimestamp = types.Int64Unknown()
+	} else {
+		plan.AccessID = state.AccessID
+		plan.SecretKey = state.SecretKey
+		plan.SecretKeyTimestamp = state.SecretKeyTimestamp
+		plan.OldSecretKey = state.OldSecretKey
+		plan.OldKeyExpiry = state.OldKeyExpiry
+		plan.OldKeyTimestamp = state.OldKeyTimestamp
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
 // Create allocates the resource.
 func (r *S3KeyResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	var s3key models.S3KeyResourceData
@@ -233,6 +314,12 @@ func (r *S3KeyResource) Update(ctx context.Context, request resource.UpdateReque
 	if response.Diagnostics.HasError() {
 		return
 	}
+	// the plan keeps the key when only the rotation policy changed
+	if !s3key.SecretKeyTimestamp.IsUnknown() {
+		diags = response.State.Set(ctx, s3key)
+		response.Diagnostics.Append(diags...)
+		return
+	}
 	// call update s3key
 	resp, err := helper.GenerateS3Key(ctx, r.client, s3key)
 	if err != nil {
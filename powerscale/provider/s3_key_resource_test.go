@@ -26,6 +26,10 @@ import (
 
 	"github.com/bytedance/mockey"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
 
 func TestAccS3KeyResourceErrorCreate(t *testing.T) {
@@ -111,6 +115,108 @@ func TestAccS3KeyResource(t *testing.T) {
 	})
 }
 
+func TestAccS3KeyResourceRotation(t *testing.T) {
+	var S3KeyResourceConfigRotation = tfRotationConfig(30, "v1")
+	var S3KeyResourceConfigKeepers = tfRotationConfig(30, "v2")
+	var secretKeyTimestamp string
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + S3KeyResourceConfigRotation,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_s3_key.tf_rotation", "rotation_days", "30"),
+					func(s *terraform.State) error {
+						secretKeyTimestamp = s.RootModule().Resources["powerscale_s3_key.tf_rotation"].Primary.Attributes["secret_key_timestamp"]
+						return nil
+					},
+				),
+			},
+			// a key younger than the rotation policy is kept
+			{
+				Config: ProviderConfig + tfRotationConfig(60, "v1"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue("powerscale_s3_key.tf_rotation", tfjsonpath.New("secret_key_timestamp"), knownvalue.NotNull()),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(s *terraform.State) error {
+						if s.RootModule().Resources["powerscale_s3_key.tf_rotation"].Primary.Attributes["secret_key_timestamp"] != secretKeyTimestamp {
+							return fmt.Errorf("the secret key was regenerated")
+						}
+						return nil
+					},
+				),
+			},
+			// a key due for rotation is regenerated
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.IsS3KeyRotationDue).Return(true).Build()
+				},
+				Config: ProviderConfig + S3KeyResourceConfigRotation,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectUnknownValue("powerscale_s3_key.tf_rotation", tfjsonpath.New("secret_key")),
+					},
+				},
+			},
+			// the keepers regenerate the key
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + S3KeyResourceConfigKeepers,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectUnknownValue("powerscale_s3_key.tf_rotation", tfjsonpath.New("secret_key_timestamp")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("powerscale_s3_key.tf_rotation", "old_key_timestamp"),
+					func(s *terraform.State) error {
+						if s.RootModule().Resources["powerscale_s3_key.tf_rotation"].Primary.Attributes["secret_key_timestamp"] == secretKeyTimestamp {
+							return fmt.Errorf("the secret key was not regenerated")
+						}
+						return nil
+					},
+				),
+			},
+			// invalid rotation policy
+			{
+				Config: ProviderConfig + `
+resource "powerscale_s3_key" "tf_rotation" {
+    user = "admin"
+    zone = "System"
+    rotation_days = 7
+    rotate_before_expiry = 7
+}
+`,
+				ExpectError: regexp.MustCompile(".*rotate_before_expiry must be lower than rotation_days*."),
+			},
+		},
+	})
+}
+
+func tfRotationConfig(rotationDays int, keeper string) string {
+	return fmt.Sprintf(`
+resource "powerscale_s3_key" "tf_rotation" {
+    user = "admin"
+    zone = "System"
+    existing_key_expiry_time = 60
+    rotation_days = %d
+    rotate_before_expiry = 7
+    keepers = {
+        version = "%s"
+    }
+}
+`, rotationDays, keeper)
+}
+
 func tfConfig(resource, user, zone string, expiry int32) string {
 	return fmt.Sprintf(`
 resource "powerscale_s3_key" "%s" {
@@ -0,0 +1,211 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SmbOpenfilesDataSource{}
+
+// NewSmbOpenfilesDataSource creates a new data source.
+func NewSmbOpenfilesDataSource() datasource.DataSource {
+	return &SmbOpenfilesDataSource{}
+}
+
+// SmbOpenfilesDataSource defines the data source implementation.
+type SmbOpenfilesDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *SmbOpenfilesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_smb_openfiles"
+}
+
+// Schema describes the data source arguments.
+func (d *SmbOpenfilesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This datasource is used to query the files open over SMB on PowerScale array. " +
+			"It can be used to check that no file of a share is still open before the share is retired.",
+		Description: "This datasource is used to query the files open over SMB on PowerScale array. " +
+			"It can be used to check that no file of a share is still open before the share is retired.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier",
+				MarkdownDescription: "Identifier",
+				Computed:            true,
+			},
+			"smb_openfiles": schema.ListNestedAttribute{
+				Description:         "List of files open over SMB.",
+				MarkdownDescription: "List of files open over SMB.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description:         "The ID of the open file.",
+							MarkdownDescription: "The ID of the open file.",
+							Computed:            true,
+						},
+						"file": schema.StringAttribute{
+							Description:         "The file as reported by the cluster, ex. C:\\ifs\\data\\file.txt.",
+							MarkdownDescription: "The file as reported by the cluster, ex. `C:\\ifs\\data\\file.txt`.",
+							Computed:            true,
+						},
+						"path": schema.StringAttribute{
+							Description:         "The path of the file in the /ifs namespace, ex. /ifs/data/file.txt.",
+							MarkdownDescription: "The path of the file in the /ifs namespace, ex. `/ifs/data/file.txt`.",
+							Computed:            true,
+						},
+						"locks": schema.Int64Attribute{
+							Description:         "The number of locks held on the file.",
+							MarkdownDescription: "The number of locks held on the file.",
+							Computed:            true,
+						},
+						"permissions": schema.ListAttribute{
+							Description:         "The permissions the file is opened with.",
+							MarkdownDescription: "The permissions the file is opened with.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"user": schema.StringAttribute{
+							Description:         "The user that opened the file.",
+							MarkdownDescription: "The user that opened the file.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Description:         "The open files API reports neither the client computer nor the node of the files, so that they cannot be filtered by client or node.",
+				MarkdownDescription: "The open files API reports neither the client computer nor the node of the files, so that they cannot be filtered by client or node.",
+				Attributes: map[string]schema.Attribute{
+					"zone": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Filter the open files by access zone. The files within the base path of the zone are returned, which includes the files of the zones nested in it.",
+						MarkdownDescription: "Filter the open files by access zone. The files within the base path of the zone are returned, which includes the files of the zones nested in it.",
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"user": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Filter the open files by user, with or without domain, ex. DOMAIN\\user or user.",
+						MarkdownDescription: "Filter the open files by user, with or without domain, ex. `DOMAIN\\user` or `user`.",
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"path": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Filter the open files by directory, ex. the path of a share. The files within the directory are returned.",
+						MarkdownDescription: "Filter the open files by directory, ex. the path of a share. The files within the directory are returned.",
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(regexp.MustCompile(`^/ifs($|/)`), "must start with '/ifs'"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *SmbOpenfilesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *SmbOpenfilesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading SMB open files data source")
+
+	var state models.SmbOpenfilesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	openfiles, err := helper.GetSmbOpenfiles(ctx, d.client)
+	if err != nil {
+		errStr := constants.ReadSmbOpenfilesErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading SMB open files", message)
+		return
+	}
+
+	filter := models.SmbOpenfilesFilterType{}
+	if state.Filter != nil {
+		filter = *state.Filter
+	}
+	zonePath := ""
+	if zone := filter.Zone.ValueString(); zone != "" {
+		zonePath, err = helper.GetAccessZonePath(ctx, d.client, zone)
+		if err != nil {
+			errStr := constants.ReadAccessZoneErrorMsg + "with error: "
+			message := helper.GetErrorString(err, errStr)
+			resp.Diagnostics.AddError("Error reading the base path of the access zone", message)
+			return
+		}
+	}
+	state.Openfiles = []models.SmbOpenfileModel{}
+	for _, openfile := range helper.FilterSmbOpenfiles(openfiles, filter.User.ValueString(), filter.Path.ValueString(), zonePath) {
+		entity, err := helper.SmbOpenfileMapper(ctx, openfile)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to map SMB open file fields", err.Error())
+			return
+		}
+		state.Openfiles = append(state.Openfiles, entity)
+	}
+
+	state.ID = types.StringValue("smb_openfiles_datasource")
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading SMB open files data source")
+}
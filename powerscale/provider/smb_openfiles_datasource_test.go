@@ -0,0 +1,103 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSmbOpenfilesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// read all
+			{
+				Config: ProviderConfig + SmbOpenfilesDataSourceAllConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerscale_smb_openfiles.all", "smb_openfiles.#"),
+				),
+			},
+			// read with filter
+			{
+				Config: ProviderConfig + SmbOpenfilesDataSourceFilterConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerscale_smb_openfiles.filtering", "smb_openfiles.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSmbOpenfilesDataSourceErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + SmbOpenfilesDataSourceInvalidConfig,
+				ExpectError: regexp.MustCompile(`.*must start with '/ifs'*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.GetSmbOpenfiles).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SmbOpenfilesDataSourceAllConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + SmbOpenfilesDataSourceAllConfig,
+			},
+		},
+	})
+}
+
+var SmbOpenfilesDataSourceAllConfig = `
+data "powerscale_smb_openfiles" "all" {
+}
+`
+
+var SmbOpenfilesDataSourceFilterConfig = `
+data "powerscale_smb_openfiles" "filtering" {
+	filter {
+		zone = "System"
+		user = "tfacc_no_such_user"
+		path = "/ifs/tfacc_no_such_share"
+	}
+}
+`
+
+var SmbOpenfilesDataSourceInvalidConfig = `
+data "powerscale_smb_openfiles" "invalid" {
+	filter {
+		path = "/data"
+	}
+}
+`
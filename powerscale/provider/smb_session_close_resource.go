@@ -0,0 +1,302 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &SmbSessionCloseResource{}
+	_ resource.ResourceWithConfigure      = &SmbSessionCloseResource{}
+	_ resource.ResourceWithValidateConfig = &SmbSessionCloseResource{}
+)
+
+// NewSmbSessionCloseResource creates a new resource.
+func NewSmbSessionCloseResource() resource.Resource {
+	return &SmbSessionCloseResource{}
+}
+
+// SmbSessionCloseResource defines the resource implementation.
+type SmbSessionCloseResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *SmbSessionCloseResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_smb_session_close"
+}
+
+// Schema describes the resource arguments.
+func (r *SmbSessionCloseResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to close the SMB sessions and the files open over SMB on PowerScale array, ex. before a share is retired. " +
+			"Creating this resource closes the matching open files and sessions once, destroying this resource only removes it from the state. " +
+			"Use `replace_triggered_by` to close them again.",
+		Description: "This resource is used to close the SMB sessions and the files open over SMB on PowerScale array, ex. before a share is retired. " +
+			"Creating this resource closes the matching open files and sessions once, destroying this resource only removes it from the state. " +
+			"Use replace_triggered_by to close them again.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier",
+				MarkdownDescription: "Identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"zone": schema.StringAttribute{
+				Description:         "Access zone of the sessions to close. The open files to close are limited to the base path of the zone, which includes the files of the zones nested in it. Defaults to all the zones.",
+				MarkdownDescription: "Access zone of the sessions to close. The open files to close are limited to the base path of the zone, which includes the files of the zones nested in it. Defaults to all the zones.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user": schema.StringAttribute{
+				Description:         "User of the sessions and open files to close, with or without domain, ex. DOMAIN\\user or user.",
+				MarkdownDescription: "User of the sessions and open files to close, with or without domain, ex. `DOMAIN\\user` or `user`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"computer": schema.StringAttribute{
+				Description:         "Host name or IP address of the client computer of the sessions to close, or network in CIDR notation, ex. 10.10.0.0/24.",
+				MarkdownDescription: "Host name or IP address of the client computer of the sessions to close, or network in CIDR notation, ex. `10.10.0.0/24`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Description:         "Directory of the open files to close, ex. the path of a share. The files within the directory are closed.",
+				MarkdownDescription: "Directory of the open files to close, ex. the path of a share. The files within the directory are closed.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^/ifs($|/)`), "must start with '/ifs'"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"close_openfiles": schema.BoolAttribute{
+				Description:         "Whether to close the files open by the user or within the path. Defaults to true.",
+				MarkdownDescription: "Whether to close the files open by the `user` or within the `path`. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"close_sessions": schema.BoolAttribute{
+				Description:         "Whether to close the sessions of the user or of the client computer. Defaults to false.",
+				MarkdownDescription: "Whether to close the sessions of the `user` or of the client `computer`. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"closed_openfiles": schema.ListAttribute{
+				Description:         "The paths of the open files that were closed.",
+				MarkdownDescription: "The paths of the open files that were closed.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"closed_sessions": schema.ListAttribute{
+				Description:         "The sessions that were closed, as computer:user.",
+				MarkdownDescription: "The sessions that were closed, as `computer:user`.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *SmbSessionCloseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// ValidateConfig prevents closing all the sessions or open files of the cluster.
+func (r *SmbSessionCloseResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cfg models.SmbSessionCloseResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	closeOpenfiles := cfg.CloseOpenfiles.IsNull() || cfg.CloseOpenfiles.IsUnknown() || cfg.CloseOpenfiles.ValueBool()
+	closeSessions := cfg.CloseSessions.IsUnknown() || cfg.CloseSessions.ValueBool()
+	if !closeOpenfiles && !closeSessions {
+		resp.Diagnostics.AddAttributeError(path.Root("close_openfiles"), "Nothing to close",
+			"At least one of close_openfiles and close_sessions must be true.")
+	}
+	if closeOpenfiles && cfg.User.IsNull() && cfg.Path.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "Missing open files filter",
+			"Please provide user or path to close open files.")
+	}
+	if closeSessions && cfg.User.IsNull() && cfg.Computer.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("computer"), "Missing sessions filter",
+			"Please provide user or computer to close sessions.")
+	}
+}
+
+// Create closes the matching open files and sessions.
+func (r *SmbSessionCloseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Closing SMB sessions and open files")
+
+	var plan models.SmbSessionCloseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	closedOpenfiles := []string{}
+	if plan.CloseOpenfiles.ValueBool() {
+		openfiles, err := helper.GetSmbOpenfiles(ctx, r.client)
+		if err != nil {
+			errStr := constants.ReadSmbOpenfilesErrorMsg + "with error: "
+			message := helper.GetErrorString(err, errStr)
+			resp.Diagnostics.AddError("Error reading SMB open files", message)
+			return
+		}
+		zonePath := ""
+		if zone := plan.Zone.ValueString(); zone != "" {
+			zonePath, err = helper.GetAccessZonePath(ctx, r.client, zone)
+			if err != nil {
+				errStr := constants.ReadAccessZoneErrorMsg + "with error: "
+				message := helper.GetErrorString(err, errStr)
+				resp.Diagnostics.AddError("Error reading the base path of the access zone", message)
+				return
+			}
+		}
+		for _, openfile := range helper.FilterSmbOpenfiles(openfiles, plan.User.ValueString(), plan.Path.ValueString(), zonePath) {
+			if err := helper.CloseSmbOpenfile(ctx, r.client, openfile.GetId()); err != nil {
+				errStr := constants.CloseSmbOpenfileErrorMsg + "with error: "
+				message := helper.GetErrorString(err, errStr)
+				resp.Diagnostics.AddError(fmt.Sprintf("Error closing SMB open file %s", openfile.GetFile()), message)
+				return
+			}
+			closedOpenfiles = append(closedOpenfiles, helper.GetSmbOpenfilePath(openfile.GetFile()))
+		}
+	}
+
+	closedSessions := []string{}
+	if plan.CloseSessions.ValueBool() {
+		sessions, err := helper.GetSmbSessions(ctx, r.client, plan.Zone.ValueString())
+		if err != nil {
+			errStr := constants.ReadSmbSessionsErrorMsg + "with error: "
+			message := helper.GetErrorString(err, errStr)
+			resp.Diagnostics.AddError("Error reading SMB sessions", message)
+			return
+		}
+		for _, session := range helper.FilterSmbSessions(sessions, plan.User.ValueString(), plan.Computer.ValueString(), nil) {
+			if err := helper.CloseSmbSession(ctx, r.client, plan.Zone.ValueString(), session.GetComputer(), session.GetUser()); err != nil {
+				errStr := constants.CloseSmbSessionErrorMsg + "with error: "
+				message := helper.GetErrorString(err, errStr)
+				resp.Diagnostics.AddError(fmt.Sprintf("Error closing SMB session of %s from %s", session.GetUser(), session.GetComputer()), message)
+				return
+			}
+			closedSessions = append(closedSessions, session.GetComputer()+":"+session.GetUser())
+		}
+	}
+
+	var diags diag.Diagnostics
+	plan.ID = types.StringValue("smb_session_close")
+	plan.ClosedOpenfiles, diags = types.ListValueFrom(ctx, types.StringType, closedOpenfiles)
+	resp.Diagnostics.Append(diags...)
+	plan.ClosedSessions, diags = types.ListValueFrom(ctx, types.StringType, closedSessions)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, fmt.Sprintf("Done with closing %d SMB open files and %d SMB sessions", len(closedOpenfiles), len(closedSessions)))
+}
+
+// Read keeps the state, the closed sessions leave nothing behind to read back.
+func (r *SmbSessionCloseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.SmbSessionCloseResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is not supported, every configurable attribute requires replacement.
+func (r *SmbSessionCloseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.SmbSessionCloseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the resource from the state, closed sessions cannot be restored.
+func (r *SmbSessionCloseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting SMB session close")
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,119 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSmbSessionCloseResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + SmbSessionCloseResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_smb_session_close.test", "close_openfiles", "true"),
+					resource.TestCheckResourceAttr("powerscale_smb_session_close.test", "closed_openfiles.#", "0"),
+					resource.TestCheckResourceAttr("powerscale_smb_session_close.test", "closed_sessions.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSmbSessionCloseResourceErr(t *testing.T) {
+	openfiles := []powerscale.V1SmbOpenfile{{
+		Id:   powerscale.PtrInt32(1),
+		File: powerscale.PtrString(`C:\ifs\tfacc_no_such_share\file.txt`),
+		User: powerscale.PtrString(`DOMAIN\tfacc_no_such_user`),
+	}}
+	sessions := []powerscale.V1SmbSession{{
+		Computer: powerscale.PtrString("192.0.2.1"),
+		User:     powerscale.PtrString(`DOMAIN\tfacc_no_such_user`),
+	}}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + SmbSessionCloseResourceInvalidConfig,
+				ExpectError: regexp.MustCompile(`.*Missing sessions filter*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.GetSmbOpenfiles).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SmbSessionCloseResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetSmbOpenfiles).Return(openfiles, nil).Build()
+					FunctionMocker2 = mockey.Mock(helper.CloseSmbOpenfile).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SmbSessionCloseResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker2.Release()
+					FunctionMocker = mockey.Mock(helper.GetSmbSessions).Return(sessions, nil).Build()
+					FunctionMocker2 = mockey.Mock(helper.CloseSmbSession).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SmbSessionCloseResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker2.Release()
+				},
+				Config: ProviderConfig + SmbSessionCloseResourceConfig,
+			},
+		},
+	})
+}
+
+var SmbSessionCloseResourceConfig = `
+resource "powerscale_smb_session_close" "test" {
+	user           = "tfacc_no_such_user"
+	path           = "/ifs/tfacc_no_such_share"
+	close_sessions = true
+}
+`
+
+var SmbSessionCloseResourceInvalidConfig = `
+resource "powerscale_smb_session_close" "test" {
+	path           = "/ifs/tfacc_no_such_share"
+	close_sessions = true
+}
+`
@@ -0,0 +1,234 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SmbSessionsDataSource{}
+
+// NewSmbSessionsDataSource creates a new data source.
+func NewSmbSessionsDataSource() datasource.DataSource {
+	return &SmbSessionsDataSource{}
+}
+
+// SmbSessionsDataSource defines the data source implementation.
+type SmbSessionsDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *SmbSessionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_smb_sessions"
+}
+
+// Schema describes the data source arguments.
+func (d *SmbSessionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This datasource is used to query the SMB sessions open on PowerScale array. " +
+			"It can be used to check that no client is still connected before a share is retired.",
+		Description: "This datasource is used to query the SMB sessions open on PowerScale array. " +
+			"It can be used to check that no client is still connected before a share is retired.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier",
+				MarkdownDescription: "Identifier",
+				Computed:            true,
+			},
+			"smb_sessions": schema.ListNestedAttribute{
+				Description:         "List of SMB sessions.",
+				MarkdownDescription: "List of SMB sessions.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"active_time": schema.Int64Attribute{
+							Description:         "The number of seconds the session has been active.",
+							MarkdownDescription: "The number of seconds the session has been active.",
+							Computed:            true,
+						},
+						"client_type": schema.StringAttribute{
+							Description:         "The SMB client version of the session.",
+							MarkdownDescription: "The SMB client version of the session.",
+							Computed:            true,
+						},
+						"computer": schema.StringAttribute{
+							Description:         "The host name or IP address of the client computer.",
+							MarkdownDescription: "The host name or IP address of the client computer.",
+							Computed:            true,
+						},
+						"encryption": schema.BoolAttribute{
+							Description:         "Whether the session is encrypted.",
+							MarkdownDescription: "Whether the session is encrypted.",
+							Computed:            true,
+						},
+						"guest_login": schema.BoolAttribute{
+							Description:         "Whether the session is a guest session.",
+							MarkdownDescription: "Whether the session is a guest session.",
+							Computed:            true,
+						},
+						"idle_time": schema.Int64Attribute{
+							Description:         "The number of seconds the session has been idle.",
+							MarkdownDescription: "The number of seconds the session has been idle.",
+							Computed:            true,
+						},
+						"openfiles": schema.Int64Attribute{
+							Description:         "The number of files open in the session.",
+							MarkdownDescription: "The number of files open in the session.",
+							Computed:            true,
+						},
+						"user": schema.StringAttribute{
+							Description:         "The user of the session.",
+							MarkdownDescription: "The user of the session.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"nodes": schema.SetAttribute{
+						Optional:    true,
+						ElementType: types.Int64Type,
+						Description: "Filter the sessions by logical node number of the node they are connected to. " +
+							"The sessions API does not report the node, so that the computers are matched with the SMB clients of the client statistics of the nodes, " +
+							"and the sessions idle for too long to show in the statistics are left out.",
+						MarkdownDescription: "Filter the sessions by logical node number of the node they are connected to. " +
+							"The sessions API does not report the node, so that the computers are matched with the SMB clients of the client statistics of the nodes, " +
+							"and the sessions idle for too long to show in the statistics are left out.",
+						Validators: []validator.Set{
+							setvalidator.ValueInt64sAre(int64validator.AtLeast(1)),
+						},
+					},
+					"zone": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Filter the sessions by access zone. Defaults to all the zones.",
+						MarkdownDescription: "Filter the sessions by access zone. Defaults to all the zones.",
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"user": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Filter the sessions by user, with or without domain, ex. DOMAIN\\user or user.",
+						MarkdownDescription: "Filter the sessions by user, with or without domain, ex. `DOMAIN\\user` or `user`.",
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"computer": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Filter the sessions by host name or IP address of the client computer, or by network in CIDR notation, ex. 10.10.0.0/24.",
+						MarkdownDescription: "Filter the sessions by host name or IP address of the client computer, or by network in CIDR notation, ex. `10.10.0.0/24`.",
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *SmbSessionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *SmbSessionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading SMB sessions data source")
+
+	var state models.SmbSessionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := models.SmbSessionsFilterType{}
+	if state.Filter != nil {
+		filter = *state.Filter
+	}
+	sessions, err := helper.GetSmbSessions(ctx, d.client, filter.Zone.ValueString())
+	if err != nil {
+		errStr := constants.ReadSmbSessionsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading SMB sessions", message)
+		return
+	}
+
+	var nodeClients []powerscale.V1SummaryClientClientItem
+	if !filter.Nodes.IsNull() && !filter.Nodes.IsUnknown() {
+		var nodes []int64
+		resp.Diagnostics.Append(filter.Nodes.ElementsAs(ctx, &nodes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		nodeClients, err = helper.GetSmbClients(ctx, d.client, nodes)
+		if err != nil {
+			errStr := constants.ReadSmbSessionsErrorMsg + "with error: "
+			message := helper.GetErrorString(err, errStr)
+			resp.Diagnostics.AddError("Error reading the SMB clients of the nodes", message)
+			return
+		}
+	}
+
+	state.Sessions = []models.SmbSessionModel{}
+	for _, session := range helper.FilterSmbSessions(sessions, filter.User.ValueString(), filter.Computer.ValueString(), nodeClients) {
+		state.Sessions = append(state.Sessions, helper.SmbSessionMapper(session))
+	}
+
+	state.ID = types.StringValue("smb_sessions_datasource")
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading SMB sessions data source")
+}
@@ -0,0 +1,355 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &SmbSharePermissionResource{}
+	_ resource.ResourceWithConfigure   = &SmbSharePermissionResource{}
+	_ resource.ResourceWithImportState = &SmbSharePermissionResource{}
+)
+
+// NewSmbSharePermissionResource is a helper function to simplify the provider implementation.
+func NewSmbSharePermissionResource() resource.Resource {
+	return &SmbSharePermissionResource{}
+}
+
+// SmbSharePermissionResource defines the resource implementation.
+type SmbSharePermissionResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *SmbSharePermissionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_smb_share_permission"
+}
+
+// Schema describes the resource arguments.
+func (r *SmbSharePermissionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage a single permission entry of an existing SMB share of PowerScale Array, identified by its trustee and permission type." +
+			" The other permission entries of the share are kept, so that several configurations can grant permissions on the same share." +
+			" The `permissions` attribute of the `powerscale_smb_share` resource is authoritative and removes the entries managed by this resource:" +
+			" when both are used for the same share, add `permissions` to the `ignore_changes` of the share lifecycle, and do not manage the same entry with both.",
+		Description: "This resource is used to manage a single permission entry of an existing SMB share of PowerScale Array, identified by its trustee and permission type." +
+			" The other permission entries of the share are kept, so that several configurations can grant permissions on the same share." +
+			" The permissions attribute of the powerscale_smb_share resource is authoritative and removes the entries managed by this resource:" +
+			" when both are used for the same share, add permissions to the ignore_changes of the share lifecycle, and do not manage the same entry with both.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Permission entry identifier, of the form zone:share_name:permission_type:trustee_id.",
+				MarkdownDescription: "Permission entry identifier, of the form `zone:share_name:permission_type:trustee_id`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"share_name": schema.StringAttribute{
+				Description:         "Name of the existing SMB share.",
+				MarkdownDescription: "Name of the existing SMB share.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"zone": schema.StringAttribute{
+				Description:         "Access zone of the SMB share. Defaults to the System access zone.",
+				MarkdownDescription: "Access zone of the SMB share. Defaults to the System access zone.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("System"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permission": schema.StringAttribute{
+				Description:         "Specifies the file system rights that are allowed or denied, full, change or read.(Update Supported)",
+				MarkdownDescription: "Specifies the file system rights that are allowed or denied, `full`, `change` or `read`.(Update Supported)",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("full", "change", "read"),
+				},
+			},
+			"permission_type": schema.StringAttribute{
+				Description:         "Determines whether the permission is allowed or denied, allow or deny.",
+				MarkdownDescription: "Determines whether the permission is allowed or denied, `allow` or `deny`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("allow", "deny"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"trustee": schema.SingleNestedAttribute{
+				Description:         "Specifies the persona the permission applies to, by id or by name and type.",
+				MarkdownDescription: "Specifies the persona the permission applies to, by `id` or by `name` and `type`.",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description:         "Specifies the serialized form of a persona using security identifier, which can be 'SID:S-1-1'.",
+						MarkdownDescription: "Specifies the serialized form of a persona using security identifier, which can be 'SID:S-1-1'.",
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.String{
+							stringvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("name")),
+						},
+						// replace only after the omitted values are taken from the state
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"name": schema.StringAttribute{
+						Description:         "Specifies the persona name, which must be combined with a type.",
+						MarkdownDescription: "Specifies the persona name, which must be combined with a type.",
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("type")),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"type": schema.StringAttribute{
+						Description:         "Specifies the type of persona, which must be combined with a name, user, group or wellknown.",
+						MarkdownDescription: "Specifies the type of persona, which must be combined with a name, `user`, `group` or `wellknown`.",
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("user", "group", "wellknown"),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *SmbSharePermissionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *SmbSharePermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating SMB share permission resource..")
+	var plan models.SmbSharePermissionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.SetSmbSharePermission(ctx, r.client, plan); err != nil {
+		errStr := constants.CreateSmbSharePermissionErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error adding smb share permission", message)
+		return
+	}
+
+	found, err := r.readPermission(ctx, &plan)
+	if err != nil {
+		errStr := constants.GetSmbSharePermissionErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading smb share permission", message)
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Error reading smb share permission",
+			fmt.Sprintf("The permission entry was not found on smb share %s after adding it", plan.ShareName.ValueString()))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with Create SMB share permission resource")
+}
+
+// Read reads data from the resource.
+func (r *SmbSharePermissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading SMB share permission resource..")
+	var state models.SmbSharePermissionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, err := r.readPermission(ctx, &state)
+	if err != nil {
+		errStr := constants.GetSmbSharePermissionErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading smb share permission", message)
+		return
+	}
+	if !found {
+		// the entry was removed outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Read SMB share permission resource")
+}
+
+// Update updates the resource state.
+func (r *SmbSharePermissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating SMB share permission resource..")
+	var plan models.SmbSharePermissionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.SetSmbSharePermission(ctx, r.client, plan); err != nil {
+		errStr := constants.UpdateSmbSharePermissionErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error updating smb share permission", message)
+		return
+	}
+
+	found, err := r.readPermission(ctx, &plan)
+	if err != nil || !found {
+		errStr := constants.GetSmbSharePermissionErrorMsg + "with error: "
+		message := fmt.Sprintf("The permission entry was not found on smb share %s after updating it", plan.ShareName.ValueString())
+		if err != nil {
+			message = helper.GetErrorString(err, errStr)
+		}
+		resp.Diagnostics.AddError("Error reading smb share permission", message)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with Update SMB share permission resource")
+}
+
+// Delete deletes the resource.
+func (r *SmbSharePermissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting SMB share permission resource..")
+	var state models.SmbSharePermissionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.RemoveSmbSharePermission(ctx, r.client, state); err != nil {
+		errStr := constants.DeleteSmbSharePermissionErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error removing smb share permission", message)
+		return
+	}
+	tflog.Info(ctx, "Done with Delete SMB share permission resource")
+}
+
+// ImportState imports the resource state.
+func (r *SmbSharePermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	zone, shareName, permissionType, trusteeID, err := helper.ParseSmbSharePermissionID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing smb share permission", err.Error())
+		return
+	}
+
+	state := models.SmbSharePermissionResourceModel{
+		ShareName:      types.StringValue(shareName),
+		Zone:           types.StringValue(helper.DefaultIfEmpty(zone, "System")),
+		PermissionType: types.StringValue(permissionType),
+		Trustee: models.V1AuthAccessAccessItemFileGroup{
+			ID:   types.StringValue(trusteeID),
+			Name: types.StringNull(),
+			Type: types.StringNull(),
+		},
+	}
+	found, err := r.readPermission(ctx, &state)
+	if err != nil {
+		errStr := constants.GetSmbSharePermissionErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error importing smb share permission", message)
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Error importing smb share permission",
+			fmt.Sprintf("Could not find a %s permission entry of trustee %s on smb share %s", permissionType, trusteeID, shareName))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// readPermission reads the permission entry of the model from the share, and returns whether it was found.
+func (r *SmbSharePermissionResource) readPermission(ctx context.Context, model *models.SmbSharePermissionResourceModel) (bool, error) {
+	share, err := helper.GetSmbShare(ctx, r.client, model.ShareName.ValueString(), model.Zone.ValueStringPointer())
+	if err != nil {
+		return false, err
+	}
+	if len(share.Shares) == 0 {
+		return false, fmt.Errorf("smb share %s not found", model.ShareName.ValueString())
+	}
+	permission := helper.FindSmbSharePermission(share.Shares[0], model.Trustee, model.PermissionType.ValueString())
+	if permission == nil {
+		return false, nil
+	}
+
+	model.Permission = types.StringValue(permission.Permission)
+	model.Trustee.ID = types.StringValue(permission.Trustee.GetId())
+	// keep the configured name when it only differs by case
+	if !strings.EqualFold(model.Trustee.Name.ValueString(), permission.Trustee.GetName()) {
+		model.Trustee.Name = types.StringValue(permission.Trustee.GetName())
+	}
+	model.Trustee.Type = types.StringValue(permission.Trustee.GetType())
+	model.ID = types.StringValue(fmt.Sprintf("%s:%s:%s:%s", helper.DefaultIfEmpty(model.Zone.ValueString(), "System"),
+		model.ShareName.ValueString(), model.PermissionType.ValueString(), permission.Trustee.GetId()))
+	return true, nil
+}
@@ -0,0 +1,259 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccSmbSharePermissionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + SmbSharePermissionResourceConfig("read"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_smb_share_permission.everyone", "permission", "read"),
+					resource.TestCheckResourceAttr("powerscale_smb_share_permission.everyone", "trustee.id", "SID:S-1-1-0"),
+					resource.TestCheckResourceAttr("powerscale_smb_share_permission.everyone", "id",
+						fmt.Sprintf("System:%s:allow:SID:S-1-1-0", shareName)),
+					resource.TestCheckResourceAttr("powerscale_smb_share_permission.admin", "trustee.name", "admin"),
+					resource.TestCheckResourceAttrSet("powerscale_smb_share_permission.admin", "trustee.id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:  "powerscale_smb_share_permission.everyone",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("System:%s:allow:SID:S-1-1-0", shareName),
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					assert.Equal(t, "read", states[0].Attributes["permission"])
+					assert.Equal(t, "wellknown", states[0].Attributes["trustee.type"])
+					assert.Equal(t, "System", states[0].Attributes["zone"])
+					return nil
+				},
+			},
+			// ImportState testing without zone, the default zone is imported as System
+			{
+				ResourceName:  "powerscale_smb_share_permission.everyone",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s:allow:SID:S-1-1-0", shareName),
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					assert.Equal(t, "System", states[0].Attributes["zone"])
+					assert.Equal(t, fmt.Sprintf("System:%s:allow:SID:S-1-1-0", shareName), states[0].Attributes["id"])
+					return nil
+				},
+			},
+			// Update testing, the share keeps both entries
+			{
+				Config: ProviderConfig + SmbSharePermissionResourceConfig("full"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_smb_share_permission.everyone", "permission", "full"),
+					resource.TestCheckResourceAttr("powerscale_smb_share_permission.admin", "permission", "change"),
+				),
+			},
+			// Update testing of a trustee given by name, the permission is updated in place
+			{
+				Config: ProviderConfig + SmbSharePermissionResourceAdminConfig("full", "read"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("powerscale_smb_share_permission.admin", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_smb_share_permission.admin", "permission", "read"),
+					resource.TestCheckResourceAttr("powerscale_smb_share_permission.admin", "trustee.name", "admin"),
+					resource.TestCheckResourceAttrSet("powerscale_smb_share_permission.admin", "trustee.id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSmbSharePermissionResourceErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid import identifier
+			{
+				Config:        ProviderConfig + SmbSharePermissionResourceConfig("read"),
+				ResourceName:  "powerscale_smb_share_permission.everyone",
+				ImportState:   true,
+				ImportStateId: "invalid",
+				ExpectError:   regexp.MustCompile(`.*invalid identifier*.`),
+			},
+			// Create error
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.SetSmbSharePermission).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SmbSharePermissionResourceConfig("read"),
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			// Read error
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.GetSmbShare).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SmbSharePermissionResourceConfig("read"),
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			// Entry missing after create
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.FindSmbSharePermission).Return(nil).Build()
+				},
+				Config:      ProviderConfig + SmbSharePermissionResourceConfig("read"),
+				ExpectError: regexp.MustCompile(`.*was not found*.`),
+			},
+			// Update error
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+				},
+				Config: ProviderConfig + SmbSharePermissionResourceConfig("read"),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.SetSmbSharePermission).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SmbSharePermissionResourceConfig("full"),
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			// Delete error
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.RemoveSmbSharePermission).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SmbSharePermissionResourceConfig("read"),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+				},
+				Config: ProviderConfig + SmbSharePermissionResourceConfig("read"),
+			},
+		},
+	})
+}
+
+func TestAccSmbSharePermissionResourceRemoved(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + SmbSharePermissionResourceConfig("read"),
+			},
+			// The entry removed outside of Terraform is planned for creation again
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.FindSmbSharePermission).Return(nil).Build()
+				},
+				Config:             ProviderConfig + SmbSharePermissionResourceConfig("read"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+				},
+				Config: ProviderConfig + SmbSharePermissionResourceConfig("read"),
+			},
+		},
+	})
+}
+
+func SmbSharePermissionResourceConfig(permission string) string {
+	return SmbSharePermissionResourceAdminConfig(permission, "change")
+}
+
+func SmbSharePermissionResourceAdminConfig(permission string, adminPermission string) string {
+	return FileSystemResourceConfigCommon4 + fmt.Sprintf(`
+resource "powerscale_smb_share" "share_test" {
+	depends_on = [powerscale_filesystem.file_system_test]
+	auto_create_directory = true
+	name = "%s"
+	path = "/ifs/%s"
+	permissions = []
+	zone = "System"
+	lifecycle {
+		ignore_changes = [permissions]
+	}
+}
+
+resource "powerscale_smb_share_permission" "everyone" {
+	share_name = powerscale_smb_share.share_test.name
+	zone = "System"
+	permission = "%s"
+	permission_type = "allow"
+	trustee = {
+		id = "SID:S-1-1-0"
+	}
+}
+
+resource "powerscale_smb_share_permission" "admin" {
+	share_name = powerscale_smb_share.share_test.name
+	zone = "System"
+	permission = "%s"
+	permission_type = "allow"
+	trustee = {
+		name = "admin"
+		type = "user"
+	}
+}
+`, shareName, shareName, permission, adminPermission)
+}
@@ -0,0 +1,256 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SnapshotChangelistDataSource{}
+
+// changelistIDRegex matches the name OneFS gives to a changelist.
+var changelistIDRegex = regexp.MustCompile(`^\d+_\d+$`)
+
+// NewSnapshotChangelistDataSource creates a new data source.
+func NewSnapshotChangelistDataSource() datasource.DataSource {
+	return &SnapshotChangelistDataSource{}
+}
+
+// SnapshotChangelistDataSource defines the data source implementation.
+type SnapshotChangelistDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *SnapshotChangelistDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snapshot_changelist"
+}
+
+// Schema describes the data source arguments.
+func (d *SnapshotChangelistDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This datasource is used to query the entries of an existing Snapshot Changelist from PowerScale array. " +
+			"A changelist records the files and directories that changed between two snapshots of the same path. " +
+			"The entries are paged through automatically and can be filtered by path prefix and change type.",
+		Description: "This datasource is used to query the entries of an existing Snapshot Changelist from PowerScale array. " +
+			"A changelist records the files and directories that changed between two snapshots of the same path. " +
+			"The entries are paged through automatically and can be filtered by path prefix and change type.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier",
+				MarkdownDescription: "Identifier",
+				Computed:            true,
+			},
+			"changelist_id": schema.StringAttribute{
+				Description:         "The changelist name, in the form <older_snapshot_id>_<newer_snapshot_id>.",
+				MarkdownDescription: "The changelist name, in the form <older_snapshot_id>_<newer_snapshot_id>.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(changelistIDRegex, "must be in the form <older_snapshot_id>_<newer_snapshot_id>"),
+				},
+			},
+			"entries": schema.ListNestedAttribute{
+				Description:         "List of changelist entries.",
+				MarkdownDescription: "List of changelist entries.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"lin": schema.Int64Attribute{
+							Description:         "The LIN of the entry.",
+							MarkdownDescription: "The LIN of the entry.",
+							Computed:            true,
+						},
+						"parent_lin": schema.Int64Attribute{
+							Description:         "The LIN of the parent directory of the entry.",
+							MarkdownDescription: "The LIN of the parent directory of the entry.",
+							Computed:            true,
+						},
+						"path": schema.StringAttribute{
+							Description:         "The path of the entry.",
+							MarkdownDescription: "The path of the entry.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							Description:         "The file type of the entry.",
+							MarkdownDescription: "The file type of the entry.",
+							Computed:            true,
+						},
+						"change_types": schema.ListAttribute{
+							Description:         "The changes recorded for the entry. Possible values are added, removed, path_changed, modified, ads, has_ads, symlink and hardlinks.",
+							MarkdownDescription: "The changes recorded for the entry. Possible values are `added`, `removed`, `path_changed`, `modified`, `ads`, `has_ads`, `symlink` and `hardlinks`.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"size": schema.Int64Attribute{
+							Description:         "The logical size of the entry in bytes.",
+							MarkdownDescription: "The logical size of the entry in bytes.",
+							Computed:            true,
+						},
+						"phys_size": schema.Int64Attribute{
+							Description:         "The physical size of the entry in bytes.",
+							MarkdownDescription: "The physical size of the entry in bytes.",
+							Computed:            true,
+						},
+						"atime": schema.Int64Attribute{
+							Description:         "The Unix Epoch time the entry was last accessed.",
+							MarkdownDescription: "The Unix Epoch time the entry was last accessed.",
+							Computed:            true,
+						},
+						"ctime": schema.Int64Attribute{
+							Description:         "The Unix Epoch time the entry metadata was last changed.",
+							MarkdownDescription: "The Unix Epoch time the entry metadata was last changed.",
+							Computed:            true,
+						},
+						"mtime": schema.Int64Attribute{
+							Description:         "The Unix Epoch time the entry data was last modified.",
+							MarkdownDescription: "The Unix Epoch time the entry data was last modified.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"path_prefix": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Only return entries at or below this path, e.g. /ifs/data/projects.",
+						MarkdownDescription: "Only return entries at or below this path, e.g. `/ifs/data/projects`.",
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"change_types": schema.SetAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						Description:         "Only return entries with at least one of these change types.",
+						MarkdownDescription: "Only return entries with at least one of these change types.",
+						Validators: []validator.Set{
+							setvalidator.ValueStringsAre(stringvalidator.OneOf(helper.ChangelistChangeTypeNames()...)),
+						},
+					},
+					"limit": schema.Int64Attribute{
+						Optional:            true,
+						Description:         "Return no more than this many entries in total.",
+						MarkdownDescription: "Return no more than this many entries in total.",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"page_size": schema.Int32Attribute{
+						Optional:            true,
+						Description:         "The number of entries requested from the array per page. Defaults to 1000.",
+						MarkdownDescription: "The number of entries requested from the array per page. Defaults to 1000.",
+						Validators: []validator.Int32{
+							int32validator.Between(1, 10000),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *SnapshotChangelistDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *SnapshotChangelistDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state models.SnapshotChangelistDataSourceModel
+	var plan models.SnapshotChangelistDataSourceModel
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := helper.GetSnapshotChangelistEntries(ctx, d.client, plan.ChangelistID.ValueString(), plan.Filter)
+	if err != nil {
+		errStr := constants.ReadSnapshotChangelistErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error getting the snapshot changelist entries",
+			message,
+		)
+		return
+	}
+
+	// Do the TF Mapping
+	state.Entries = []models.SnapshotChangelistEntry{}
+	for _, lin := range result {
+		entry, err := helper.SnapshotChangelistEntryMapper(ctx, lin)
+		if err != nil {
+			errStr := constants.ReadSnapshotChangelistErrorMsg + "with error: "
+			message := helper.GetErrorString(err, errStr)
+			resp.Diagnostics.AddError(
+				"Error getting the snapshot changelist entries",
+				message,
+			)
+			return
+		}
+		state.Entries = append(state.Entries, entry)
+	}
+
+	// save into the Terraform state.
+	state.ID = types.StringValue("snapshot_changelist_datasource")
+	state.ChangelistID = plan.ChangelistID
+	state.Filter = plan.Filter
+
+	tflog.Trace(ctx, "read the snapshot changelist datasource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,121 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSnapshotChangelistDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read all entries
+			{
+				Config: ProviderConfig + SnapshotChangelistDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerscale_snapshot_changelist.all", "entries.#"),
+				),
+			},
+			// Read with filter
+			{
+				Config: ProviderConfig + SnapshotChangelistDataSourceFilterConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerscale_snapshot_changelist.filtered", "entries.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSnapshotChangelistDataSourceInvalidID(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + SnapshotChangelistDataSourceInvalidConfig,
+				ExpectError: regexp.MustCompile(`.*must be in the form*.`),
+			},
+		},
+	})
+}
+
+func TestAccSnapshotChangelistDataSourceErrorRead(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.GetSnapshotChangelistEntries).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SnapshotChangelistDataSourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.SnapshotChangelistEntryMapper).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SnapshotChangelistDataSourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + SnapshotChangelistDataSourceConfig,
+			},
+		},
+	})
+}
+
+var SnapshotChangelistDataSourceConfig = SnapshotChangelistResourceConfig + `
+data "powerscale_snapshot_changelist" "all" {
+  changelist_id = powerscale_snapshot_changelist.test.id
+}
+`
+
+var SnapshotChangelistDataSourceFilterConfig = SnapshotChangelistResourceConfig + `
+data "powerscale_snapshot_changelist" "filtered" {
+  changelist_id = powerscale_snapshot_changelist.test.id
+  filter {
+    path_prefix  = "/ifs/tfacc_file_system_test/does_not_exist"
+    change_types = ["added", "modified"]
+    page_size    = 10
+    limit        = 100
+  }
+}
+`
+
+var SnapshotChangelistDataSourceInvalidConfig = `
+data "powerscale_snapshot_changelist" "invalid" {
+  changelist_id = "invalid"
+}
+`
@@ -0,0 +1,274 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &SnapshotChangelistResource{}
+	_ resource.ResourceWithConfigure   = &SnapshotChangelistResource{}
+	_ resource.ResourceWithImportState = &SnapshotChangelistResource{}
+)
+
+// NewSnapshotChangelistResource creates a new resource.
+func NewSnapshotChangelistResource() resource.Resource {
+	return &SnapshotChangelistResource{}
+}
+
+// SnapshotChangelistResource defines the resource implementation.
+type SnapshotChangelistResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *SnapshotChangelistResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snapshot_changelist"
+}
+
+// Schema describes the resource arguments.
+func (r *SnapshotChangelistResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource is used to manage the Snapshot Changelist entity of PowerScale Array. A changelist records the differences between two snapshots of the same path. " +
+			"Creating this resource launches a ChangelistCreate job for the snapshot pair and waits for the job to finish. We can Create, Import and Delete the changelist using this resource. " +
+			"The entries of the changelist can be read using the powerscale_snapshot_changelist datasource.",
+		Description: "This resource is used to manage the Snapshot Changelist entity of PowerScale Array. A changelist records the differences between two snapshots of the same path. " +
+			"Creating this resource launches a ChangelistCreate job for the snapshot pair and waits for the job to finish. We can Create, Import and Delete the changelist using this resource. " +
+			"The entries of the changelist can be read using the powerscale_snapshot_changelist datasource.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "The changelist name, in the form <older_snapshot_id>_<newer_snapshot_id>.",
+				MarkdownDescription: "The changelist name, in the form <older_snapshot_id>_<newer_snapshot_id>.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"older_snapshot_id": schema.Int64Attribute{
+				Description:         "The ID of the older snapshot of the pair.",
+				MarkdownDescription: "The ID of the older snapshot of the pair.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"newer_snapshot_id": schema.Int64Attribute{
+				Description:         "The ID of the newer snapshot of the pair. Must be greater than older_snapshot_id.",
+				MarkdownDescription: "The ID of the newer snapshot of the pair. Must be greater than older_snapshot_id.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"retain_repstate": schema.BoolAttribute{
+				Description:         "Whether to retain the repstate of the snapshots after the changelist is created.",
+				MarkdownDescription: "Whether to retain the repstate of the snapshots after the changelist is created.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"allow_dup": schema.BoolAttribute{
+				Description:         "Whether or not to queue the job if one of the same type is already running or queued.",
+				MarkdownDescription: "Whether or not to queue the job if one of the same type is already running or queued.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"job_id": schema.Int64Attribute{
+				Description:         "The ID of the ChangelistCreate job.",
+				MarkdownDescription: "The ID of the ChangelistCreate job.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"num_entries": schema.Int64Attribute{
+				Description:         "The number of entries in the changelist.",
+				MarkdownDescription: "The number of entries in the changelist.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"root_path": schema.StringAttribute{
+				Description:         "The root path of the snapshots the changelist was computed for.",
+				MarkdownDescription: "The root path of the snapshots the changelist was computed for.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description:         "The status of the changelist.",
+				MarkdownDescription: "The status of the changelist.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *SnapshotChangelistResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allows to create a snapshot changelist.
+func (r *SnapshotChangelistResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating snapshot changelist")
+
+	var plan models.SnapshotChangelistResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := helper.CreateSnapshotChangelist(ctx, r.client, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Create snapshot changelist")
+}
+
+// Read allows to read a snapshot changelist.
+func (r *SnapshotChangelistResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading snapshot changelist")
+
+	var state models.SnapshotChangelistResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	changelist, httpResp, err := helper.GetSnapshotChangelist(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, helper.ErrSnapshotChangelistNotFound) || (httpResp != nil && httpResp.StatusCode == http.StatusNotFound) {
+			// changelist was removed outside of terraform
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		errStr := constants.ReadSnapshotChangelistErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error reading snapshot changelist",
+			message,
+		)
+		return
+	}
+
+	if err := helper.UpdateSnapshotChangelistState(ctx, &state, changelist); err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading snapshot changelist",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Read snapshot changelist")
+}
+
+// Update is not supported, every configurable attribute requires replacement.
+func (r *SnapshotChangelistResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating snapshot changelist")
+
+	var plan models.SnapshotChangelistResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with Update snapshot changelist")
+}
+
+// Delete allows to delete a snapshot changelist.
+func (r *SnapshotChangelistResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting snapshot changelist")
+
+	var state models.SnapshotChangelistResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.DeleteSnapshotChangelist(ctx, r.client, state.ID.ValueString()); err != nil {
+		errStr := constants.DeleteSnapshotChangelistErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error deleting snapshot changelist",
+			message,
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with Delete snapshot changelist")
+}
+
+// ImportState imports a snapshot changelist by its name.
+func (r *SnapshotChangelistResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,165 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSnapshotChangelistResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + SnapshotChangelistResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("powerscale_snapshot_changelist.test", "older_snapshot_id", "powerscale_snapshot.older", "id"),
+					resource.TestCheckResourceAttrPair("powerscale_snapshot_changelist.test", "newer_snapshot_id", "powerscale_snapshot.newer", "id"),
+					resource.TestCheckResourceAttrSet("powerscale_snapshot_changelist.test", "job_id"),
+					resource.TestCheckResourceAttrSet("powerscale_snapshot_changelist.test", "num_entries"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "powerscale_snapshot_changelist.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"job_id", "allow_dup", "retain_repstate"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccSnapshotChangelistResourceInvalidPair(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + SnapshotChangelistResourceInvalidPairConfig,
+				ExpectError: regexp.MustCompile(`.*must be less than newer_snapshot_id*.`),
+			},
+		},
+	})
+}
+
+func TestAccSnapshotChangelistResourceErrorCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.CreateSnapshotRestoreJob).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SnapshotChangelistResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+		},
+	})
+}
+
+func TestAccSnapshotChangelistResourceErrorRead(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+				},
+				Config: ProviderConfig + SnapshotChangelistResourceConfig,
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetSnapshotChangelist).Return(nil, nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SnapshotChangelistResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			// changelist removed outside of terraform is dropped from the state
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetSnapshotChangelist).Return(nil, nil, fmt.Errorf("%w: 1_2", helper.ErrSnapshotChangelistNotFound)).Build()
+				},
+				Config:             ProviderConfig + SnapshotChangelistResourceConfig,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.DeleteSnapshotChangelist).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SnapshotChangelistResourceConfig,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + SnapshotChangelistResourceConfig,
+			},
+		},
+	})
+}
+
+var SnapshotChangelistSnapshotsConfig = FileSystemResourceConfigCommon + `
+resource "powerscale_snapshot" "older" {
+  path = "/ifs/tfacc_file_system_test"
+  name = "tfacc_changelist_older"
+  depends_on = [powerscale_filesystem.file_system_test]
+}
+
+resource "powerscale_snapshot" "newer" {
+  path = "/ifs/tfacc_file_system_test"
+  name = "tfacc_changelist_newer"
+  depends_on = [powerscale_snapshot.older]
+}
+`
+
+var SnapshotChangelistResourceConfig = SnapshotChangelistSnapshotsConfig + `
+resource "powerscale_snapshot_changelist" "test" {
+  older_snapshot_id = powerscale_snapshot.older.id
+  newer_snapshot_id = powerscale_snapshot.newer.id
+  allow_dup         = true
+}
+`
+
+var SnapshotChangelistResourceInvalidPairConfig = SnapshotChangelistSnapshotsConfig + `
+resource "powerscale_snapshot_changelist" "test" {
+  older_snapshot_id = powerscale_snapshot.newer.id
+  newer_snapshot_id = powerscale_snapshot.older.id
+}
+`
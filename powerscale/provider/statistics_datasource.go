@@ -0,0 +1,224 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &StatisticsDataSource{}
+
+// NewStatisticsDataSource creates a new data source.
+func NewStatisticsDataSource() datasource.DataSource {
+	return &StatisticsDataSource{}
+}
+
+// StatisticsDataSource defines the data source implementation.
+type StatisticsDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *StatisticsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_statistics"
+}
+
+// Schema describes the data source arguments.
+func (d *StatisticsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This datasource is used to query the current or historical values of performance and capacity statistics of the PowerScale cluster." +
+			" The values can be used to gate changes on the current cluster load. The valid keys can be discovered with the `powerscale_statistics_keys` datasource.",
+		Description: "This datasource is used to query the current or historical values of performance and capacity statistics of the PowerScale cluster." +
+			" The values can be used to gate changes on the current cluster load. The valid keys can be discovered with the powerscale_statistics_keys datasource.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier",
+				MarkdownDescription: "Identifier",
+				Computed:            true,
+			},
+			"keys": schema.ListAttribute{
+				Description:         "Statistics keys to query, ex. ifs.bytes.used, node.cpu.user.avg or node.protostats.nfs.total.",
+				MarkdownDescription: "Statistics keys to query, ex. `ifs.bytes.used`, `node.cpu.user.avg` or `node.protostats.nfs.total`.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"nodes": schema.SetAttribute{
+				Description:         "Node device IDs to query, 0 being the cluster. By default all the nodes the keys apply to are queried.",
+				MarkdownDescription: "Node device IDs to query, `0` being the cluster. By default all the nodes the keys apply to are queried.",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+			},
+			"history_window": schema.Int64Attribute{
+				Description:         "Number of seconds of history to query. The current values are queried when not set.",
+				MarkdownDescription: "Number of seconds of history to query. The current values are queried when not set.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, math.MaxInt32),
+				},
+			},
+			"resolution": schema.Int64Attribute{
+				Description:         "Minimum interval in seconds between the historical values. Requires history_window.",
+				MarkdownDescription: "Minimum interval in seconds between the historical values. Requires `history_window`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, math.MaxInt32),
+					int64validator.AlsoRequires(path.MatchRoot("history_window")),
+				},
+			},
+			"statistics": schema.ListNestedAttribute{
+				Description:         "Values of the statistics keys, one per key and node.",
+				MarkdownDescription: "Values of the statistics keys, one per key and node.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Description:         "Statistics key.",
+							MarkdownDescription: "Statistics key.",
+							Computed:            true,
+						},
+						"devid": schema.Int64Attribute{
+							Description:         "Node device ID of the value, 0 for the cluster.",
+							MarkdownDescription: "Node device ID of the value, `0` for the cluster.",
+							Computed:            true,
+						},
+						"time": schema.Int64Attribute{
+							Description:         "Time of the latest value, in Unix epoch seconds.",
+							MarkdownDescription: "Time of the latest value, in Unix epoch seconds.",
+							Computed:            true,
+						},
+						"value": schema.StringAttribute{
+							Description:         "Latest value as returned by the cluster. Values that are not scalar are JSON encoded.",
+							MarkdownDescription: "Latest value as returned by the cluster. Values that are not scalar are JSON encoded.",
+							Computed:            true,
+						},
+						"value_number": schema.Float64Attribute{
+							Description:         "Latest value as a number, null if the value is not numeric.",
+							MarkdownDescription: "Latest value as a number, null if the value is not numeric.",
+							Computed:            true,
+						},
+						"values": schema.ListNestedAttribute{
+							Description:         "Historical values, oldest first. Only set when history_window is set.",
+							MarkdownDescription: "Historical values, oldest first. Only set when `history_window` is set.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"time": schema.Int64Attribute{
+										Description:         "Time of the value, in Unix epoch seconds.",
+										MarkdownDescription: "Time of the value, in Unix epoch seconds.",
+										Computed:            true,
+									},
+									"value": schema.StringAttribute{
+										Description:         "Value as returned by the cluster. Values that are not scalar are JSON encoded.",
+										MarkdownDescription: "Value as returned by the cluster. Values that are not scalar are JSON encoded.",
+										Computed:            true,
+									},
+									"value_number": schema.Float64Attribute{
+										Description:         "Value as a number, null if the value is not numeric.",
+										MarkdownDescription: "Value as a number, null if the value is not numeric.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+						"error": schema.StringAttribute{
+							Description:         "Error message, if the value could not be queried.",
+							MarkdownDescription: "Error message, if the value could not be queried.",
+							Computed:            true,
+						},
+						"error_code": schema.Int64Attribute{
+							Description:         "Error code, if the value could not be queried.",
+							MarkdownDescription: "Error code, if the value could not be queried.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *StatisticsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *StatisticsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading statistics data source")
+
+	var state models.StatisticsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	statistics, err := helper.GetStatistics(ctx, d.client, state)
+	if err != nil {
+		errStr := constants.ReadStatisticsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading statistics", message)
+		return
+	}
+	if statistics == nil {
+		statistics = []models.StatisticModel{}
+	}
+
+	state.Statistics = statistics
+	state.ID = types.StringValue("statistics_datasource")
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading statistics data source")
+}
@@ -0,0 +1,129 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccStatisticsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// read current values
+			{
+				Config: ProviderConfig + StatisticsDataSourceCurrentConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerscale_statistics.current", "statistics.#", "1"),
+					resource.TestCheckResourceAttr("data.powerscale_statistics.current", "statistics.0.key", "ifs.bytes.used"),
+					resource.TestCheckResourceAttr("data.powerscale_statistics.current", "statistics.0.devid", "0"),
+					resource.TestCheckResourceAttrSet("data.powerscale_statistics.current", "statistics.0.value"),
+					resource.TestCheckResourceAttrSet("data.powerscale_statistics.current", "statistics.0.value_number"),
+					resource.TestCheckResourceAttr("data.powerscale_statistics.current", "statistics.0.values.#", "0"),
+				),
+			},
+			// read historical values
+			{
+				Config: ProviderConfig + StatisticsDataSourceHistoryConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerscale_statistics.history", "statistics.#"),
+					resource.TestCheckResourceAttr("data.powerscale_statistics.history", "statistics.0.key", "node.cpu.user.avg"),
+					resource.TestCheckResourceAttr("data.powerscale_statistics.history", "statistics.0.devid", "1"),
+					resource.TestCheckResourceAttrSet("data.powerscale_statistics.history", "statistics.0.values.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStatisticsDataSourceConfigErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + StatisticsDataSourceEmptyKeysConfig,
+				ExpectError: regexp.MustCompile(`.*Attribute keys list must contain at least 1 elements*.`),
+			},
+			{
+				Config:      ProviderConfig + StatisticsDataSourceResolutionConfig,
+				ExpectError: regexp.MustCompile(`.*Attribute "history_window" must be specified when "resolution" is specified*.`),
+			},
+		},
+	})
+}
+
+func TestAccStatisticsDataSourceGettingErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.GetStatistics).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + StatisticsDataSourceCurrentConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + StatisticsDataSourceCurrentConfig,
+			},
+		},
+	})
+}
+
+var StatisticsDataSourceCurrentConfig = `
+data "powerscale_statistics" "current" {
+	keys = ["ifs.bytes.used"]
+}
+`
+
+var StatisticsDataSourceHistoryConfig = `
+data "powerscale_statistics" "history" {
+	keys           = ["node.cpu.user.avg"]
+	nodes          = [1]
+	history_window = 600
+	resolution     = 60
+}
+`
+
+var StatisticsDataSourceEmptyKeysConfig = `
+data "powerscale_statistics" "test" {
+	keys = []
+}
+`
+
+var StatisticsDataSourceResolutionConfig = `
+data "powerscale_statistics" "test" {
+	keys       = ["ifs.bytes.used"]
+	resolution = 60
+}
+`
@@ -0,0 +1,185 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &StatisticsKeysDataSource{}
+
+// NewStatisticsKeysDataSource creates a new data source.
+func NewStatisticsKeysDataSource() datasource.DataSource {
+	return &StatisticsKeysDataSource{}
+}
+
+// StatisticsKeysDataSource defines the data source implementation.
+type StatisticsKeysDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *StatisticsKeysDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_statistics_keys"
+}
+
+// Schema describes the data source arguments.
+func (d *StatisticsKeysDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This datasource is used to discover the statistics keys supported by the OneFS version of the PowerScale cluster, which can be queried with the `powerscale_statistics` datasource.",
+		Description:         "This datasource is used to discover the statistics keys supported by the OneFS version of the PowerScale cluster, which can be queried with the powerscale_statistics datasource.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier",
+				MarkdownDescription: "Identifier",
+				Computed:            true,
+			},
+			"statistics_keys": schema.ListNestedAttribute{
+				Description:         "List of statistics keys.",
+				MarkdownDescription: "List of statistics keys.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Description:         "Name of the key.",
+							MarkdownDescription: "Name of the key.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							Description:         "Description of the key.",
+							MarkdownDescription: "Description of the key.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							Description:         "Type of the value of the key.",
+							MarkdownDescription: "Type of the value of the key.",
+							Computed:            true,
+						},
+						"units": schema.StringAttribute{
+							Description:         "Units of the value of the key.",
+							MarkdownDescription: "Units of the value of the key.",
+							Computed:            true,
+						},
+						"scope": schema.StringAttribute{
+							Description:         "Scope of the key, ex. node or cluster.",
+							MarkdownDescription: "Scope of the key, ex. `node` or `cluster`.",
+							Computed:            true,
+						},
+						"aggregation_type": schema.StringAttribute{
+							Description:         "Aggregation type of the key.",
+							MarkdownDescription: "Aggregation type of the key.",
+							Computed:            true,
+						},
+						"default_cache_time": schema.Int64Attribute{
+							Description:         "Default cache time of the key in seconds.",
+							MarkdownDescription: "Default cache time of the key in seconds.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"keys": schema.SetAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						Description:         "Filter the statistics keys by name.",
+						MarkdownDescription: "Filter the statistics keys by name.",
+					},
+					"substr": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Filter the statistics keys whose name contains this substring.",
+						MarkdownDescription: "Filter the statistics keys whose name contains this substring.",
+					},
+					"queryable": schema.BoolAttribute{
+						Optional:            true,
+						Description:         "Only list the keys that can be queried.",
+						MarkdownDescription: "Only list the keys that can be queried.",
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *StatisticsKeysDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *StatisticsKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading statistics keys data source")
+
+	var state models.StatisticsKeysDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keys, err := helper.GetStatisticsKeys(ctx, d.client, state.Filter)
+	if err != nil {
+		errStr := constants.ReadStatisticsKeysErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading statistics keys", message)
+		return
+	}
+
+	state.Keys, err = helper.FilterStatisticsKeys(ctx, keys, state.Filter)
+	if err != nil {
+		resp.Diagnostics.AddError("Error filtering statistics keys", err.Error())
+		return
+	}
+	if state.Keys == nil {
+		state.Keys = []models.StatisticsKeyModel{}
+	}
+
+	state.ID = types.StringValue("statistics_keys_datasource")
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading statistics keys data source")
+}
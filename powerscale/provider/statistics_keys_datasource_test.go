@@ -0,0 +1,115 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccStatisticsKeysDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// read all
+			{
+				Config: ProviderConfig + StatisticsKeysDataSourceAllConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerscale_statistics_keys.all", "statistics_keys.#"),
+				),
+			},
+			// read with filter
+			{
+				Config: ProviderConfig + StatisticsKeysDataSourceFilterConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerscale_statistics_keys.filtering", "statistics_keys.#", "1"),
+					resource.TestCheckResourceAttr("data.powerscale_statistics_keys.filtering", "statistics_keys.0.key", "ifs.bytes.used"),
+					resource.TestCheckResourceAttrSet("data.powerscale_statistics_keys.filtering", "statistics_keys.0.description"),
+				),
+			},
+			// read with substring
+			{
+				Config: ProviderConfig + StatisticsKeysDataSourceSubstrConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerscale_statistics_keys.substr", "statistics_keys.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStatisticsKeysDataSourceGettingErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.GetStatisticsKeys).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + StatisticsKeysDataSourceAllConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.FilterStatisticsKeys).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + StatisticsKeysDataSourceAllConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + StatisticsKeysDataSourceAllConfig,
+			},
+		},
+	})
+}
+
+var StatisticsKeysDataSourceAllConfig = `
+data "powerscale_statistics_keys" "all" {
+}
+`
+
+var StatisticsKeysDataSourceFilterConfig = `
+data "powerscale_statistics_keys" "filtering" {
+	filter {
+		keys = ["ifs.bytes.used"]
+	}
+}
+`
+
+var StatisticsKeysDataSourceSubstrConfig = `
+data "powerscale_statistics_keys" "substr" {
+	filter {
+		substr    = "node.cpu"
+		queryable = true
+	}
+}
+`
@@ -0,0 +1,335 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource               = &SyncIQFailoverResource{}
+	_ resource.ResourceWithConfigure  = &SyncIQFailoverResource{}
+	_ resource.ResourceWithModifyPlan = &SyncIQFailoverResource{}
+)
+
+// NewSyncIQFailoverResource creates a new resource.
+func NewSyncIQFailoverResource() resource.Resource {
+	return &SyncIQFailoverResource{}
+}
+
+// SyncIQFailoverResource defines the resource implementation.
+type SyncIQFailoverResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *SyncIQFailoverResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_synciq_failover"
+}
+
+// Schema describes the resource arguments.
+func (r *SyncIQFailoverResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource is used to orchestrate the SyncIQ failover and failback workflow of a policy between two PowerScale clusters. " +
+			"The resource is managed through the provider of the source cluster, the target cluster is reached with the connection details of the `target_cluster` attribute, " +
+			"which are usually the same values the aliased provider of the target cluster is configured with. " +
+			"Failover allows writes on the target cluster. Failback runs resync_prep on the source cluster, runs the mirror policy on the target cluster, allows writes on the source cluster " +
+			"and runs resync_prep for the mirror policy on the target cluster. Each completed step is recorded in the state and a failed workflow resumes from the last completed step on the next apply. Steps whose effect is already visible in the policy states, ex. after a failed create replaces the resource, are skipped. " +
+			"Destroying this resource only removes it from the state, it does not revert the workflow.",
+		Description: "This resource is used to orchestrate the SyncIQ failover and failback workflow of a policy between two PowerScale clusters. " +
+			"The resource is managed through the provider of the source cluster, the target cluster is reached with the connection details of the target_cluster attribute, " +
+			"which are usually the same values the aliased provider of the target cluster is configured with. " +
+			"Failover allows writes on the target cluster. Failback runs resync_prep on the source cluster, runs the mirror policy on the target cluster, allows writes on the source cluster " +
+			"and runs resync_prep for the mirror policy on the target cluster. Each completed step is recorded in the state and a failed workflow resumes from the last completed step on the next apply. Steps whose effect is already visible in the policy states, ex. after a failed create replaces the resource, are skipped. " +
+			"Destroying this resource only removes it from the state, it does not revert the workflow.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Name of the SyncIQ policy.",
+				MarkdownDescription: "Name of the SyncIQ policy.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy_name": schema.StringAttribute{
+				Description:         "Name of the SyncIQ policy on the source cluster.",
+				MarkdownDescription: "Name of the SyncIQ policy on the source cluster.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mirror_policy_name": schema.StringAttribute{
+				Description:         "Name of the mirror policy that resync_prep creates on the target cluster. Defaults to <policy_name>_mirror.",
+				MarkdownDescription: "Name of the mirror policy that resync_prep creates on the target cluster. Defaults to `<policy_name>_mirror`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"direction": schema.StringAttribute{
+				Description:         "The workflow to drive. failover allows writes on the target cluster, failback returns the writes to the source cluster. Changing the direction runs the corresponding workflow.",
+				MarkdownDescription: "The workflow to drive. `failover` allows writes on the target cluster, `failback` returns the writes to the source cluster. Changing the direction runs the corresponding workflow.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(helper.SyncIQFailoverDirection, helper.SyncIQFailbackDirection),
+				},
+			},
+			"sync_before_failover": schema.BoolAttribute{
+				Description:         "Whether to run the policy on the source cluster before allowing writes on the target cluster. Use it for planned failovers.",
+				MarkdownDescription: "Whether to run the policy on the source cluster before allowing writes on the target cluster. Use it for planned failovers.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"job_timeout": schema.Int64Attribute{
+				Description:         "Time in seconds to wait for each job of the workflow to end.",
+				MarkdownDescription: "Time in seconds to wait for each job of the workflow to end.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(3600),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"target_cluster": schema.SingleNestedAttribute{
+				Description:         "Connection details of the target cluster of the policy.",
+				MarkdownDescription: "Connection details of the target cluster of the policy.",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"endpoint": schema.StringAttribute{
+						Description:         "The API endpoint of the target cluster, ex. https://172.17.177.231:8080.",
+						MarkdownDescription: "The API endpoint of the target cluster, ex. https://172.17.177.231:8080.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"username": schema.StringAttribute{
+						Description:         "The username of the target cluster.",
+						MarkdownDescription: "The username of the target cluster.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"password": schema.StringAttribute{
+						Description:         "The password of the target cluster.",
+						MarkdownDescription: "The password of the target cluster.",
+						Required:            true,
+						Sensitive:           true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"insecure": schema.BoolAttribute{
+						Description:         "whether to skip SSL validation of the target cluster.",
+						MarkdownDescription: "whether to skip SSL validation of the target cluster.",
+						Optional:            true,
+					},
+					"auth_type": schema.Int64Attribute{
+						Description:         "what should be the auth type, 0 for basic and 1 for session-based. Defaults to 1.",
+						MarkdownDescription: "what should be the auth type, 0 for basic and 1 for session-based. Defaults to 1.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.OneOf(0, 1),
+						},
+					},
+					"timeout": schema.Int64Attribute{
+						Description:         "specifies a time limit for requests to the target cluster. Defaults to 2000.",
+						MarkdownDescription: "specifies a time limit for requests to the target cluster. Defaults to 2000.",
+						Optional:            true,
+					},
+				},
+			},
+			"completed_steps": schema.ListAttribute{
+				Description:         "Steps of the current workflow that have completed, in order.",
+				MarkdownDescription: "Steps of the current workflow that have completed, in order.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"last_completed_step": schema.StringAttribute{
+				Description:         "Last step of the current workflow that has completed.",
+				MarkdownDescription: "Last step of the current workflow that has completed.",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				Description:         "Status of the current workflow, complete or incomplete.",
+				MarkdownDescription: "Status of the current workflow, `complete` or `incomplete`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *SyncIQFailoverResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// ModifyPlan plans an update while the workflow recorded in the state is incomplete, so that the next apply resumes it.
+func (r *SyncIQFailoverResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to resume on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state models.SyncIQFailoverResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Status.ValueString() != helper.SyncIQFailoverStatusComplete {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("last_completed_step"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("completed_steps"), types.ListUnknown(types.StringType))...)
+	}
+}
+
+// Create runs the workflow of the configured direction.
+func (r *SyncIQFailoverResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating SyncIQ failover")
+
+	var plan models.SyncIQFailoverResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	targetClient, diags := helper.NewSyncIQFailoverTargetClient(ctx, plan.TargetCluster)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	completed, diags := helper.RunSyncIQFailoverWorkflow(ctx, r.client, targetClient, plan, []string{})
+	resp.Diagnostics.Append(diags...)
+
+	// record the progress even if a step failed
+	resp.Diagnostics.Append(helper.UpdateSyncIQFailoverState(ctx, &plan, completed)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with Create SyncIQ failover")
+}
+
+// Read keeps the recorded workflow progress, the workflow steps leave nothing behind to read back.
+func (r *SyncIQFailoverResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading SyncIQ failover")
+
+	var state models.SyncIQFailoverResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Read SyncIQ failover")
+}
+
+// Update runs the workflow when the direction changes, or resumes an incomplete workflow.
+func (r *SyncIQFailoverResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating SyncIQ failover")
+
+	var plan, state models.SyncIQFailoverResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	completed := []string{}
+	if plan.Direction.Equal(state.Direction) {
+		if state.Status.ValueString() == helper.SyncIQFailoverStatusComplete {
+			// only the settings of the resource changed
+			plan.CompletedSteps = state.CompletedSteps
+			plan.LastCompletedStep = state.LastCompletedStep
+			plan.Status = state.Status
+			plan.ID = state.ID
+			resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+			return
+		}
+		// resume the incomplete workflow
+		resp.Diagnostics.Append(state.CompletedSteps.ElementsAs(ctx, &completed, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	targetClient, diags := helper.NewSyncIQFailoverTargetClient(ctx, plan.TargetCluster)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	completed, diags = helper.RunSyncIQFailoverWorkflow(ctx, r.client, targetClient, plan, completed)
+	resp.Diagnostics.Append(diags...)
+
+	// record the progress even if a step failed
+	resp.Diagnostics.Append(helper.UpdateSyncIQFailoverState(ctx, &plan, completed)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with Update SyncIQ failover")
+}
+
+// Delete removes the resource from the state without reverting the workflow.
+func (r *SyncIQFailoverResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting SyncIQ failover")
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with Delete SyncIQ failover")
+}
@@ -0,0 +1,243 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+	"testing"
+	"time"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSyncIQFailoverResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// failover
+			{
+				Config: ProviderConfig + SetupReplication() + syncIQFailoverConfig("failover"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_synciq_failover.test", "id", "TerraformPolicy"),
+					resource.TestCheckResourceAttr("powerscale_synciq_failover.test", "mirror_policy_name", "TerraformPolicy_mirror"),
+					resource.TestCheckResourceAttr("powerscale_synciq_failover.test", "status", "complete"),
+					resource.TestCheckResourceAttr("powerscale_synciq_failover.test", "last_completed_step", "allow_write_on_target"),
+				),
+			},
+			// failback
+			{
+				Config: ProviderConfig + SetupReplication() + syncIQFailoverConfig("failback"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_synciq_failover.test", "status", "complete"),
+					resource.TestCheckResourceAttr("powerscale_synciq_failover.test", "completed_steps.#", "4"),
+					resource.TestCheckResourceAttr("powerscale_synciq_failover.test", "last_completed_step", "resync_prep_mirror_on_target"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSyncIQFailoverResourceResume(t *testing.T) {
+	failStep := ""
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.RunSyncIQFailoverStep).To(func(_ context.Context, _ *client.Client, step helper.SyncIQFailoverStep, _ time.Duration) error {
+						if step.Name == failStep {
+							return fmt.Errorf("mock error")
+						}
+						return nil
+					}).Build()
+				},
+				Config: ProviderConfig + syncIQFailoverConfig("failover"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_synciq_failover.test", "status", "complete"),
+				),
+			},
+			// failback fails on the third step
+			{
+				PreConfig: func() {
+					failStep = "allow_write_mirror_on_source"
+				},
+				Config:      ProviderConfig + syncIQFailoverConfig("failback"),
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			// the next apply resumes from the failed step
+			{
+				PreConfig: func() {
+					failStep = ""
+				},
+				Config: ProviderConfig + syncIQFailoverConfig("failback"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_synciq_failover.test", "status", "complete"),
+					resource.TestCheckResourceAttr("powerscale_synciq_failover.test", "completed_steps.#", "4"),
+					resource.TestCheckResourceAttr("powerscale_synciq_failover.test", "completed_steps.2", "allow_write_mirror_on_source"),
+				),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config:  ProviderConfig + syncIQFailoverConfig("failback"),
+				Destroy: true,
+			},
+		},
+	})
+}
+
+func TestAccSyncIQFailoverResourceCreateResume(t *testing.T) {
+	failStep := "allow_write_on_target"
+	ran := map[string]bool{}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// failover fails on create, the progress is kept in the tainted resource
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.RunSyncIQFailoverStep).To(func(_ context.Context, _ *client.Client, step helper.SyncIQFailoverStep, _ time.Duration) error {
+						if step.Name == failStep {
+							return fmt.Errorf("mock error")
+						}
+						ran[step.Name] = true
+						return nil
+					}).Build()
+					FunctionMocker2 = mockey.Mock(helper.IsSyncIQFailoverStepDone).To(func(_ context.Context, _, _ *client.Client, _ models.SyncIQFailoverResourceModel, step helper.SyncIQFailoverStep) (bool, error) {
+						return ran[step.Name], nil
+					}).Build()
+				},
+				Config:      ProviderConfig + syncIQFailoverSyncConfig(),
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			// the replacement skips the step that already took effect
+			{
+				PreConfig: func() {
+					failStep = "run_policy_on_source"
+				},
+				Config: ProviderConfig + syncIQFailoverSyncConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_synciq_failover.test", "status", "complete"),
+					resource.TestCheckResourceAttr("powerscale_synciq_failover.test", "completed_steps.#", "2"),
+					resource.TestCheckResourceAttr("powerscale_synciq_failover.test", "completed_steps.0", "run_policy_on_source"),
+					resource.TestCheckResourceAttr("powerscale_synciq_failover.test", "last_completed_step", "allow_write_on_target"),
+				),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker2.Release()
+				},
+				Config:  ProviderConfig + syncIQFailoverSyncConfig(),
+				Destroy: true,
+			},
+		},
+	})
+}
+
+func TestAccSyncIQFailoverResourceError(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + syncIQFailoverConfig("switchover"),
+				ExpectError: regexp.MustCompile(`.*Attribute direction value must be one of*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.CreateSyncIQReplicationJob).Return("", fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + syncIQFailoverConfig("failover"),
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config:      ProviderConfig + syncIQFailoverInvalidTargetConfig,
+				ExpectError: regexp.MustCompile(`.*Error running SyncIQ failover step*.`),
+			},
+		},
+	})
+}
+
+func syncIQFailoverConfig(direction string) string {
+	return fmt.Sprintf(`
+resource "powerscale_synciq_failover" "test" {
+  policy_name = "TerraformPolicy"
+  direction   = "%s"
+  job_timeout = 600
+  target_cluster = {
+    endpoint  = "%s"
+    username  = "%s"
+    password  = "%s"
+    insecure  = true
+  }
+}
+`, direction, powerscaleEndpoint, powerscaleUsername, powerscalePassword)
+}
+
+func syncIQFailoverSyncConfig() string {
+	return fmt.Sprintf(`
+resource "powerscale_synciq_failover" "test" {
+  policy_name          = "TerraformPolicy"
+  direction            = "failover"
+  sync_before_failover = true
+  job_timeout          = 600
+  target_cluster = {
+    endpoint  = "%s"
+    username  = "%s"
+    password  = "%s"
+    insecure  = true
+  }
+}
+`, powerscaleEndpoint, powerscaleUsername, powerscalePassword)
+}
+
+var syncIQFailoverInvalidTargetConfig = `
+resource "powerscale_synciq_failover" "test" {
+  policy_name = "TerraformPolicy"
+  direction   = "failover"
+  target_cluster = {
+    endpoint  = "https://127.0.0.1:8080"
+    username  = "invalid"
+    password  = "invalid"
+    insecure  = true
+    auth_type = 0
+  }
+}
+`
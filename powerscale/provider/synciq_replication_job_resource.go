@@ -23,20 +23,24 @@ import (
 	"fmt"
 	"net/http"
 	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
 	"terraform-provider-powerscale/powerscale/helper"
 	"terraform-provider-powerscale/powerscale/models"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -94,7 +98,8 @@ func (r *SyncIQReplicationJobResource) Schema(_ context.Context, _ resource.Sche
 		MarkdownDescription: `The PowerScale SyncIQ ReplicationJob resource provides a means of managing replication jobs on PowerScale clusters.
 		 This resource allows for the manual triggering of replication jobs to replicate data from a source PowerScale cluster to a target PowerScale cluster. 
 		 Note: The replication job is an asynchronous operation, and this resource does not provide real-time monitoring of the job's status. 
-		 To check the status of the job,please use the powerscale_synciq_replication_report datasource.`,
+		 To check the status of the job,please use the powerscale_synciq_replication_report datasource.
+		 The assessment mode is the exception, it waits for the test job to end and records its report in assessment_report.`,
 		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -143,10 +148,161 @@ func (r *SyncIQReplicationJobResource) Schema(_ context.Context, _ resource.Sche
 					int64validator.AtLeast(1),
 				},
 			},
+			"assessment": schema.SingleNestedAttribute{
+				Optional: true,
+				Description: "Assessment mode, only supported with the test action. The test job is run and waited for, and its report is recorded in assessment_report. " +
+					"The apply fails when the job does not finish or its report exceeds any of the configured thresholds. Changing the assessment runs it again.",
+				MarkdownDescription: "Assessment mode, only supported with the `test` action. The test job is run and waited for, and its report is recorded in `assessment_report`. " +
+					"The apply fails when the job does not finish or its report exceeds any of the configured thresholds. Changing the assessment runs it again.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"timeout": schema.Int64Attribute{
+						Optional:            true,
+						Computed:            true,
+						Description:         "Time in seconds to wait for the assessment job to end.",
+						MarkdownDescription: "Time in seconds to wait for the assessment job to end.",
+						Default:             int64default.StaticInt64(3600),
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"max_files_selected": schema.Int64Attribute{
+						Optional:            true,
+						Description:         "Maximum number of files the assessment may select.",
+						MarkdownDescription: "Maximum number of files the assessment may select.",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+					"max_bytes_selected": schema.Int64Attribute{
+						Optional:            true,
+						Description:         "Maximum number of bytes the assessment may select.",
+						MarkdownDescription: "Maximum number of bytes the assessment may select.",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+					"max_errors": schema.Int64Attribute{
+						Optional:            true,
+						Description:         "Maximum number of errors the assessment may report.",
+						MarkdownDescription: "Maximum number of errors the assessment may report.",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+				},
+			},
+			"assessment_report": schema.SingleNestedAttribute{
+				Computed:            true,
+				Description:         "Report of the assessment job, null when the assessment mode is not used.",
+				MarkdownDescription: "Report of the assessment job, null when the assessment mode is not used.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:            true,
+						Description:         "ID of the report.",
+						MarkdownDescription: "ID of the report.",
+					},
+					"state": schema.StringAttribute{
+						Computed:            true,
+						Description:         "State of the assessment job.",
+						MarkdownDescription: "State of the assessment job.",
+					},
+					"start_time": schema.Int64Attribute{
+						Computed:            true,
+						Description:         "Start time of the assessment job, in seconds since the epoch.",
+						MarkdownDescription: "Start time of the assessment job, in seconds since the epoch.",
+					},
+					"end_time": schema.Int64Attribute{
+						Computed:            true,
+						Description:         "End time of the assessment job, in seconds since the epoch.",
+						MarkdownDescription: "End time of the assessment job, in seconds since the epoch.",
+					},
+					"duration": schema.Int64Attribute{
+						Computed:            true,
+						Description:         "Duration of the assessment job in seconds.",
+						MarkdownDescription: "Duration of the assessment job in seconds.",
+					},
+					"files_selected": schema.Int64Attribute{
+						Computed:            true,
+						Description:         "Number of files selected for replication.",
+						MarkdownDescription: "Number of files selected for replication.",
+					},
+					"total_files": schema.Int64Attribute{
+						Computed:            true,
+						Description:         "Total number of files visited.",
+						MarkdownDescription: "Total number of files visited.",
+					},
+					"total_data_bytes": schema.Int64Attribute{
+						Computed:            true,
+						Description:         "Total number of bytes selected for replication.",
+						MarkdownDescription: "Total number of bytes selected for replication.",
+					},
+					"file_data_bytes": schema.Int64Attribute{
+						Computed:            true,
+						Description:         "Number of bytes of file data selected for replication.",
+						MarkdownDescription: "Number of bytes of file data selected for replication.",
+					},
+					"dirs_new": schema.Int64Attribute{
+						Computed:            true,
+						Description:         "Number of new directories.",
+						MarkdownDescription: "Number of new directories.",
+					},
+					"dirs_changed": schema.Int64Attribute{
+						Computed:            true,
+						Description:         "Number of changed directories.",
+						MarkdownDescription: "Number of changed directories.",
+					},
+					"dirs_deleted": schema.Int64Attribute{
+						Computed:            true,
+						Description:         "Number of deleted directories.",
+						MarkdownDescription: "Number of deleted directories.",
+					},
+					"source_directories_visited": schema.Int64Attribute{
+						Computed:            true,
+						Description:         "Number of directories visited on the source.",
+						MarkdownDescription: "Number of directories visited on the source.",
+					},
+					"errors": schema.ListAttribute{
+						Computed:            true,
+						ElementType:         types.StringType,
+						Description:         "Errors reported by the assessment job.",
+						MarkdownDescription: "Errors reported by the assessment job.",
+					},
+					"warnings": schema.ListAttribute{
+						Computed:            true,
+						ElementType:         types.StringType,
+						Description:         "Warnings reported by the assessment job.",
+						MarkdownDescription: "Warnings reported by the assessment job.",
+					},
+				},
+			},
 		},
 	}
 }
 
+// ValidateConfig validates the resource config.
+func (r *SyncIQReplicationJobResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cfg models.SyncIQReplicationJobResourceModel
+	diags := req.Config.Get(ctx, &cfg)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !cfg.Assessment.IsNull() && !cfg.Action.IsUnknown() && cfg.Action.ValueString() != "test" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("assessment"),
+			"Config Error",
+			"SyncIQ assessment is only supported with the test action.",
+		)
+	}
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *SyncIQReplicationJobResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	tflog.Trace(ctx, "resource_SyncIQReplicationJobResource create : Started")
@@ -171,6 +327,18 @@ func (r *SyncIQReplicationJobResource) Create(ctx context.Context, req resource.
 		)
 		return
 	}
+	// the report of the assessment is the first one after the latest report before the job
+	var previousReportID string
+	var startTime int64
+	if !plan.Assessment.IsNull() {
+		previousReportID, startTime, err = helper.GetSyncIQJobStart(ctx, r.client, plan.Id.ValueString())
+		if err != nil {
+			errStr := constants.SyncIQAssessmentErrorMsg + "with error: "
+			message := helper.GetErrorString(err, errStr)
+			resp.Diagnostics.AddError("Error reading the SyncIQ reports of the policy", message)
+			return
+		}
+	}
 	_, err = helper.CreateSyncIQReplicationJob(ctx, r.client, createJob)
 	if err != nil {
 		errStr := "Could not create syncIQ Replication Job with error: "
@@ -181,6 +349,37 @@ func (r *SyncIQReplicationJobResource) Create(ctx context.Context, req resource.
 		)
 		return
 	}
+
+	plan.AssessmentReport = types.ObjectNull(helper.SyncIQAssessmentReportAttrTypes)
+	if !plan.Assessment.IsNull() {
+		var assessment models.SyncIQAssessmentModel
+		resp.Diagnostics.Append(plan.Assessment.As(ctx, &assessment, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		timeout := time.Duration(assessment.Timeout.ValueInt64()) * time.Second
+		report, err := helper.WaitForSyncIQAssessment(ctx, r.client, plan.Id.ValueString(), previousReportID, startTime, timeout)
+		if err != nil {
+			errStr := constants.SyncIQAssessmentErrorMsg + "with error: "
+			message := helper.GetErrorString(err, errStr)
+			resp.Diagnostics.AddError("Error waiting for the SyncIQ assessment", message)
+			return
+		}
+		reportModel, err := helper.SyncIQAssessmentReportMapper(ctx, report)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading the SyncIQ assessment report", err.Error())
+			return
+		}
+		if err := helper.CheckSyncIQAssessmentThresholds(reportModel, assessment); err != nil {
+			resp.Diagnostics.AddError("SyncIQ assessment exceeded its thresholds", err.Error())
+			return
+		}
+		plan.AssessmentReport, diags = types.ObjectValueFrom(ctx, helper.SyncIQAssessmentReportAttrTypes, reportModel)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 	tflog.Trace(ctx, "resource_SyncIQReplicationJobResource create: updating state finished, saving ...")
 	// Save into State
 	diags = resp.State.Set(ctx, &plan)
@@ -88,6 +88,56 @@ func TestAccSyncIQReplicationJobResource(t *testing.T) {
 	})
 }
 
+func TestAccSyncIQReplicationJobResourceAssessment(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + errorAssessmentReplicationJob,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`.*SyncIQ assessment is only supported with the test action.*`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.GetSyncIQJobStart).Return("", int64(0), fmt.Errorf("mock report error")).Build()
+				},
+				Config:      ProviderConfig + SetupReplication() + assessmentReplicationJob,
+				ExpectError: regexp.MustCompile(`.*mock report error.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.WaitForSyncIQAssessment).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SetupReplication() + assessmentReplicationJob,
+				ExpectError: regexp.MustCompile(`.*mock error.*`),
+			},
+			{
+				// run the assessment positive test
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + SetupReplication() + assessmentReplicationJob,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_synciq_replication_job.assessment", "id", "TerraformPolicy"),
+					resource.TestCheckResourceAttr("powerscale_synciq_replication_job.assessment", "assessment.timeout", "3600"),
+					resource.TestCheckResourceAttr("powerscale_synciq_replication_job.assessment", "assessment_report.state", "finished"),
+					resource.TestCheckResourceAttrSet("powerscale_synciq_replication_job.assessment", "assessment_report.files_selected"),
+					resource.TestCheckResourceAttrSet("powerscale_synciq_replication_job.assessment", "assessment_report.total_data_bytes"),
+				),
+			},
+			{
+				Config:      ProviderConfig + SetupReplication() + exceededAssessmentReplicationJob,
+				ExpectError: regexp.MustCompile(`.*SyncIQ assessment exceeded its thresholds.*`),
+			},
+		},
+	})
+}
+
 func SetupReplication() string {
 	connection := fmt.Sprintf(`
   connection {
@@ -152,3 +202,35 @@ resource "powerscale_synciq_replication_job" "errorJob" {
   id     = "TerraformPolicy"
   is_paused = true
 }`
+
+var assessmentReplicationJob = `
+resource "powerscale_synciq_replication_job" "assessment" {
+  action = "test"
+  id     = "TerraformPolicy"
+  assessment = {
+    max_files_selected = 1000000
+    max_errors         = 0
+  }
+  depends_on = [terraform_data.large_file]
+}
+`
+
+var exceededAssessmentReplicationJob = `
+resource "powerscale_synciq_replication_job" "assessment" {
+  action = "test"
+  id     = "TerraformPolicy"
+  assessment = {
+    max_bytes_selected = 0
+  }
+  depends_on = [terraform_data.large_file]
+}
+`
+
+var errorAssessmentReplicationJob = `
+resource "powerscale_synciq_replication_job" "errorJob" {
+  action = "run"
+  id     = "TerraformPolicy"
+  assessment = {
+    max_errors = 0
+  }
+}`
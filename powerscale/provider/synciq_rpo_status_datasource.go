@@ -0,0 +1,209 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SyncIQRpoStatusDataSource{}
+
+// NewSyncIQRpoStatusDataSource creates a new data source.
+func NewSyncIQRpoStatusDataSource() datasource.DataSource {
+	return &SyncIQRpoStatusDataSource{}
+}
+
+// SyncIQRpoStatusDataSource defines the data source implementation.
+type SyncIQRpoStatusDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *SyncIQRpoStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_synciq_rpo_status"
+}
+
+// Schema describes the data source arguments.
+func (d *SyncIQRpoStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This datasource is used to query the RPO compliance of the SyncIQ policies of PowerScale array. " +
+			"It joins each policy with its most recent replication reports and returns its last successful job, lag, consecutive failures and whether it missed its RPO alert.",
+		Description: "This datasource is used to query the RPO compliance of the SyncIQ policies of PowerScale array. " +
+			"It joins each policy with its most recent replication reports and returns its last successful job, lag, consecutive failures and whether it missed its RPO alert.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier",
+				MarkdownDescription: "Identifier",
+				Computed:            true,
+			},
+			"synciq_rpo_status": schema.ListNestedAttribute{
+				Description:         "List of the RPO status of the SyncIQ policies.",
+				MarkdownDescription: "List of the RPO status of the SyncIQ policies.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"policy_id": schema.StringAttribute{
+							Description:         "The system ID given to the policy.",
+							MarkdownDescription: "The system ID given to the policy.",
+							Computed:            true,
+						},
+						"policy_name": schema.StringAttribute{
+							Description:         "The name of the policy.",
+							MarkdownDescription: "The name of the policy.",
+							Computed:            true,
+						},
+						"enabled": schema.BoolAttribute{
+							Description:         "Whether the policy is enabled.",
+							MarkdownDescription: "Whether the policy is enabled.",
+							Computed:            true,
+						},
+						"schedule": schema.StringAttribute{
+							Description:         "The schedule of the policy.",
+							MarkdownDescription: "The schedule of the policy.",
+							Computed:            true,
+						},
+						"rpo_alert": schema.Int64Attribute{
+							Description:         "The RPO alert threshold of the policy in seconds, 0 when RPO alerts are disabled for the policy.",
+							MarkdownDescription: "The RPO alert threshold of the policy in seconds, 0 when RPO alerts are disabled for the policy.",
+							Computed:            true,
+						},
+						"last_success": schema.Int64Attribute{
+							Description:         "The end time of the last successful job of the policy, in seconds since the epoch. Assessment jobs are not counted. Null when the policy never succeeded.",
+							MarkdownDescription: "The end time of the last successful job of the policy, in seconds since the epoch. Assessment jobs are not counted. Null when the policy never succeeded.",
+							Computed:            true,
+						},
+						"lag": schema.Int64Attribute{
+							Description:         "The number of seconds elapsed since the last successful job of the policy. Null when the policy never succeeded.",
+							MarkdownDescription: "The number of seconds elapsed since the last successful job of the policy. Null when the policy never succeeded.",
+							Computed:            true,
+						},
+						"breached": schema.BoolAttribute{
+							Description:         "Whether the policy missed its RPO, that is the policy is enabled, has an RPO alert and did not succeed within it.",
+							MarkdownDescription: "Whether the policy missed its RPO, that is the policy is enabled, has an RPO alert and did not succeed within it.",
+							Computed:            true,
+						},
+						"consecutive_failures": schema.Int64Attribute{
+							Description:         "The number of failed jobs of the policy since its last successful job. Assessment jobs are not counted.",
+							MarkdownDescription: "The number of failed jobs of the policy since its last successful job. Assessment jobs are not counted.",
+							Computed:            true,
+						},
+						"last_error": schema.StringAttribute{
+							Description:         "The errors of the last failed job of the policy since its last successful job. Null when no job failed since.",
+							MarkdownDescription: "The errors of the last failed job of the policy since its last successful job. Null when no job failed since.",
+							Computed:            true,
+						},
+						"last_job_state": schema.StringAttribute{
+							Description:         "The state of the last job of the policy. Null when the policy has no report.",
+							MarkdownDescription: "The state of the last job of the policy. Null when the policy has no report.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"names": schema.SetAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						Description:         "Filter the status by policy name.",
+						MarkdownDescription: "Filter the status by policy name.",
+					},
+					"breached": schema.BoolAttribute{
+						Optional:            true,
+						Description:         "If specified, only the policies which missed their RPO, or did not, will be returned.",
+						MarkdownDescription: "If specified, only the policies which missed their RPO, or did not, will be returned.",
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *SyncIQRpoStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *SyncIQRpoStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading SyncIQ RPO status data source")
+
+	var state models.SyncIQRpoStatusDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policies, err := helper.GetAllSyncIQPolicies(ctx, d.client)
+	if err != nil {
+		errStr := constants.ListSynciqPoliciesMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading syncIQ policies", message)
+		return
+	}
+
+	reports, err := helper.GetSyncIQRpoReports(ctx, d.client)
+	if err != nil {
+		errStr := constants.ReadSyncIQRpoStatusErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading SyncIQ replication reports", message)
+		return
+	}
+
+	statuses := helper.GetSyncIQRpoStatuses(policies.Policies, reports, time.Now().Unix())
+	state.Statuses, err = helper.FilterSyncIQRpoStatuses(ctx, statuses, state.Filter)
+	if err != nil {
+		resp.Diagnostics.AddError("Error filtering SyncIQ RPO status", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue("synciq_rpo_status_datasource")
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading SyncIQ RPO status data source")
+}
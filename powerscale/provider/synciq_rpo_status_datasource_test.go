@@ -0,0 +1,136 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSyncIQRpoStatusDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// read all
+			{
+				Config: ProviderConfig + SyncIQRpoStatusDataSourceAllConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerscale_synciq_rpo_status.all", "synciq_rpo_status.#"),
+				),
+			},
+			// a policy which never ran misses its RPO
+			{
+				Config: ProviderConfig + SyncIQRpoStatusDataSourceFilterConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerscale_synciq_rpo_status.filtering", "synciq_rpo_status.#", "1"),
+					resource.TestCheckResourceAttr("data.powerscale_synciq_rpo_status.filtering", "synciq_rpo_status.0.policy_name", "tfaccRpoPolicy"),
+					resource.TestCheckResourceAttr("data.powerscale_synciq_rpo_status.filtering", "synciq_rpo_status.0.rpo_alert", "3600"),
+					resource.TestCheckResourceAttr("data.powerscale_synciq_rpo_status.filtering", "synciq_rpo_status.0.breached", "true"),
+					resource.TestCheckResourceAttr("data.powerscale_synciq_rpo_status.filtering", "synciq_rpo_status.0.consecutive_failures", "0"),
+					resource.TestCheckNoResourceAttr("data.powerscale_synciq_rpo_status.filtering", "synciq_rpo_status.0.last_success"),
+				),
+			},
+			// filter with no match
+			{
+				Config: ProviderConfig + SyncIQRpoStatusDataSourceNoMatchConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerscale_synciq_rpo_status.none", "synciq_rpo_status.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSyncIQRpoStatusDataSourceGettingErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.GetAllSyncIQPolicies).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SyncIQRpoStatusDataSourceAllConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetSyncIQRpoReports).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SyncIQRpoStatusDataSourceAllConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.FilterSyncIQRpoStatuses).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SyncIQRpoStatusDataSourceAllConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + SyncIQRpoStatusDataSourceAllConfig,
+			},
+		},
+	})
+}
+
+var SyncIQRpoStatusDataSourceAllConfig = `
+data "powerscale_synciq_rpo_status" "all" {
+}
+`
+
+var SyncIQRpoStatusDataSourceFilterConfig = `
+resource "powerscale_synciq_policy" "rpo" {
+	name             = "tfaccRpoPolicy"
+	action           = "sync"
+	source_root_path = "/ifs"
+	target_host      = "10.10.10.10"
+	target_path      = "/ifs/tfaccRpoSink"
+	rpo_alert        = 3600
+}
+
+data "powerscale_synciq_rpo_status" "filtering" {
+	filter {
+		names    = ["tfaccRpoPolicy"]
+		breached = true
+	}
+	depends_on = [powerscale_synciq_policy.rpo]
+}
+`
+
+var SyncIQRpoStatusDataSourceNoMatchConfig = `
+data "powerscale_synciq_rpo_status" "none" {
+	filter {
+		names = ["tfaccInvalidPolicy"]
+	}
+}
+`
@@ -0,0 +1,198 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource              = &SyncIQTargetPolicyBreakResource{}
+	_ resource.ResourceWithConfigure = &SyncIQTargetPolicyBreakResource{}
+)
+
+// NewSyncIQTargetPolicyBreakResource creates a new resource.
+func NewSyncIQTargetPolicyBreakResource() resource.Resource {
+	return &SyncIQTargetPolicyBreakResource{}
+}
+
+// SyncIQTargetPolicyBreakResource defines the resource implementation.
+type SyncIQTargetPolicyBreakResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *SyncIQTargetPolicyBreakResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_synciq_target_policy_break"
+}
+
+// Schema describes the resource arguments.
+func (r *SyncIQTargetPolicyBreakResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to break the association between a SyncIQ target directory and its source cluster, on the target cluster of the policy. " +
+			"Breaking the association makes the target directory writable and forces the next job of the policy on the source cluster to run a full replication. " +
+			"Creating this resource breaks the association, destroying this resource only removes it from the state.",
+		Description: "This resource is used to break the association between a SyncIQ target directory and its source cluster, on the target cluster of the policy. " +
+			"Breaking the association makes the target directory writable and forces the next job of the policy on the source cluster to run a full replication. " +
+			"Creating this resource breaks the association, destroying this resource only removes it from the state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Name or ID of the target policy.",
+				MarkdownDescription: "Name or ID of the target policy.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy_name": schema.StringAttribute{
+				Description:         "Name or ID of the target policy to break.",
+				MarkdownDescription: "Name or ID of the target policy to break.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"force": schema.BoolAttribute{
+				Description:         "Whether to break the association without contacting the source cluster. Use it when the source cluster is unreachable.",
+				MarkdownDescription: "Whether to break the association without contacting the source cluster. Use it when the source cluster is unreachable.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_path": schema.StringAttribute{
+				Description:         "The target directory of the policy that was broken.",
+				MarkdownDescription: "The target directory of the policy that was broken.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_host": schema.StringAttribute{
+				Description:         "The host name or IP address of the source cluster of the policy that was broken.",
+				MarkdownDescription: "The host name or IP address of the source cluster of the policy that was broken.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *SyncIQTargetPolicyBreakResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create breaks the target policy association.
+func (r *SyncIQTargetPolicyBreakResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Breaking SyncIQ target policy association")
+
+	var plan models.SyncIQTargetPolicyBreakResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// record the association before it is gone
+	policy, _, err := helper.GetSyncIQTargetPolicy(ctx, r.client, plan.PolicyName.ValueString())
+	if err != nil {
+		errStr := constants.ReadSyncIQTargetPoliciesErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading SyncIQ target policy", message)
+		return
+	}
+
+	if err := helper.BreakSyncIQTargetPolicy(ctx, r.client, plan.PolicyName.ValueString(), plan.Force.ValueBool()); err != nil {
+		errStr := constants.BreakSyncIQTargetPolicyErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error breaking SyncIQ target policy association", message)
+		return
+	}
+
+	plan.ID = plan.PolicyName
+	plan.TargetPath = types.StringValue(policy.GetTargetPath())
+	plan.SourceHost = types.StringValue(policy.GetSourceHost())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with breaking SyncIQ target policy association")
+}
+
+// Read keeps the state, the broken association leaves nothing behind to read back.
+func (r *SyncIQTargetPolicyBreakResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.SyncIQTargetPolicyBreakResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is not supported, every configurable attribute requires replacement.
+func (r *SyncIQTargetPolicyBreakResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.SyncIQTargetPolicyBreakResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the resource from the state, a broken association cannot be restored.
+func (r *SyncIQTargetPolicyBreakResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting SyncIQ target policy break")
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,95 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSyncIQTargetPolicyBreakResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"time": {
+				Source: "hashicorp/time",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + SetupHostIP() + SyncIQTargetPolicyBreakResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_synciq_target_policy_break.test", "id", "tfaccPolicy"),
+					resource.TestCheckResourceAttr("powerscale_synciq_target_policy_break.test", "target_path", "/ifs/tfaccSink"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSyncIQTargetPolicyBreakResourceErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + SyncIQTargetPolicyBreakInvalidConfig,
+				ExpectError: regexp.MustCompile(`.*Error reading SyncIQ target policy*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.GetSyncIQTargetPolicy).Return(nil, nil, nil).Build()
+					FunctionMocker2 = mockey.Mock(helper.BreakSyncIQTargetPolicy).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SyncIQTargetPolicyBreakInvalidConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker2.Release()
+				},
+				Config:      ProviderConfig + SyncIQTargetPolicyBreakInvalidConfig,
+				ExpectError: regexp.MustCompile(`.*Error reading SyncIQ target policy*.`),
+			},
+		},
+	})
+}
+
+var SyncIQTargetPolicyBreakResourceConfig = JobConfig + `
+resource "powerscale_synciq_target_policy_break" "test" {
+	policy_name = "tfaccPolicy"
+	force       = true
+	depends_on  = [time_sleep.wait_60_seconds]
+}
+`
+
+var SyncIQTargetPolicyBreakInvalidConfig = `
+resource "powerscale_synciq_target_policy_break" "test" {
+	policy_name = "tfaccInvalidPolicy"
+}
+`
@@ -0,0 +1,226 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SyncIQTargetPolicyDataSource{}
+
+// NewSyncIQTargetPolicyDataSource creates a new data source.
+func NewSyncIQTargetPolicyDataSource() datasource.DataSource {
+	return &SyncIQTargetPolicyDataSource{}
+}
+
+// SyncIQTargetPolicyDataSource defines the data source implementation.
+type SyncIQTargetPolicyDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *SyncIQTargetPolicyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_synciq_target_policy"
+}
+
+// Schema describes the data source arguments.
+func (d *SyncIQTargetPolicyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This datasource is used to query the SyncIQ policies that replicate to this PowerScale cluster, as seen from the target cluster." +
+			" The information fetched from this datasource can be used to verify the replication health and the failover/failback state on a DR cluster.",
+		Description: "This datasource is used to query the SyncIQ policies that replicate to this PowerScale cluster, as seen from the target cluster." +
+			" The information fetched from this datasource can be used to verify the replication health and the failover/failback state on a DR cluster.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier",
+				MarkdownDescription: "Identifier",
+				Computed:            true,
+			},
+			"synciq_target_policies": schema.ListNestedAttribute{
+				Description:         "List of SyncIQ target policies.",
+				MarkdownDescription: "List of SyncIQ target policies.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cancel_state": schema.StringAttribute{
+							Description:         "The state of the policy cancellation on the target cluster.",
+							MarkdownDescription: "The state of the policy cancellation on the target cluster.",
+							Computed:            true,
+						},
+						"failover_failback_state": schema.StringAttribute{
+							Description:         "The failover or failback state of the target directory.",
+							MarkdownDescription: "The failover or failback state of the target directory.",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							Description:         "The system ID given to the policy.",
+							MarkdownDescription: "The system ID given to the policy.",
+							Computed:            true,
+						},
+						"last_job_state": schema.StringAttribute{
+							Description:         "The state of the last job of the policy.",
+							MarkdownDescription: "The state of the last job of the policy.",
+							Computed:            true,
+						},
+						"last_source_coordinator_ip": schema.StringAttribute{
+							Description:         "The IP address of the coordinator of the last job on the source cluster.",
+							MarkdownDescription: "The IP address of the coordinator of the last job on the source cluster.",
+							Computed:            true,
+						},
+						"last_update_from_source": schema.Int64Attribute{
+							Description:         "The Unix Epoch time of the last update from the source cluster.",
+							MarkdownDescription: "The Unix Epoch time of the last update from the source cluster.",
+							Computed:            true,
+						},
+						"legacy_policy": schema.BoolAttribute{
+							Description:         "Whether the policy was created by a legacy version of SyncIQ.",
+							MarkdownDescription: "Whether the policy was created by a legacy version of SyncIQ.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							Description:         "The name of the policy.",
+							MarkdownDescription: "The name of the policy.",
+							Computed:            true,
+						},
+						"source_cluster_guid": schema.StringAttribute{
+							Description:         "The GUID of the source cluster.",
+							MarkdownDescription: "The GUID of the source cluster.",
+							Computed:            true,
+						},
+						"source_host": schema.StringAttribute{
+							Description:         "The host name or IP address of the source cluster.",
+							MarkdownDescription: "The host name or IP address of the source cluster.",
+							Computed:            true,
+						},
+						"target_path": schema.StringAttribute{
+							Description:         "The target directory of the policy.",
+							MarkdownDescription: "The target directory of the policy.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"names": schema.SetAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						Description:         "Filter the target policies by name or ID.",
+						MarkdownDescription: "Filter the target policies by name or ID.",
+						Validators: []validator.Set{
+							setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+						},
+					},
+					"target_path": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Filter the target policies by target directory.",
+						MarkdownDescription: "Filter the target policies by target directory.",
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"failover_failback_state": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Filter the target policies by failover or failback state.",
+						MarkdownDescription: "Filter the target policies by failover or failback state.",
+						Validators: []validator.String{
+							stringvalidator.OneOf("writes_disabled", "enabling_writes", "writes_enabled", "disabling_writes", "creating_resync_policy", "resync_policy_created"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *SyncIQTargetPolicyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *SyncIQTargetPolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading SyncIQ target policy data source")
+
+	var state models.SyncIQTargetPolicyDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policies, err := helper.GetSyncIQTargetPolicies(ctx, d.client)
+	if err != nil {
+		errStr := constants.ReadSyncIQTargetPoliciesErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading SyncIQ target policies", message)
+		return
+	}
+
+	policies, err = helper.FilterSyncIQTargetPolicies(ctx, policies, state.Filter)
+	if err != nil {
+		resp.Diagnostics.AddError("Error filtering SyncIQ target policies", err.Error())
+		return
+	}
+
+	state.Policies = []models.SyncIQTargetPolicyModel{}
+	for _, policy := range policies {
+		entity, err := helper.SyncIQTargetPolicyMapper(ctx, policy)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to map SyncIQ target policy fields", err.Error())
+			return
+		}
+		state.Policies = append(state.Policies, entity)
+	}
+
+	state.ID = types.StringValue("synciq_target_policy_datasource")
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading SyncIQ target policy data source")
+}
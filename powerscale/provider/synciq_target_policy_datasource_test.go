@@ -0,0 +1,133 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSyncIQTargetPolicyDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"time": {
+				Source: "hashicorp/time",
+			},
+		},
+		Steps: []resource.TestStep{
+			// read all
+			{
+				Config: ProviderConfig + SetupHostIP() + SyncIQTargetPolicyDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerscale_synciq_target_policy.all", "synciq_target_policies.#"),
+				),
+			},
+			// read with filter
+			{
+				Config: ProviderConfig + SetupHostIP() + SyncIQTargetPolicyDataSourceFilterConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerscale_synciq_target_policy.filtering", "synciq_target_policies.#", "1"),
+					resource.TestCheckResourceAttr("data.powerscale_synciq_target_policy.filtering", "synciq_target_policies.0.name", "tfaccPolicy"),
+					resource.TestCheckResourceAttr("data.powerscale_synciq_target_policy.filtering", "synciq_target_policies.0.target_path", "/ifs/tfaccSink"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSyncIQTargetPolicyDataSourceFilterErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + SyncIQTargetPolicyDataSourceFilterConfigErr,
+				ExpectError: regexp.MustCompile(`.*Attribute filter.failover_failback_state value must be one of*.`),
+			},
+		},
+	})
+}
+
+func TestAccSyncIQTargetPolicyDataSourceGettingErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.GetSyncIQTargetPolicies).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SyncIQTargetPolicyDataSourceAllConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.FilterSyncIQTargetPolicies).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SyncIQTargetPolicyDataSourceAllConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + SyncIQTargetPolicyDataSourceAllConfig,
+			},
+		},
+	})
+}
+
+var SyncIQTargetPolicyDataSourceAllConfig = `
+data "powerscale_synciq_target_policy" "all" {
+}
+`
+
+var SyncIQTargetPolicyDataSourceConfig = JobConfig + `
+data "powerscale_synciq_target_policy" "all" {
+	depends_on = [time_sleep.wait_60_seconds]
+}
+`
+
+var SyncIQTargetPolicyDataSourceFilterConfig = JobConfig + `
+data "powerscale_synciq_target_policy" "filtering" {
+	filter {
+		names       = ["tfaccPolicy"]
+		target_path = "/ifs/tfaccSink"
+	}
+	depends_on = [time_sleep.wait_60_seconds]
+}
+`
+
+var SyncIQTargetPolicyDataSourceFilterConfigErr = `
+data "powerscale_synciq_target_policy" "test" {
+	filter {
+		failover_failback_state = "invalid"
+	}
+}
+`
@@ -0,0 +1,135 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SyncIQTargetReportDataSource{}
+
+// NewSyncIQTargetReportDataSource creates a new data source.
+func NewSyncIQTargetReportDataSource() datasource.DataSource {
+	return &SyncIQTargetReportDataSource{}
+}
+
+// SyncIQTargetReportDataSource defines the data source implementation.
+type SyncIQTargetReportDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *SyncIQTargetReportDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_synciq_target_report"
+}
+
+// Schema describes the data source arguments.
+func (d *SyncIQTargetReportDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	// target reports have the same fields and filters as the replication reports of the source cluster
+	var reportSchema datasource.SchemaResponse
+	(&ReplicationReportDataSource{}).Schema(ctx, req, &reportSchema)
+	reports := reportSchema.Schema.Attributes["replication_reports"].(schema.ListNestedAttribute)
+	reports.Description = "List of reports of the SyncIQ jobs run on this cluster as a target."
+	reports.MarkdownDescription = "List of reports of the SyncIQ jobs run on this cluster as a target."
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This datasource is used to query the SyncIQ target reports from PowerScale array. A target report describes a SyncIQ job as seen from the target cluster," +
+			" which can be used to verify the replication health from a DR cluster.",
+		Description: "This datasource is used to query the SyncIQ target reports from PowerScale array. A target report describes a SyncIQ job as seen from the target cluster," +
+			" which can be used to verify the replication health from a DR cluster.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier",
+				MarkdownDescription: "Identifier",
+				Computed:            true,
+			},
+			"target_reports": reports,
+		},
+		Blocks: reportSchema.Schema.Blocks,
+	}
+}
+
+// Configure configures the data source.
+func (d *SyncIQTargetReportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *SyncIQTargetReportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading SyncIQ target report data source")
+
+	var state models.SyncIQTargetReportsDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reports, err := helper.GetSyncIQTargetReports(ctx, d.client, state)
+	if err != nil {
+		errStr := constants.ReadSyncIQTargetReportsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error getting the list of SyncIQ target reports",
+			message,
+		)
+		return
+	}
+
+	state.Reports = []models.ReplicationReportsDetail{}
+	for _, report := range reports {
+		entity, err := helper.SyncIQTargetReportMapper(ctx, report)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading SyncIQ target report datasource",
+				fmt.Sprintf("Could not list SyncIQ target reports with error: %s", err.Error()))
+			return
+		}
+		state.Reports = append(state.Reports, entity)
+	}
+
+	state.ID = types.StringValue("synciq_target_report_datasource")
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading SyncIQ target report data source")
+}
@@ -0,0 +1,123 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSyncIQTargetReportDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"time": {
+				Source: "hashicorp/time",
+			},
+		},
+		Steps: []resource.TestStep{
+			// read all
+			{
+				Config: ProviderConfig + SetupHostIP() + SyncIQTargetReportDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerscale_synciq_target_report.all", "target_reports.#"),
+				),
+			},
+			// read with filter
+			{
+				Config: ProviderConfig + SetupHostIP() + SyncIQTargetReportDataSourceFilterConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerscale_synciq_target_report.filtering", "target_reports.0.policy_name", "tfaccPolicy"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSyncIQTargetReportDataSourceFilterErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + SyncIQTargetReportDataSourceFilterConfigErr,
+				ExpectError: regexp.MustCompile(`.*Unsupported argument*.`),
+			},
+		},
+	})
+}
+
+func TestAccSyncIQTargetReportDataSourceGettingErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.GetSyncIQTargetReports).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SyncIQTargetReportDataSourceAllConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + SyncIQTargetReportDataSourceAllConfig,
+			},
+		},
+	})
+}
+
+var SyncIQTargetReportDataSourceAllConfig = `
+data "powerscale_synciq_target_report" "all" {
+}
+`
+
+var SyncIQTargetReportDataSourceConfig = JobConfig + `
+data "powerscale_synciq_target_report" "all" {
+	depends_on = [time_sleep.wait_60_seconds]
+}
+`
+
+var SyncIQTargetReportDataSourceFilterConfig = JobConfig + `
+data "powerscale_synciq_target_report" "filtering" {
+	filter {
+		policy_name        = "tfaccPolicy"
+		reports_per_policy = 1
+	}
+	depends_on = [time_sleep.wait_60_seconds]
+}
+`
+
+var SyncIQTargetReportDataSourceFilterConfigErr = `
+data "powerscale_synciq_target_report" "test" {
+	filter {
+		invalidFilter = "Invalid"
+	}
+}
+`
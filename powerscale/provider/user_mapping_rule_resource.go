@@ -0,0 +1,402 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &UserMappingRuleResource{}
+	_ resource.ResourceWithConfigure   = &UserMappingRuleResource{}
+	_ resource.ResourceWithImportState = &UserMappingRuleResource{}
+)
+
+// NewUserMappingRuleResource creates a new resource.
+func NewUserMappingRuleResource() resource.Resource {
+	return &UserMappingRuleResource{}
+}
+
+// UserMappingRuleResource defines the resource implementation.
+type UserMappingRuleResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *UserMappingRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_mapping_rule"
+}
+
+// userMappingRuleUserSchema returns the schema of a user of the user mapping rule.
+func userMappingRuleUserSchema(description string, required bool) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description:         description,
+		MarkdownDescription: description,
+		Required:            required,
+		Optional:            !required,
+		// the user fields require the replacement, the object itself only when it is added or removed,
+		// since its computed domain is unknown until the nested plan modifiers ran
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.RequiresReplaceIf(
+				func(ctx context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+					resp.RequiresReplace = req.StateValue.IsNull() != req.PlanValue.IsNull()
+				},
+				"Adding or removing the user requires a replacement.",
+				"Adding or removing the user requires a replacement.",
+			),
+		},
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				Description:         "Specifies the domain of the user that is being mapped.",
+				MarkdownDescription: "Specifies the domain of the user that is being mapped.",
+				Optional:            true,
+				Computed:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user": schema.StringAttribute{
+				Description:         "Specifies the name of the user that is being mapped.",
+				MarkdownDescription: "Specifies the name of the user that is being mapped.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Schema describes the resource arguments.
+func (r *UserMappingRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage a single User Mapping Rule of PowerScale Array, without taking over the other rules of the zone. " +
+			"The rule is identified by its operator, target user and source user, and is inserted at the declared `position`, or `before` or `after` another rule. " +
+			"The rule list is read again right before it is written back, so that rules changed concurrently are not overwritten, and only this rule is removed on destroy. " +
+			"Note that, this resource should not be used together with the `powerscale_user_mapping_rules` resource on the same zone, as the latter manages the whole rule list.",
+		Description: "This resource is used to manage a single User Mapping Rule of PowerScale Array, without taking over the other rules of the zone. " +
+			"The rule is identified by its operator, target user and source user, and is inserted at the declared position, or before or after another rule. " +
+			"The rule list is read again right before it is written back, so that rules changed concurrently are not overwritten, and only this rule is removed on destroy. " +
+			"Note that, this resource should not be used together with the powerscale_user_mapping_rules resource on the same zone, as the latter manages the whole rule list.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "User Mapping Rule ID, of the form zone:operator:target_user[:source_user], where the users are written as [domain\\]user.",
+				MarkdownDescription: "User Mapping Rule ID, of the form `zone:operator:target_user[:source_user]`, where the users are written as `[domain\\]user`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"zone": schema.StringAttribute{
+				Description:         "The zone to which the user mapping applies. Defaults to System.",
+				MarkdownDescription: "The zone to which the user mapping applies. Defaults to System.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("System"),
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"operator": schema.StringAttribute{
+				Description:         "Specifies the operator to make rules on specified users or groups.",
+				MarkdownDescription: "Specifies the operator to make rules on specified users or groups.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.OneOf("append", "insert", "replace", "trim", "union")},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"options": schema.SingleNestedAttribute{
+				Description:         "Specifies the mapping options for this user mapping rule.(Update Supported)",
+				MarkdownDescription: "Specifies the mapping options for this user mapping rule.(Update Supported)",
+				Optional:            true,
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"break": schema.BoolAttribute{
+						Description:         "If true, and the rule was applied successfully, stop processing further.",
+						MarkdownDescription: "If true, and the rule was applied successfully, stop processing further.",
+						Optional:            true,
+						Computed:            true,
+					},
+					"user": schema.BoolAttribute{
+						Description:         "If true, the primary UID and primary user SID should be copied to the existing credential.",
+						MarkdownDescription: "If true, the primary UID and primary user SID should be copied to the existing credential.",
+						Optional:            true,
+						Computed:            true,
+					},
+					"group": schema.BoolAttribute{
+						Description:         "If true, the primary GID and primary group SID should be copied to the existing credential.",
+						MarkdownDescription: "If true, the primary GID and primary group SID should be copied to the existing credential.",
+						Optional:            true,
+						Computed:            true,
+					},
+					"groups": schema.BoolAttribute{
+						Description:         "If true, all additional identifiers should be copied to the existing credential.",
+						MarkdownDescription: "If true, all additional identifiers should be copied to the existing credential.",
+						Optional:            true,
+						Computed:            true,
+					},
+					"default_user": schema.SingleNestedAttribute{
+						Description:         "Specifies the default user information that can be applied if the final credentials do not have valid UID and GID information.",
+						MarkdownDescription: "Specifies the default user information that can be applied if the final credentials do not have valid UID and GID information.",
+						Optional:            true,
+						Computed:            true,
+						Attributes: map[string]schema.Attribute{
+							"domain": schema.StringAttribute{
+								Description:         "Specifies the domain of the user that is being mapped.",
+								MarkdownDescription: "Specifies the domain of the user that is being mapped.",
+								Optional:            true,
+								Computed:            true,
+								Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+							},
+							"user": schema.StringAttribute{
+								Description:         "Specifies the name of the user that is being mapped.",
+								MarkdownDescription: "Specifies the name of the user that is being mapped.",
+								Required:            true,
+								Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+							},
+						},
+					},
+				},
+			},
+			"target_user": userMappingRuleUserSchema("Specifies the target user information that the rule can be applied to.", true),
+			"source_user": userMappingRuleUserSchema("Specifies the source user information that the rule can be applied from.", false),
+			"position": schema.Int64Attribute{
+				Description: "Zero-based position of the rule in the rule list of the zone, the rule is placed last when the position is past the end of the list. " +
+					"The rule is moved back when it is found at another position.(Update Supported)",
+				MarkdownDescription: "Zero-based position of the rule in the rule list of the zone, the rule is placed last when the position is past the end of the list. " +
+					"The rule is moved back when it is found at another position.(Update Supported)",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+					int64validator.ConflictsWith(path.MatchRoot("before"), path.MatchRoot("after")),
+				},
+			},
+			"before": schema.StringAttribute{
+				Description: "ID of the rule of the same zone this rule is inserted immediately before, usually the id of another powerscale_user_mapping_rule resource. " +
+					"The rule is moved back only when it is found after the anchor rule.(Update Supported)",
+				MarkdownDescription: "ID of the rule of the same zone this rule is inserted immediately before, usually the `id` of another `powerscale_user_mapping_rule` resource. " +
+					"The rule is moved back only when it is found after the anchor rule.(Update Supported)",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("after")),
+				},
+			},
+			"after": schema.StringAttribute{
+				Description: "ID of the rule of the same zone this rule is inserted immediately after, usually the id of another powerscale_user_mapping_rule resource. " +
+					"The rule is moved back only when it is found before the anchor rule.(Update Supported)",
+				MarkdownDescription: "ID of the rule of the same zone this rule is inserted immediately after, usually the `id` of another `powerscale_user_mapping_rule` resource. " +
+					"The rule is moved back only when it is found before the anchor rule.(Update Supported)",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *UserMappingRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *UserMappingRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating User Mapping Rule resource")
+	var plan models.UserMappingRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, err := helper.CreateUserMappingRule(ctx, r.client, plan)
+	if err != nil {
+		errStr := constants.CreateUserMappingRuleErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("error creating user mapping rule", message)
+		return
+	}
+
+	if found := r.readRule(ctx, &plan, key, &resp.Diagnostics); !found && !resp.Diagnostics.HasError() {
+		resp.Diagnostics.AddError("error creating user mapping rule", fmt.Sprintf("user mapping rule %s was not found after creating it", key))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with Create User Mapping Rule resource")
+}
+
+// Read reads the resource state.
+func (r *UserMappingRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading User Mapping Rule resource")
+	var state models.UserMappingRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, key, err := helper.ParseUserMappingRuleID(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("error reading user mapping rule", err.Error())
+		return
+	}
+	found := r.readRule(ctx, &state, key, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		// the rule was removed outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Read User Mapping Rule resource")
+}
+
+// Update updates the resource state.
+func (r *UserMappingRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating User Mapping Rule resource")
+	var plan, state models.UserMappingRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.UpdateUserMappingRule(ctx, r.client, state, plan); err != nil {
+		errStr := constants.UpdateUserMappingRuleErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("error updating user mapping rule", message)
+		return
+	}
+
+	_, key, _ := helper.ParseUserMappingRuleID(state.ID.ValueString())
+	if found := r.readRule(ctx, &plan, key, &resp.Diagnostics); !found && !resp.Diagnostics.HasError() {
+		resp.Diagnostics.AddError("error updating user mapping rule", fmt.Sprintf("user mapping rule %s was not found after updating it", key))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with Update User Mapping Rule resource")
+}
+
+// Delete deletes the resource.
+func (r *UserMappingRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting User Mapping Rule resource")
+	var state models.UserMappingRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.DeleteUserMappingRule(ctx, r.client, state); err != nil {
+		errStr := constants.DeleteUserMappingRuleErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("error deleting user mapping rule", message)
+		return
+	}
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with Delete User Mapping Rule resource")
+}
+
+// ImportState imports the resource state.
+func (r *UserMappingRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Importing User Mapping Rule resource")
+	zone, key, err := helper.ParseUserMappingRuleID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("error importing user mapping rule", err.Error())
+		return
+	}
+
+	state := models.UserMappingRuleResourceModel{
+		Zone:     types.StringValue(helper.DefaultIfEmpty(zone, "System")),
+		Position: types.Int64Null(),
+		Before:   types.StringNull(),
+		After:    types.StringNull(),
+	}
+	found := r.readRule(ctx, &state, key, &resp.Diagnostics)
+	if !found && !resp.Diagnostics.HasError() {
+		resp.Diagnostics.AddError("error importing user mapping rule", fmt.Sprintf("user mapping rule %s was not found", key))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Import User Mapping Rule resource")
+}
+
+// readRule reads the rule with the given key into the model, and returns whether it was found.
+func (r *UserMappingRuleResource) readRule(ctx context.Context, model *models.UserMappingRuleResourceModel, key string, diags *diag.Diagnostics) bool {
+	rulesResponse, err := helper.GetUserMappingRulesByZone(ctx, r.client, model.Zone.ValueString())
+	if err != nil {
+		errStr := constants.ReadUserMappingRuleErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		diags.AddError("error getting user mapping rules", message)
+		return false
+	}
+	index := helper.FindUserMappingRule(rulesResponse.Rules, key)
+	if index < 0 {
+		return false
+	}
+	diags.Append(helper.UpdateUserMappingRuleState(ctx, model, rulesResponse.Rules, index)...)
+	return true
+}
@@ -0,0 +1,248 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccSingleUserMappingRuleResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + SingleUserMappingRuleResourceConfig("position = 0", `after = powerscale_user_mapping_rule.first.id`, "false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_user_mapping_rule.first", "id", `System:append:domain\tfaccMappingRuleUser:admin`),
+					resource.TestCheckResourceAttr("powerscale_user_mapping_rule.first", "position", "0"),
+					resource.TestCheckResourceAttr("powerscale_user_mapping_rule.second", "id", "System:union:tfaccMappingRuleUser:admin"),
+					resource.TestCheckResourceAttr("powerscale_user_mapping_rule.second", "options.break", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "powerscale_user_mapping_rule.second",
+				ImportState:             true,
+				ImportStateId:           "System:union:tfaccMappingRuleUser:admin",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"after"},
+			},
+			// Update options and placement testing, the rules without domain are updated in place
+			{
+				Config: ProviderConfig + SingleUserMappingRuleResourceConfig("", `before = powerscale_user_mapping_rule.first.id`, "true"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("powerscale_user_mapping_rule.first", plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction("powerscale_user_mapping_rule.second", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_user_mapping_rule.second", "options.break", "true"),
+					resource.TestCheckResourceAttrPair("powerscale_user_mapping_rule.second", "before", "powerscale_user_mapping_rule.first", "id"),
+					resource.TestCheckNoResourceAttr("powerscale_user_mapping_rule.first", "position"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSingleUserMappingRuleResourceDefaultZone(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + SingleUserMappingRuleDefaultZoneResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_user_mapping_rule.default_zone", "zone", "System"),
+				),
+			},
+			// importing with or without the default zone gives the same state as the config without zone
+			{
+				ResourceName:      "powerscale_user_mapping_rule.default_zone",
+				ImportState:       true,
+				ImportStateId:     "System:union:tfaccMappingRuleUser:admin",
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "powerscale_user_mapping_rule.default_zone",
+				ImportState:       true,
+				ImportStateId:     "union:tfaccMappingRuleUser:admin",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSingleUserMappingRuleResourceErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Conflicting placement
+			{
+				Config: ProviderConfig + `
+				resource "powerscale_user_mapping_rule" "invalid" {
+					operator = "trim"
+					target_user = {
+						user = "tfaccMappingRuleUser"
+					}
+					position = 0
+					before = "System:union:tfaccMappingRuleUser:admin"
+				}
+				`,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Combination*.`),
+			},
+			// Missing anchor rule
+			{
+				Config: ProviderConfig + `
+				resource "powerscale_user_mapping_rule" "invalid" {
+					operator = "trim"
+					target_user = {
+						user = "tfaccMappingRuleUser"
+					}
+					after = "System:union:tfaccMissingRuleUser:admin"
+				}
+				`,
+				ExpectError: regexp.MustCompile(`.*was not found*.`),
+			},
+			// Invalid import identifier
+			{
+				Config:        ProviderConfig + SingleUserMappingRuleResourceConfig("", "", "false"),
+				ResourceName:  "powerscale_user_mapping_rule.second",
+				ImportState:   true,
+				ImportStateId: "System:invalid",
+				ExpectError:   regexp.MustCompile(`.*invalid identifier*.`),
+			},
+			// Create error
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.ModifyUserMappingRules).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SingleUserMappingRuleResourceConfig("", "", "false"),
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			// Read rules error
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.GetUserMappingRulesByZone).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SingleUserMappingRuleResourceConfig("", "", "false"),
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			// Update error
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+				},
+				Config: ProviderConfig + SingleUserMappingRuleResourceConfig("", "", "false"),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.ModifyUserMappingRules).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + SingleUserMappingRuleResourceConfig("", "", "true"),
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			// Delete error
+			{
+				Config:      ProviderConfig + SingleUserMappingRuleResourceConfig("", "", "false"),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+				},
+				Config: ProviderConfig + SingleUserMappingRuleResourceConfig("", "", "false"),
+			},
+		},
+	})
+}
+
+func SingleUserMappingRuleResourceConfig(firstPlacement, secondPlacement, breakOption string) string {
+	return fmt.Sprintf(`
+resource "powerscale_user_mapping_rule" "first" {
+	zone = "System"
+	operator = "append"
+	options = {
+		break = true
+		group = true
+		groups = true
+		user = true
+	}
+	target_user = {
+		domain = "domain"
+		user = "tfaccMappingRuleUser"
+	}
+	source_user = {
+		user = "admin"
+	}
+	%s
+}
+
+resource "powerscale_user_mapping_rule" "second" {
+	zone = "System"
+	operator = "union"
+	options = {
+		break = %s
+	}
+	target_user = {
+		user = "tfaccMappingRuleUser"
+	}
+	source_user = {
+		user = "admin"
+	}
+	%s
+}
+`, firstPlacement, breakOption, secondPlacement)
+}
+
+var SingleUserMappingRuleDefaultZoneResourceConfig = `
+resource "powerscale_user_mapping_rule" "default_zone" {
+	operator = "union"
+	target_user = {
+		user = "tfaccMappingRuleUser"
+	}
+	source_user = {
+		user = "admin"
+	}
+}
+`
@@ -0,0 +1,286 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UserMappingRulesEvaluationDataSource{}
+
+// NewUserMappingRulesEvaluationDataSource creates a new data source.
+func NewUserMappingRulesEvaluationDataSource() datasource.DataSource {
+	return &UserMappingRulesEvaluationDataSource{}
+}
+
+// UserMappingRulesEvaluationDataSource defines the data source implementation.
+type UserMappingRulesEvaluationDataSource struct{}
+
+// Metadata describes the data source arguments.
+func (d *UserMappingRulesEvaluationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_mapping_rules_evaluation"
+}
+
+// userMappingRuleUserDataSourceSchema returns the schema of a user of a candidate user mapping rule.
+func userMappingRuleUserDataSourceSchema(description string, required bool) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description:         description,
+		MarkdownDescription: description,
+		Required:            required,
+		Optional:            !required,
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				Description:         "Specifies the domain of the user that is being mapped.",
+				MarkdownDescription: "Specifies the domain of the user that is being mapped.",
+				Optional:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"user": schema.StringAttribute{
+				Description:         "Specifies the name of the user that is being mapped.",
+				MarkdownDescription: "Specifies the name of the user that is being mapped.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+		},
+	}
+}
+
+// userMappingGroupSchemaAttributes returns the attributes of a group of a user identity.
+func userMappingGroupSchemaAttributes(computed bool) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Description:         "Specifies the group name.",
+			MarkdownDescription: "Specifies the group name.",
+			Optional:            !computed,
+			Computed:            computed,
+		},
+		"gid": schema.StringAttribute{
+			Description:         "Specifies the group GID, ex. GID:2000.",
+			MarkdownDescription: "Specifies the group GID, ex. `GID:2000`.",
+			Optional:            !computed,
+			Computed:            computed,
+		},
+		"sid": schema.StringAttribute{
+			Description:         "Specifies the group SID.",
+			MarkdownDescription: "Specifies the group SID.",
+			Optional:            !computed,
+			Computed:            computed,
+		},
+	}
+}
+
+// userMappingIdentitySchemaAttributes returns the attributes of a user identity.
+func userMappingIdentitySchemaAttributes(computed bool) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"domain": schema.StringAttribute{
+			Description:         "Specifies the domain of the user, unset for the users of the local and file providers.",
+			MarkdownDescription: "Specifies the domain of the user, unset for the users of the local and file providers.",
+			Optional:            !computed,
+			Computed:            computed,
+		},
+		"name": schema.StringAttribute{
+			Description:         "Specifies the user name.",
+			MarkdownDescription: "Specifies the user name.",
+			Required:            !computed,
+			Computed:            computed,
+		},
+		"uid": schema.StringAttribute{
+			Description:         "Specifies the user UID, ex. UID:2000.",
+			MarkdownDescription: "Specifies the user UID, ex. `UID:2000`.",
+			Optional:            !computed,
+			Computed:            computed,
+		},
+		"sid": schema.StringAttribute{
+			Description:         "Specifies the user SID.",
+			MarkdownDescription: "Specifies the user SID.",
+			Optional:            !computed,
+			Computed:            computed,
+		},
+		"primary_group": schema.SingleNestedAttribute{
+			Description:         "Specifies the primary group of the user.",
+			MarkdownDescription: "Specifies the primary group of the user.",
+			Optional:            !computed,
+			Computed:            computed,
+			Attributes:          userMappingGroupSchemaAttributes(computed),
+		},
+		"groups": schema.ListNestedAttribute{
+			Description:         "Specifies the supplemental groups of the user.",
+			MarkdownDescription: "Specifies the supplemental groups of the user.",
+			Optional:            !computed,
+			Computed:            computed,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: userMappingGroupSchemaAttributes(computed),
+			},
+		},
+	}
+}
+
+// userMappingResultSchemaAttributes returns the attributes of the access token a user maps to.
+func userMappingResultSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"user": schema.StringAttribute{
+			Description:         "Specifies the evaluated user.",
+			MarkdownDescription: "Specifies the evaluated user.",
+			Computed:            true,
+		},
+		"denied": schema.BoolAttribute{
+			Description:         "True, if the user is denied by a replace rule without source user.",
+			MarkdownDescription: "True, if the user is denied by a replace rule without source user.",
+			Computed:            true,
+		},
+		"token": schema.SingleNestedAttribute{
+			Description:         "Specifies the access token of the user, unset when the user is denied.",
+			MarkdownDescription: "Specifies the access token of the user, unset when the user is denied.",
+			Computed:            true,
+			Attributes:          userMappingIdentitySchemaAttributes(true),
+		},
+		"applied_rules": schema.ListAttribute{
+			Description:         "Specifies the zero-based indexes of the rules applied to the user, in order.",
+			MarkdownDescription: "Specifies the zero-based indexes of the rules applied to the user, in order.",
+			Computed:            true,
+			ElementType:         types.Int64Type,
+		},
+	}
+}
+
+// Schema describes the data source arguments.
+func (d *UserMappingRulesEvaluationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This datasource is used to evaluate a candidate list of User Mapping Rules against sample identities at plan time, without applying the rules to the PowerScale Array. " +
+			"The rules are processed in order with the OneFS semantics of the `append`, `insert`, `replace`, `trim` and `union` (join) operators, the `*` and `?` wildcards, and the `break` and `default_user` options. " +
+			"The `identities` stand for the directory services of the zone, so that the result only depends on the configuration. " +
+			"The same evaluation is available as the `provider::powerscale::evaluate_user_mapping_rules` function.",
+		Description: "This datasource is used to evaluate a candidate list of User Mapping Rules against sample identities at plan time, without applying the rules to the PowerScale Array. " +
+			"The rules are processed in order with the OneFS semantics of the append, insert, replace, trim and union (join) operators, the * and ? wildcards, and the break and default_user options. " +
+			"The identities stand for the directory services of the zone, so that the result only depends on the configuration. " +
+			"The same evaluation is available as the provider::powerscale::evaluate_user_mapping_rules function.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier",
+				MarkdownDescription: "Identifier",
+				Computed:            true,
+			},
+			"rules": schema.ListNestedAttribute{
+				Description:         "Specifies the candidate list of user mapping rules, with the same attributes as the rules of the powerscale_user_mapping_rules resource.",
+				MarkdownDescription: "Specifies the candidate list of user mapping rules, with the same attributes as the `rules` of the `powerscale_user_mapping_rules` resource.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"operator": schema.StringAttribute{
+							Description:         "Specifies the operator to make rules on specified users or groups.",
+							MarkdownDescription: "Specifies the operator to make rules on specified users or groups.",
+							Required:            true,
+							Validators:          []validator.String{stringvalidator.OneOf("append", "insert", "replace", "trim", "union")},
+						},
+						"options": schema.SingleNestedAttribute{
+							Description:         "Specifies the mapping options for this user mapping rule.",
+							MarkdownDescription: "Specifies the mapping options for this user mapping rule.",
+							Optional:            true,
+							Attributes: map[string]schema.Attribute{
+								"break": schema.BoolAttribute{
+									Description:         "If true, and the rule was applied successfully, stop processing further.",
+									MarkdownDescription: "If true, and the rule was applied successfully, stop processing further.",
+									Optional:            true,
+								},
+								"user": schema.BoolAttribute{
+									Description:         "If true, the primary UID and primary user SID should be copied to the existing credential.",
+									MarkdownDescription: "If true, the primary UID and primary user SID should be copied to the existing credential.",
+									Optional:            true,
+								},
+								"group": schema.BoolAttribute{
+									Description:         "If true, the primary GID and primary group SID should be copied to the existing credential.",
+									MarkdownDescription: "If true, the primary GID and primary group SID should be copied to the existing credential.",
+									Optional:            true,
+								},
+								"groups": schema.BoolAttribute{
+									Description:         "If true, all additional identifiers should be copied to the existing credential.",
+									MarkdownDescription: "If true, all additional identifiers should be copied to the existing credential.",
+									Optional:            true,
+								},
+								"default_user": userMappingRuleUserDataSourceSchema("Specifies the default user information that can be applied if the final credentials do not have valid UID and GID information.", false),
+							},
+						},
+						"target_user": userMappingRuleUserDataSourceSchema("Specifies the target user information that the rule can be applied to.", true),
+						"source_user": userMappingRuleUserDataSourceSchema("Specifies the source user information that the rule can be applied from.", false),
+					},
+				},
+			},
+			"identities": schema.ListNestedAttribute{
+				Description:         "Specifies the user identities standing for the directory services of the zone, both the evaluated users and the users the rules map to.",
+				MarkdownDescription: "Specifies the user identities standing for the directory services of the zone, both the evaluated users and the users the rules map to.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: userMappingIdentitySchemaAttributes(false),
+				},
+			},
+			"users": schema.ListAttribute{
+				Description:         "Specifies the users to evaluate the rules against, written as [domain\\]name.",
+				MarkdownDescription: "Specifies the users to evaluate the rules against, written as `[domain\\]name`.",
+				Required:            true,
+				ElementType:         types.StringType,
+			},
+			"results": schema.ListNestedAttribute{
+				Description:         "Specifies the access token each of the users maps to.",
+				MarkdownDescription: "Specifies the access token each of the users maps to.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: userMappingResultSchemaAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Read reads data from the data source.
+func (d *UserMappingRulesEvaluationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading User Mapping Rules Evaluation data source")
+	var state models.UserMappingRulesEvaluationDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := helper.GetUserMappingRulesInput(ctx, state.Rules)
+	if err != nil {
+		resp.Diagnostics.AddError("error reading user mapping rules", err.Error())
+		return
+	}
+	users := make([]string, 0, len(state.Users))
+	for _, user := range state.Users {
+		users = append(users, user.ValueString())
+	}
+	state.Results, err = helper.EvaluateUserMappings(rules, helper.GetMappingIdentities(state.Identities), users)
+	if err != nil {
+		resp.Diagnostics.AddError("error evaluating user mapping rules", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue("user_mapping_rules_evaluation")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Read User Mapping Rules Evaluation data source")
+}
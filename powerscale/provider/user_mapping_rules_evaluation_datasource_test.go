@@ -0,0 +1,152 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserMappingRulesEvaluationDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + userMappingRulesEvaluationDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerscale_user_mapping_rules_evaluation.test", "results.#", "3"),
+					// AD\alice is joined with the UNIX user alice
+					resource.TestCheckResourceAttr("data.powerscale_user_mapping_rules_evaluation.test", "results.0.token.uid", "UID:2001"),
+					resource.TestCheckResourceAttr("data.powerscale_user_mapping_rules_evaluation.test", "results.0.token.sid", "SID:S-1-5-21-100-200-300-1001"),
+					resource.TestCheckResourceAttr("data.powerscale_user_mapping_rules_evaluation.test", "results.0.token.groups.#", "2"),
+					resource.TestCheckResourceAttr("data.powerscale_user_mapping_rules_evaluation.test", "results.0.applied_rules.0", "0"),
+					// AD\bob maps to the default user
+					resource.TestCheckResourceAttr("data.powerscale_user_mapping_rules_evaluation.test", "results.1.token.name", "nobody"),
+					resource.TestCheckResourceAttr("data.powerscale_user_mapping_rules_evaluation.test", "results.1.applied_rules.0", "1"),
+					// AD\eve is denied
+					resource.TestCheckResourceAttr("data.powerscale_user_mapping_rules_evaluation.test", "results.2.denied", "true"),
+					resource.TestCheckNoResourceAttr("data.powerscale_user_mapping_rules_evaluation.test", "results.2.token"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUserMappingRulesEvaluationDataSourceErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + `
+				data "powerscale_user_mapping_rules_evaluation" "test" {
+					rules = []
+					identities = [{ name = "alice" }]
+					users = ["AD\\unknown"]
+				}
+				`,
+				ExpectError: regexp.MustCompile(`.*is not one of the identities*.`),
+			},
+		},
+	})
+}
+
+var userMappingRulesEvaluationDataSourceConfig = `
+data "powerscale_user_mapping_rules_evaluation" "test" {
+	rules = [
+		{
+			operator = "union"
+			target_user = {
+				domain = "AD"
+				user = "*"
+			}
+			source_user = {
+				user = "*"
+			}
+		},
+		{
+			operator = "replace"
+			options = {
+				default_user = {
+					user = "nobody"
+				}
+			}
+			target_user = {
+				domain = "AD"
+				user = "bob"
+			}
+			source_user = {
+				user = "unknown"
+			}
+		},
+		{
+			operator = "replace"
+			target_user = {
+				domain = "AD"
+				user = "eve"
+			}
+		},
+	]
+	identities = [
+		{
+			domain = "AD"
+			name = "alice"
+			sid = "SID:S-1-5-21-100-200-300-1001"
+			primary_group = {
+				name = "AD\\domain users"
+				sid = "SID:S-1-5-21-100-200-300-513"
+			}
+		},
+		{
+			name = "alice"
+			uid = "UID:2001"
+			sid = "SID:S-1-22-1-2001"
+			primary_group = {
+				name = "alice"
+				gid = "GID:2001"
+				sid = "SID:S-1-22-2-2001"
+			}
+			groups = [
+				{
+					name = "eng"
+					gid = "GID:3000"
+				}
+			]
+		},
+		{
+			domain = "AD"
+			name = "bob"
+			sid = "SID:S-1-5-21-100-200-300-1002"
+		},
+		{
+			domain = "AD"
+			name = "eve"
+			sid = "SID:S-1-5-21-100-200-300-1003"
+		},
+		{
+			name = "nobody"
+			uid = "UID:65534"
+			sid = "SID:S-1-22-1-65534"
+		},
+	]
+	users = ["AD\\alice", "AD\\bob", "AD\\eve"]
+}
+`
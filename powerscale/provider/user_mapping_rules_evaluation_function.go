@@ -0,0 +1,104 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &EvaluateUserMappingRulesFunction{}
+
+// NewEvaluateUserMappingRulesFunction creates a new function.
+func NewEvaluateUserMappingRulesFunction() function.Function {
+	return &EvaluateUserMappingRulesFunction{}
+}
+
+// EvaluateUserMappingRulesFunction defines the function implementation.
+type EvaluateUserMappingRulesFunction struct{}
+
+// Metadata describes the function.
+func (f *EvaluateUserMappingRulesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "evaluate_user_mapping_rules"
+}
+
+// Definition describes the function arguments and result.
+func (f *EvaluateUserMappingRulesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Evaluates user mapping rules against sample identities",
+		Description: "Evaluates a candidate list of user mapping rules against sample identities, without applying the rules to the PowerScale Array. " +
+			"It returns the same results as the powerscale_user_mapping_rules_evaluation data source.",
+		MarkdownDescription: "Evaluates a candidate list of user mapping rules against sample identities, without applying the rules to the PowerScale Array. " +
+			"It returns the same `results` as the `powerscale_user_mapping_rules_evaluation` data source.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name: "rules",
+				Description: "List of user mapping rules, with the same attributes as the rules of the powerscale_user_mapping_rules resource. " +
+					"The rules of the resource or of the powerscale_user_mapping_rules data source can be passed as is.",
+				MarkdownDescription: "List of user mapping rules, with the same attributes as the `rules` of the `powerscale_user_mapping_rules` resource. " +
+					"The rules of the resource or of the `powerscale_user_mapping_rules` data source can be passed as is.",
+			},
+			function.DynamicParameter{
+				Name:                "identities",
+				Description:         "List of user identities standing for the directory services of the zone, with the same attributes as the identities of the powerscale_user_mapping_rules_evaluation data source.",
+				MarkdownDescription: "List of user identities standing for the directory services of the zone, with the same attributes as the `identities` of the `powerscale_user_mapping_rules_evaluation` data source.",
+			},
+			function.ListParameter{
+				Name:                "users",
+				Description:         "Users to evaluate the rules against, written as [domain\\]name.",
+				MarkdownDescription: "Users to evaluate the rules against, written as `[domain\\]name`.",
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: schema.NestedAttributeObject{Attributes: userMappingResultSchemaAttributes()}.Type(),
+		},
+	}
+}
+
+// Run evaluates the user mapping rules.
+func (f *EvaluateUserMappingRulesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var rulesValue, identitiesValue types.Dynamic
+	var users []string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &rulesValue, &identitiesValue, &users))
+	if resp.Error != nil {
+		return
+	}
+
+	rules, err := helper.DecodeUserMappingRules(rulesValue)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	identities, err := helper.DecodeMappingIdentities(identitiesValue)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+	results, err := helper.EvaluateUserMappings(rules, identities, users)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, results))
+}
@@ -0,0 +1,91 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccEvaluateUserMappingRulesFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + `
+				locals {
+					results = provider::powerscale::evaluate_user_mapping_rules(
+						[
+							{
+								operator = "append"
+								options = { groups = true }
+								target_user = { user = "alice" }
+								source_user = { domain = "AD", user = "alice" }
+							},
+							{
+								operator = "trim"
+								options = { break = true }
+								target_user = { user = "nobody" }
+							},
+						],
+						[
+							{
+								domain = "AD"
+								name = "alice"
+								sid = "SID:S-1-5-21-100-200-300-1001"
+								groups = [{ name = "AD\\engineering", sid = "SID:S-1-5-21-100-200-300-2001" }]
+							},
+							{ name = "alice", uid = "UID:2001" },
+						],
+						["alice"]
+					)
+				}
+				output "uid" {
+					value = local.results[0].token.uid
+				}
+				output "group" {
+					value = local.results[0].token.groups[0].name
+				}
+				output "applied_rules" {
+					value = length(local.results[0].applied_rules)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("uid", "UID:2001"),
+					resource.TestCheckOutput("group", "AD\\engineering"),
+					resource.TestCheckOutput("applied_rules", "1"),
+				),
+			},
+			{
+				Config: ProviderConfig + `
+				output "invalid" {
+					value = provider::powerscale::evaluate_user_mapping_rules("invalid", [], ["alice"])
+				}
+				`,
+				ExpectError: regexp.MustCompile(`.*invalid user mapping rules*.`),
+			},
+		},
+	})
+}
@@ -16,8 +16,12 @@ package provider
 import (
 	"context"
 	powerscale "dell/powerscale-go-client"
+	"encoding/json"
 	"fmt"
+	"os"
 	"regexp"
+	"strconv"
+	"strings"
 	"terraform-provider-powerscale/client"
 	"terraform-provider-powerscale/powerscale/helper"
 	"terraform-provider-powerscale/powerscale/models"
@@ -126,6 +130,129 @@ func TestAccUserMappingRuleResourceEmpty(t *testing.T) {
 	})
 }
 
+// TestAccUserMappingRulesRecordLookups applies the rules of each case of the user mapping evaluator and records the token that
+// test_mapping_users returns, so that Test_EvaluateUserMappingRulesRecordedLookups compares the evaluator to the cluster.
+// The identities are the tokens of the users without any rule. It replaces the rules of the System zone.
+func TestAccUserMappingRulesRecordLookups(t *testing.T) {
+	if os.Getenv("POWERSCALE_RECORD_USER_MAPPING_LOOKUPS") == "" {
+		t.Skip("set POWERSCALE_RECORD_USER_MAPPING_LOOKUPS to record the lookups of the user mapping evaluator tests")
+	}
+	fixture, err := os.ReadFile("../helper/testdata/user_mapping_lookup_cases.json")
+	assert.Nil(t, err)
+	var lookupCases struct {
+		Users []string `json:"users"`
+		Cases []struct {
+			Name  string                               `json:"name"`
+			Rules []powerscale.V1MappingUsersRulesRule `json:"rules"`
+			User  string                               `json:"user"`
+			Token *helper.MappingIdentity              `json:"token"`
+		} `json:"cases"`
+	}
+	assert.Nil(t, json.Unmarshal(fixture, &lookupCases))
+	recorded := struct {
+		Identities []helper.MappingIdentity `json:"identities"`
+		Cases      any                      `json:"cases"`
+	}{}
+
+	steps := []resource.TestStep{
+		{
+			Config: ProviderConfig + userMappingRulesLookupConfig(nil, lookupCases.Users),
+			Check: func(s *terraform.State) error {
+				attributes := s.RootModule().Resources["powerscale_user_mapping_rules.lookup"].Primary.Attributes
+				for index := range lookupCases.Users {
+					recorded.Identities = append(recorded.Identities, *recordedUserMappingToken(attributes, fmt.Sprintf("mapping_users.%d", index)))
+				}
+				return nil
+			},
+		},
+	}
+	for index := range lookupCases.Cases {
+		lookupCase := &lookupCases.Cases[index]
+		steps = append(steps, resource.TestStep{
+			Config: ProviderConfig + userMappingRulesLookupConfig(lookupCase.Rules, []string{lookupCase.User}),
+			Check: func(s *terraform.State) error {
+				attributes := s.RootModule().Resources["powerscale_user_mapping_rules.lookup"].Primary.Attributes
+				lookupCase.Token = recordedUserMappingToken(attributes, "mapping_users.0")
+				return nil
+			},
+		})
+	}
+	// write the recorded lookups once the last case is recorded
+	steps[len(steps)-1].Check = resource.ComposeTestCheckFunc(steps[len(steps)-1].Check, func(_ *terraform.State) error {
+		recorded.Cases = lookupCases.Cases
+		content, err := json.MarshalIndent(recorded, "", "  ")
+		if err != nil {
+			return err
+		}
+		return os.WriteFile("../helper/testdata/user_mapping_recorded_lookups.json", append(content, '\n'), 0o600)
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps:                    steps,
+	})
+}
+
+// recordedUserMappingToken returns the token of a test mapping user from the flattened state, nil when the user was not mapped.
+func recordedUserMappingToken(attributes map[string]string, prefix string) *helper.MappingIdentity {
+	name, ok := attributes[prefix+".user.name"]
+	if !ok {
+		return nil
+	}
+	token := &helper.MappingIdentity{
+		Name: name,
+		UID:  attributes[prefix+".user.uid"],
+		SID:  attributes[prefix+".user.sid"],
+		PrimaryGroup: helper.MappingGroup{
+			Name: attributes[prefix+".user.primary_group_name"],
+			SID:  attributes[prefix+".user.primary_group_sid"],
+		},
+	}
+	if domain, user, found := strings.Cut(name, `\`); found {
+		token.Domain, token.Name = domain, user
+	}
+	count, _ := strconv.Atoi(attributes[prefix+".supplemental_identities.#"])
+	for index := 0; index < count; index++ {
+		identity := fmt.Sprintf("%s.supplemental_identities.%d", prefix, index)
+		token.Groups = append(token.Groups, helper.MappingGroup{
+			Name: attributes[identity+".name"],
+			GID:  attributes[identity+".gid"],
+			SID:  attributes[identity+".sid"],
+		})
+	}
+	return token
+}
+
+// userMappingRulesLookupConfig returns the config of the System zone rules with the given users to look up.
+func userMappingRulesLookupConfig(rules []powerscale.V1MappingUsersRulesRule, users []string) string {
+	var ruleBlocks, userBlocks []string
+	for _, rule := range rules {
+		block := fmt.Sprintf("{\n\t\toperator = %q\n\t\ttarget_user = %s\n", rule.GetOperator(), userMappingRuleUserConfig(rule.User1.Domain, rule.User1.User))
+		if rule.User2 != nil {
+			block += fmt.Sprintf("\t\tsource_user = %s\n", userMappingRuleUserConfig(rule.User2.Domain, rule.User2.User))
+		}
+		ruleBlocks = append(ruleBlocks, block+"\t}")
+	}
+	for _, user := range users {
+		userBlocks = append(userBlocks, fmt.Sprintf("{ name = %q }", user))
+	}
+	return fmt.Sprintf(`
+resource "powerscale_user_mapping_rules" "lookup" {
+	zone = "System"
+	rules = [%s]
+	test_mapping_users = [%s]
+}
+`, strings.Join(ruleBlocks, ", "), strings.Join(userBlocks, ", "))
+}
+
+func userMappingRuleUserConfig(domain *string, user string) string {
+	if domain == nil {
+		return fmt.Sprintf("{ user = %q }", user)
+	}
+	return fmt.Sprintf("{ domain = %q, user = %q }", *domain, user)
+}
+
 func TestAccUserMappingRuleResourceErr(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },